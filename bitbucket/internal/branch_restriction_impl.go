@@ -0,0 +1,251 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewBranchRestrictionService handles communication with the branch restriction related methods of the Bitbucket API.
+func NewBranchRestrictionService(client service.Connector) *BranchRestrictionService {
+
+	return &BranchRestrictionService{
+		internalClient: &internalBranchRestrictionServiceImpl{c: client},
+		c:              client,
+	}
+}
+
+// BranchRestrictionService handles communication with the branch restriction related methods of the Bitbucket API.
+type BranchRestrictionService struct {
+	internalClient bitbucket.BranchRestrictionConnector
+	c              service.Connector
+}
+
+// Gets returns a paginated list of the branch restrictions of the specified repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/branch-restrictions
+func (b *BranchRestrictionService) Gets(ctx context.Context, workspace, repoSlug string, options *model.BranchRestrictionOptionsScheme) (*model.BranchRestrictionPageScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Gets(ctx, workspace, repoSlug, options)
+}
+
+// GetsAll iterates over all the branch restrictions of the specified repository.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/branch-restrictions
+func (b *BranchRestrictionService) GetsAll(ctx context.Context, workspace, repoSlug string, options *model.BranchRestrictionOptionsScheme, opts ...paginate.Option) iter.Seq2[*model.BranchRestrictionScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.BranchRestrictionScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.BranchRestrictionScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	endpoint := branchRestrictionListEndpoint(fmt.Sprintf("2.0/repositories/%v/%v/branch-restrictions", workspace, repoSlug), options)
+	return paginateLinks[*model.BranchRestrictionScheme](ctx, b.c, endpoint, opts)
+}
+
+// Get returns the specified branch restriction.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/branch-restrictions/{id}
+func (b *BranchRestrictionService) Get(ctx context.Context, workspace, repoSlug string, restrictionID int) (*model.BranchRestrictionScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Get(ctx, workspace, repoSlug, restrictionID)
+}
+
+// Create creates a new branch restriction.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/branch-restrictions
+func (b *BranchRestrictionService) Create(ctx context.Context, workspace, repoSlug string, payload *model.BranchRestrictionScheme) (*model.BranchRestrictionScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Create(ctx, workspace, repoSlug, payload)
+}
+
+// Update updates the specified branch restriction.
+//
+// PUT /2.0/repositories/{workspace}/{repo_slug}/branch-restrictions/{id}
+func (b *BranchRestrictionService) Update(ctx context.Context, workspace, repoSlug string, restrictionID int, payload *model.BranchRestrictionScheme) (*model.BranchRestrictionScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Update(ctx, workspace, repoSlug, restrictionID, payload)
+}
+
+// Delete deletes the specified branch restriction.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/branch-restrictions/{id}
+func (b *BranchRestrictionService) Delete(ctx context.Context, workspace, repoSlug string, restrictionID int) (*model.ResponseScheme, error) {
+	return b.internalClient.Delete(ctx, workspace, repoSlug, restrictionID)
+}
+
+type internalBranchRestrictionServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the branch restrictions of the specified repository.
+func (i *internalBranchRestrictionServiceImpl) Gets(ctx context.Context, workspace, repoSlug string, options *model.BranchRestrictionOptionsScheme) (*model.BranchRestrictionPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := branchRestrictionListEndpoint(fmt.Sprintf("2.0/repositories/%v/%v/branch-restrictions", workspace, repoSlug), options)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.BranchRestrictionPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Get returns the specified branch restriction.
+func (i *internalBranchRestrictionServiceImpl) Get(ctx context.Context, workspace, repoSlug string, restrictionID int) (*model.BranchRestrictionScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if restrictionID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoBranchRestrictionID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/branch-restrictions/%v", workspace, repoSlug, restrictionID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	restriction := new(model.BranchRestrictionScheme)
+	response, err := i.c.Call(request, restriction)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return restriction, response, nil
+}
+
+// Create creates a new branch restriction.
+func (i *internalBranchRestrictionServiceImpl) Create(ctx context.Context, workspace, repoSlug string, payload *model.BranchRestrictionScheme) (*model.BranchRestrictionScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/branch-restrictions", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	restriction := new(model.BranchRestrictionScheme)
+	response, err := i.c.Call(request, restriction)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return restriction, response, nil
+}
+
+// Update updates the specified branch restriction.
+func (i *internalBranchRestrictionServiceImpl) Update(ctx context.Context, workspace, repoSlug string, restrictionID int, payload *model.BranchRestrictionScheme) (*model.BranchRestrictionScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if restrictionID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoBranchRestrictionID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/branch-restrictions/%v", workspace, repoSlug, restrictionID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	restriction := new(model.BranchRestrictionScheme)
+	response, err := i.c.Call(request, restriction)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return restriction, response, nil
+}
+
+// Delete deletes the specified branch restriction.
+func (i *internalBranchRestrictionServiceImpl) Delete(ctx context.Context, workspace, repoSlug string, restrictionID int) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if restrictionID == 0 {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoBranchRestrictionID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/branch-restrictions/%v", workspace, repoSlug, restrictionID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+// branchRestrictionListEndpoint appends the kind and pattern filters to the endpoint listing branch restrictions.
+func branchRestrictionListEndpoint(base string, options *model.BranchRestrictionOptionsScheme) string {
+
+	if options == nil {
+		return base
+	}
+
+	params := url.Values{}
+	if options.Kind != "" {
+		params.Add("kind", options.Kind)
+	}
+
+	if options.Pattern != "" {
+		params.Add("pattern", options.Pattern)
+	}
+
+	if len(params) == 0 {
+		return base
+	}
+
+	return fmt.Sprintf("%v?%v", base, params.Encode())
+}
@@ -0,0 +1,721 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func Test_internalBranchRestrictionServiceImpl_Gets(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		repoSlug  string
+		options   *model.BranchRestrictionOptionsScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				options: &model.BranchRestrictionOptionsScheme{
+					Kind:    "push",
+					Pattern: "release/*",
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/branch-restrictions?kind=push&pattern=release%2F%2A",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.BranchRestrictionPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				options: &model.BranchRestrictionOptionsScheme{
+					Kind:    "push",
+					Pattern: "release/*",
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/branch-restrictions?kind=push&pattern=release%2F%2A",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "",
+				repoSlug:  "repository-sample",
+				options: &model.BranchRestrictionOptionsScheme{
+					Kind:    "push",
+					Pattern: "release/*",
+				},
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "",
+				options: &model.BranchRestrictionOptionsScheme{
+					Kind:    "push",
+					Pattern: "release/*",
+				},
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewBranchRestrictionService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Gets(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalBranchRestrictionServiceImpl_Get(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx           context.Context
+		workspace     string
+		repoSlug      string
+		restrictionID int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				restrictionID: 27,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/branch-restrictions/27",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.BranchRestrictionScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				restrictionID: 27,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/branch-restrictions/27",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "",
+				repoSlug:      "repository-sample",
+				restrictionID: 27,
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "",
+				restrictionID: 27,
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the restriction id is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				restrictionID: 0,
+			},
+			wantErr: true,
+			Err:     model.ErrNoBranchRestrictionID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewBranchRestrictionService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Get(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.restrictionID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalBranchRestrictionServiceImpl_Create(t *testing.T) {
+
+	payloadMocked := &model.BranchRestrictionScheme{
+		Kind:            "require_approvals_to_merge",
+		BranchMatchKind: "glob",
+		Pattern:         "main",
+		Value:           2,
+	}
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		repoSlug  string
+		payload   *model.BranchRestrictionScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				payload:   payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"2.0/repositories/work-space-name-sample/repository-sample/branch-restrictions",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.BranchRestrictionScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				payload:   payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"2.0/repositories/work-space-name-sample/repository-sample/branch-restrictions",
+					"", payloadMocked).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "",
+				repoSlug:  "repository-sample",
+				payload:   payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "",
+				payload:   payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewBranchRestrictionService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Create(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalBranchRestrictionServiceImpl_Update(t *testing.T) {
+
+	payloadMocked := &model.BranchRestrictionScheme{
+		Kind:            "require_approvals_to_merge",
+		BranchMatchKind: "glob",
+		Pattern:         "main",
+		Value:           2,
+	}
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx           context.Context
+		workspace     string
+		repoSlug      string
+		restrictionID int
+		payload       *model.BranchRestrictionScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				restrictionID: 27,
+				payload:       payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"2.0/repositories/work-space-name-sample/repository-sample/branch-restrictions/27",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.BranchRestrictionScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				restrictionID: 27,
+				payload:       payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"2.0/repositories/work-space-name-sample/repository-sample/branch-restrictions/27",
+					"", payloadMocked).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "",
+				repoSlug:      "repository-sample",
+				restrictionID: 27,
+				payload:       payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "",
+				restrictionID: 27,
+				payload:       payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the restriction id is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				restrictionID: 0,
+				payload:       payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoBranchRestrictionID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewBranchRestrictionService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Update(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.restrictionID, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalBranchRestrictionServiceImpl_Delete(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx           context.Context
+		workspace     string
+		repoSlug      string
+		restrictionID int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				restrictionID: 27,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"2.0/repositories/work-space-name-sample/repository-sample/branch-restrictions/27",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				restrictionID: 27,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"2.0/repositories/work-space-name-sample/repository-sample/branch-restrictions/27",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "",
+				repoSlug:      "repository-sample",
+				restrictionID: 27,
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "",
+				restrictionID: 27,
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the restriction id is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				restrictionID: 0,
+			},
+			wantErr: true,
+			Err:     model.ErrNoBranchRestrictionID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewBranchRestrictionService(testCase.fields.c)
+
+			gotResponse, err := newService.Delete(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.restrictionID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewBranchingModelService handles communication with the branching model related methods of the Bitbucket API.
+func NewBranchingModelService(client service.Connector) *BranchingModelService {
+
+	return &BranchingModelService{
+		internalClient: &internalBranchingModelServiceImpl{c: client},
+	}
+}
+
+// BranchingModelService handles communication with the branching model related methods of the Bitbucket API.
+type BranchingModelService struct {
+	internalClient bitbucket.BranchingModelConnector
+}
+
+// Get returns the effective branching model of the specified repository,
+// the settings of the repository or, when it inherits them, of its project.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/branching-model
+func (b *BranchingModelService) Get(ctx context.Context, workspace, repoSlug string) (*model.BranchingModelScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Get(ctx, workspace, repoSlug)
+}
+
+// Settings returns the branching model settings of the specified repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/branching-model/settings
+func (b *BranchingModelService) Settings(ctx context.Context, workspace, repoSlug string) (*model.BranchingModelSettingsScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Settings(ctx, workspace, repoSlug)
+}
+
+// UpdateSettings updates the branching model settings of the specified repository,
+// the attributes unset in the payload are left unchanged.
+//
+// PUT /2.0/repositories/{workspace}/{repo_slug}/branching-model/settings
+func (b *BranchingModelService) UpdateSettings(ctx context.Context, workspace, repoSlug string, payload *model.BranchingModelSettingsScheme) (*model.BranchingModelSettingsScheme, *model.ResponseScheme, error) {
+	return b.internalClient.UpdateSettings(ctx, workspace, repoSlug, payload)
+}
+
+type internalBranchingModelServiceImpl struct {
+	c service.Connector
+}
+
+// Get returns the effective branching model of the specified repository,
+func (i *internalBranchingModelServiceImpl) Get(ctx context.Context, workspace, repoSlug string) (*model.BranchingModelScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/branching-model", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	branchingModel := new(model.BranchingModelScheme)
+	response, err := i.c.Call(request, branchingModel)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return branchingModel, response, nil
+}
+
+// Settings returns the branching model settings of the specified repository.
+func (i *internalBranchingModelServiceImpl) Settings(ctx context.Context, workspace, repoSlug string) (*model.BranchingModelSettingsScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/branching-model/settings", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings := new(model.BranchingModelSettingsScheme)
+	response, err := i.c.Call(request, settings)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return settings, response, nil
+}
+
+// UpdateSettings updates the branching model settings of the specified repository,
+func (i *internalBranchingModelServiceImpl) UpdateSettings(ctx context.Context, workspace, repoSlug string, payload *model.BranchingModelSettingsScheme) (*model.BranchingModelSettingsScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/branching-model/settings", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings := new(model.BranchingModelSettingsScheme)
+	response, err := i.c.Call(request, settings)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return settings, response, nil
+}
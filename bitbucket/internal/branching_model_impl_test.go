@@ -0,0 +1,393 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func Test_internalBranchingModelServiceImpl_Get(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		repoSlug  string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/branching-model",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.BranchingModelScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/branching-model",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "",
+				repoSlug:  "repository-sample",
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewBranchingModelService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Get(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalBranchingModelServiceImpl_Settings(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		repoSlug  string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/branching-model/settings",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.BranchingModelSettingsScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/branching-model/settings",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "",
+				repoSlug:  "repository-sample",
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewBranchingModelService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Settings(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalBranchingModelServiceImpl_UpdateSettings(t *testing.T) {
+
+	payloadMocked := &model.BranchingModelSettingsScheme{
+		Development: &model.BranchingModelSettingsBranchScheme{Name: "develop"},
+		BranchTypes: []*model.BranchingModelSettingsTypeScheme{{Kind: "release", Prefix: "release/"}},
+	}
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		repoSlug  string
+		payload   *model.BranchingModelSettingsScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				payload:   payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"2.0/repositories/work-space-name-sample/repository-sample/branching-model/settings",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.BranchingModelSettingsScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				payload:   payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"2.0/repositories/work-space-name-sample/repository-sample/branching-model/settings",
+					"", payloadMocked).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "",
+				repoSlug:  "repository-sample",
+				payload:   payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "",
+				payload:   payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewBranchingModelService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.UpdateSettings(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
@@ -0,0 +1,251 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewCommitCommentService handles communication with the commit comment related methods of the Bitbucket API.
+func NewCommitCommentService(client service.Connector) *CommitCommentService {
+
+	return &CommitCommentService{
+		internalClient: &internalCommitCommentServiceImpl{c: client},
+		c:              client,
+	}
+}
+
+// CommitCommentService handles communication with the commit comment related methods of the Bitbucket API.
+type CommitCommentService struct {
+	internalClient bitbucket.CommitCommentConnector
+	c              service.Connector
+}
+
+// Gets returns a paginated list of the comments on the specified commit.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/comments
+func (c *CommitCommentService) Gets(ctx context.Context, workspace, repoSlug, commit string) (*model.CommitCommentPageScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Gets(ctx, workspace, repoSlug, commit)
+}
+
+// GetsAll iterates over all the comments on the specified commit.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/comments
+func (c *CommitCommentService) GetsAll(ctx context.Context, workspace, repoSlug, commit string, opts ...paginate.Option) iter.Seq2[*model.CommitCommentScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.CommitCommentScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.CommitCommentScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	if commit == "" {
+		return paginateError[*model.CommitCommentScheme](fmt.Errorf("bitbucket: %w", model.ErrNoCommit))
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/commit/%v/comments", workspace, repoSlug, commit)
+	return paginateLinks[*model.CommitCommentScheme](ctx, c.c, endpoint, opts)
+}
+
+// Get returns the specified commit comment.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/comments/{comment_id}
+func (c *CommitCommentService) Get(ctx context.Context, workspace, repoSlug, commit string, commentID int) (*model.CommitCommentScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Get(ctx, workspace, repoSlug, commit, commentID)
+}
+
+// Create creates a new commit comment, a reply when the payload has a parent.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/comments
+func (c *CommitCommentService) Create(ctx context.Context, workspace, repoSlug, commit string, payload *model.CommitCommentPayloadScheme) (*model.CommitCommentScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Create(ctx, workspace, repoSlug, commit, payload)
+}
+
+// Update updates the specified commit comment.
+//
+// PUT /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/comments/{comment_id}
+func (c *CommitCommentService) Update(ctx context.Context, workspace, repoSlug, commit string, commentID int, payload *model.CommitCommentPayloadScheme) (*model.CommitCommentScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Update(ctx, workspace, repoSlug, commit, commentID, payload)
+}
+
+// Delete deletes the specified commit comment.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/comments/{comment_id}
+func (c *CommitCommentService) Delete(ctx context.Context, workspace, repoSlug, commit string, commentID int) (*model.ResponseScheme, error) {
+	return c.internalClient.Delete(ctx, workspace, repoSlug, commit, commentID)
+}
+
+type internalCommitCommentServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the comments on the specified commit.
+func (i *internalCommitCommentServiceImpl) Gets(ctx context.Context, workspace, repoSlug, commit string) (*model.CommitCommentPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if commit == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommit)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/commit/%v/comments", workspace, repoSlug, commit)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.CommitCommentPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Get returns the specified commit comment.
+func (i *internalCommitCommentServiceImpl) Get(ctx context.Context, workspace, repoSlug, commit string, commentID int) (*model.CommitCommentScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if commit == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommit)
+	}
+
+	if commentID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommentID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/commit/%v/comments/%v", workspace, repoSlug, commit, commentID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comment := new(model.CommitCommentScheme)
+	response, err := i.c.Call(request, comment)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return comment, response, nil
+}
+
+// Create creates a new commit comment, a reply when the payload has a parent.
+func (i *internalCommitCommentServiceImpl) Create(ctx context.Context, workspace, repoSlug, commit string, payload *model.CommitCommentPayloadScheme) (*model.CommitCommentScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if commit == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommit)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/commit/%v/comments", workspace, repoSlug, commit)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comment := new(model.CommitCommentScheme)
+	response, err := i.c.Call(request, comment)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return comment, response, nil
+}
+
+// Update updates the specified commit comment.
+func (i *internalCommitCommentServiceImpl) Update(ctx context.Context, workspace, repoSlug, commit string, commentID int, payload *model.CommitCommentPayloadScheme) (*model.CommitCommentScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if commit == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommit)
+	}
+
+	if commentID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommentID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/commit/%v/comments/%v", workspace, repoSlug, commit, commentID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comment := new(model.CommitCommentScheme)
+	response, err := i.c.Call(request, comment)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return comment, response, nil
+}
+
+// Delete deletes the specified commit comment.
+func (i *internalCommitCommentServiceImpl) Delete(ctx context.Context, workspace, repoSlug, commit string, commentID int) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if commit == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommit)
+	}
+
+	if commentID == 0 {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommentID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/commit/%v/comments/%v", workspace, repoSlug, commit, commentID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
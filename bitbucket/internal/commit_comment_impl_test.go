@@ -0,0 +1,793 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func Test_internalCommitCommentServiceImpl_Gets(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		repoSlug  string
+		commit    string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/commit/a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0/comments",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.CommitCommentPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/commit/a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0/comments",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the commit is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoCommit,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewCommitCommentService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Gets(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.commit)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalCommitCommentServiceImpl_Get(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		repoSlug  string
+		commit    string
+		commentID int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+				commentID: 1024,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/commit/a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0/comments/1024",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.CommitCommentScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+				commentID: 1024,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/commit/a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0/comments/1024",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+				commentID: 1024,
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+				commentID: 1024,
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the commit is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "",
+				commentID: 1024,
+			},
+			wantErr: true,
+			Err:     model.ErrNoCommit,
+		},
+
+		{
+			name: "when the comment id is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+				commentID: 0,
+			},
+			wantErr: true,
+			Err:     model.ErrNoCommentID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewCommitCommentService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Get(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.commit, testCase.args.commentID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalCommitCommentServiceImpl_Create(t *testing.T) {
+
+	payloadMocked := &model.CommitCommentPayloadScheme{
+		Content: &model.BitbucketContentScheme{Raw: "This breaks the build on Windows"},
+		Inline:  &model.CommitCommentInlineScheme{Path: "main.go", To: 42},
+	}
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		repoSlug  string
+		commit    string
+		payload   *model.CommitCommentPayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+				payload:   payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"2.0/repositories/work-space-name-sample/repository-sample/commit/a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0/comments",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.CommitCommentScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+				payload:   payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"2.0/repositories/work-space-name-sample/repository-sample/commit/a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0/comments",
+					"", payloadMocked).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+				payload:   payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+				payload:   payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the commit is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "",
+				payload:   payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoCommit,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewCommitCommentService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Create(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.commit, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalCommitCommentServiceImpl_Update(t *testing.T) {
+
+	payloadMocked := &model.CommitCommentPayloadScheme{
+		Content: &model.BitbucketContentScheme{Raw: "This breaks the build on Windows"},
+		Inline:  &model.CommitCommentInlineScheme{Path: "main.go", To: 42},
+	}
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		repoSlug  string
+		commit    string
+		commentID int
+		payload   *model.CommitCommentPayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+				commentID: 1024,
+				payload:   payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"2.0/repositories/work-space-name-sample/repository-sample/commit/a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0/comments/1024",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.CommitCommentScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+				commentID: 1024,
+				payload:   payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"2.0/repositories/work-space-name-sample/repository-sample/commit/a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0/comments/1024",
+					"", payloadMocked).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+				commentID: 1024,
+				payload:   payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+				commentID: 1024,
+				payload:   payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the commit is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "",
+				commentID: 1024,
+				payload:   payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoCommit,
+		},
+
+		{
+			name: "when the comment id is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+				commentID: 0,
+				payload:   payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoCommentID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewCommitCommentService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Update(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.commit, testCase.args.commentID, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalCommitCommentServiceImpl_Delete(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		repoSlug  string
+		commit    string
+		commentID int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+				commentID: 1024,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"2.0/repositories/work-space-name-sample/repository-sample/commit/a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0/comments/1024",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+				commentID: 1024,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"2.0/repositories/work-space-name-sample/repository-sample/commit/a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0/comments/1024",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+				commentID: 1024,
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+				commentID: 1024,
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the commit is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "",
+				commentID: 1024,
+			},
+			wantErr: true,
+			Err:     model.ErrNoCommit,
+		},
+
+		{
+			name: "when the comment id is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+				commentID: 0,
+			},
+			wantErr: true,
+			Err:     model.ErrNoCommentID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewCommitCommentService(testCase.fields.c)
+
+			gotResponse, err := newService.Delete(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.commit, testCase.args.commentID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
@@ -0,0 +1,276 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"net/url"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewCommitService handles communication with the commit related methods of the Bitbucket API.
+func NewCommitService(client service.Connector, comment *CommitCommentService, status *CommitStatusService, report *CommitReportService) *CommitService {
+
+	return &CommitService{
+		internalClient: &internalCommitServiceImpl{c: client},
+		c:              client,
+		Comment:        comment,
+		Status:         status,
+		Report:         report,
+	}
+}
+
+// CommitService handles communication with the commit related methods of the Bitbucket API.
+type CommitService struct {
+	internalClient bitbucket.CommitConnector
+	c              service.Connector
+	Comment        *CommitCommentService
+	Status         *CommitStatusService
+	Report         *CommitReportService
+}
+
+// Gets returns a paginated list of the commits of the specified repository, the newest first.
+//
+// The commits reachable from the included revisions and not from the excluded ones are returned,
+// all the branches when none is included.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/commits
+func (c *CommitService) Gets(ctx context.Context, workspace, repoSlug string, options *model.CommitOptionsScheme) (*model.CommitPageScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Gets(ctx, workspace, repoSlug, options)
+}
+
+// GetsAll iterates over all the commits of the specified repository matching the options.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/commits
+func (c *CommitService) GetsAll(ctx context.Context, workspace, repoSlug string, options *model.CommitOptionsScheme, opts ...paginate.Option) iter.Seq2[*model.CommitScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.CommitScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.CommitScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	endpoint := commitListEndpoint(fmt.Sprintf("2.0/repositories/%v/%v/commits", workspace, repoSlug), options)
+	return paginateLinks[*model.CommitScheme](ctx, c.c, endpoint, opts)
+}
+
+// Get returns the specified commit.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}
+func (c *CommitService) Get(ctx context.Context, workspace, repoSlug, commit string) (*model.CommitScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Get(ctx, workspace, repoSlug, commit)
+}
+
+// Diff returns the diff between two revisions as a reader, the caller must close it.
+//
+// The spec is either a commit, diffed against its first parent, or two revisions, e.g. "feature..main".
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/diff/{spec}
+func (c *CommitService) Diff(ctx context.Context, workspace, repoSlug, spec string) (io.ReadCloser, error) {
+	return c.internalClient.Diff(ctx, workspace, repoSlug, spec)
+}
+
+// Patch returns the patch between two revisions as a reader, the caller must close it.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/patch/{spec}
+func (c *CommitService) Patch(ctx context.Context, workspace, repoSlug, spec string) (io.ReadCloser, error) {
+	return c.internalClient.Patch(ctx, workspace, repoSlug, spec)
+}
+
+// DiffStat returns a paginated list of the files modified between two revisions.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/diffstat/{spec}
+func (c *CommitService) DiffStat(ctx context.Context, workspace, repoSlug, spec string) (*model.DiffStatPageScheme, *model.ResponseScheme, error) {
+	return c.internalClient.DiffStat(ctx, workspace, repoSlug, spec)
+}
+
+// DiffStatAll iterates over all the files modified between two revisions.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/diffstat/{spec}
+func (c *CommitService) DiffStatAll(ctx context.Context, workspace, repoSlug, spec string, opts ...paginate.Option) iter.Seq2[*model.DiffStatScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.DiffStatScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.DiffStatScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	if spec == "" {
+		return paginateError[*model.DiffStatScheme](fmt.Errorf("bitbucket: %w", model.ErrNoDiffSpec))
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/diffstat/%v", workspace, repoSlug, url.PathEscape(spec))
+	return paginateLinks[*model.DiffStatScheme](ctx, c.c, endpoint, opts)
+}
+
+type internalCommitServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the commits of the specified repository, the newest first.
+func (i *internalCommitServiceImpl) Gets(ctx context.Context, workspace, repoSlug string, options *model.CommitOptionsScheme) (*model.CommitPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := commitListEndpoint(fmt.Sprintf("2.0/repositories/%v/%v/commits", workspace, repoSlug), options)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.CommitPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Get returns the specified commit.
+func (i *internalCommitServiceImpl) Get(ctx context.Context, workspace, repoSlug, commit string) (*model.CommitScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if commit == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommit)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/commit/%v", workspace, repoSlug, commit)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(model.CommitScheme)
+	response, err := i.c.Call(request, result)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return result, response, nil
+}
+
+// Diff returns the diff between two revisions as a reader, the caller must close it.
+func (i *internalCommitServiceImpl) Diff(ctx context.Context, workspace, repoSlug, spec string) (io.ReadCloser, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if spec == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoDiffSpec)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/diff/%v", workspace, repoSlug, url.PathEscape(spec))
+	return download(ctx, i.c, endpoint)
+}
+
+// Patch returns the patch between two revisions as a reader, the caller must close it.
+func (i *internalCommitServiceImpl) Patch(ctx context.Context, workspace, repoSlug, spec string) (io.ReadCloser, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if spec == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoDiffSpec)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/patch/%v", workspace, repoSlug, url.PathEscape(spec))
+	return download(ctx, i.c, endpoint)
+}
+
+// DiffStat returns a paginated list of the files modified between two revisions.
+func (i *internalCommitServiceImpl) DiffStat(ctx context.Context, workspace, repoSlug, spec string) (*model.DiffStatPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if spec == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoDiffSpec)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/diffstat/%v", workspace, repoSlug, url.PathEscape(spec))
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.DiffStatPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// commitListEndpoint appends the include, exclude and path filters to the endpoint listing commits.
+func commitListEndpoint(base string, options *model.CommitOptionsScheme) string {
+
+	if options == nil {
+		return base
+	}
+
+	params := url.Values{}
+	for _, include := range options.Include {
+		params.Add("include", include)
+	}
+
+	for _, exclude := range options.Exclude {
+		params.Add("exclude", exclude)
+	}
+
+	if options.Path != "" {
+		params.Add("path", options.Path)
+	}
+
+	if len(params) == 0 {
+		return base
+	}
+
+	return fmt.Sprintf("%v?%v", base, params.Encode())
+}
@@ -0,0 +1,469 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func Test_internalCommitServiceImpl_Gets(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		repoSlug  string
+		options   *model.CommitOptionsScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				options: &model.CommitOptionsScheme{
+					Include: []string{"main"},
+					Exclude: []string{"release/2.3"},
+					Path:    "go.mod",
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/commits?exclude=release%2F2.3&include=main&path=go.mod",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.CommitPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				options: &model.CommitOptionsScheme{
+					Include: []string{"main"},
+					Exclude: []string{"release/2.3"},
+					Path:    "go.mod",
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/commits?exclude=release%2F2.3&include=main&path=go.mod",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "",
+				repoSlug:  "repository-sample",
+				options: &model.CommitOptionsScheme{
+					Include: []string{"main"},
+					Exclude: []string{"release/2.3"},
+					Path:    "go.mod",
+				},
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "",
+				options: &model.CommitOptionsScheme{
+					Include: []string{"main"},
+					Exclude: []string{"release/2.3"},
+					Path:    "go.mod",
+				},
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewCommitService(testCase.fields.c, nil, nil, nil)
+
+			gotResult, gotResponse, err := newService.Gets(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalCommitServiceImpl_Get(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		repoSlug  string
+		commit    string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/commit/a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.CommitScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/commit/a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the commit is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoCommit,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewCommitService(testCase.fields.c, nil, nil, nil)
+
+			gotResult, gotResponse, err := newService.Get(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.commit)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalCommitServiceImpl_DiffStat(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		repoSlug  string
+		spec      string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				spec:      "feature/login..main",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/diffstat/feature%2Flogin..main",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.DiffStatPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				spec:      "feature/login..main",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/diffstat/feature%2Flogin..main",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "",
+				repoSlug:  "repository-sample",
+				spec:      "feature/login..main",
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "",
+				spec:      "feature/login..main",
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the diff spec is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				spec:      "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoDiffSpec,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewCommitService(testCase.fields.c, nil, nil, nil)
+
+			gotResult, gotResponse, err := newService.DiffStat(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.spec)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalCommitServiceImpl_Diff(t *testing.T) {
+
+	client := mocks.NewConnector(t)
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodGet,
+		"2.0/repositories/work-space-name-sample/repository-sample/diff/feature%2Flogin..main",
+		"", nil).
+		Return(&http.Request{}, nil)
+
+	client.On("Do", &http.Request{}).
+		Return(&http.Response{
+			StatusCode: http.StatusNotFound,
+			Body:       io.NopCloser(strings.NewReader(`{"type": "error", "error": {"message": "Revision not found"}}`)),
+		}, nil)
+
+	newService := NewCommitService(client, nil, nil, nil)
+
+	reader, err := newService.Diff(context.Background(), "work-space-name-sample", "repository-sample", "feature/login..main")
+	assert.Nil(t, reader)
+
+	var apiErr *model.APIError
+	assert.True(t, errors.As(err, &apiErr))
+
+	_, err = newService.Diff(context.Background(), "work-space-name-sample", "repository-sample", "")
+	assert.True(t, errors.Is(err, model.ErrNoDiffSpec))
+}
@@ -0,0 +1,388 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewCommitReportService handles communication with the Code Insights report related methods of the Bitbucket API.
+func NewCommitReportService(client service.Connector) *CommitReportService {
+
+	return &CommitReportService{
+		internalClient: &internalCommitReportServiceImpl{c: client},
+		c:              client,
+	}
+}
+
+// CommitReportService handles communication with the Code Insights report related methods of the Bitbucket API.
+type CommitReportService struct {
+	internalClient bitbucket.CommitReportConnector
+	c              service.Connector
+}
+
+// Gets returns a paginated list of the reports of the specified commit.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/reports
+func (r *CommitReportService) Gets(ctx context.Context, workspace, repoSlug, commit string) (*model.CommitReportPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Gets(ctx, workspace, repoSlug, commit)
+}
+
+// GetsAll iterates over all the reports of the specified commit.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/reports
+func (r *CommitReportService) GetsAll(ctx context.Context, workspace, repoSlug, commit string, opts ...paginate.Option) iter.Seq2[*model.CommitReportScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.CommitReportScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.CommitReportScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	if commit == "" {
+		return paginateError[*model.CommitReportScheme](fmt.Errorf("bitbucket: %w", model.ErrNoCommit))
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/commit/%v/reports", workspace, repoSlug, commit)
+	return paginateLinks[*model.CommitReportScheme](ctx, r.c, endpoint, opts)
+}
+
+// Get returns the specified report.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/reports/{reportId}
+func (r *CommitReportService) Get(ctx context.Context, workspace, repoSlug, commit, reportID string) (*model.CommitReportScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Get(ctx, workspace, repoSlug, commit, reportID)
+}
+
+// Create creates the specified report, replacing the existing report with the same ID and its annotations.
+//
+// PUT /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/reports/{reportId}
+func (r *CommitReportService) Create(ctx context.Context, workspace, repoSlug, commit, reportID string, payload *model.CommitReportScheme) (*model.CommitReportScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Create(ctx, workspace, repoSlug, commit, reportID, payload)
+}
+
+// Delete deletes the specified report and its annotations.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/reports/{reportId}
+func (r *CommitReportService) Delete(ctx context.Context, workspace, repoSlug, commit, reportID string) (*model.ResponseScheme, error) {
+	return r.internalClient.Delete(ctx, workspace, repoSlug, commit, reportID)
+}
+
+// Annotations returns a paginated list of the annotations of the specified report.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/reports/{reportId}/annotations
+func (r *CommitReportService) Annotations(ctx context.Context, workspace, repoSlug, commit, reportID string) (*model.CommitReportAnnotationPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Annotations(ctx, workspace, repoSlug, commit, reportID)
+}
+
+// AnnotationsAll iterates over all the annotations of the specified report.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/reports/{reportId}/annotations
+func (r *CommitReportService) AnnotationsAll(ctx context.Context, workspace, repoSlug, commit, reportID string, opts ...paginate.Option) iter.Seq2[*model.CommitReportAnnotationScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.CommitReportAnnotationScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.CommitReportAnnotationScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	if commit == "" {
+		return paginateError[*model.CommitReportAnnotationScheme](fmt.Errorf("bitbucket: %w", model.ErrNoCommit))
+	}
+
+	if reportID == "" {
+		return paginateError[*model.CommitReportAnnotationScheme](fmt.Errorf("bitbucket: %w", model.ErrNoCommitReportID))
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/commit/%v/reports/%v/annotations", workspace, repoSlug, commit, url.PathEscape(reportID))
+	return paginateLinks[*model.CommitReportAnnotationScheme](ctx, r.c, endpoint, opts)
+}
+
+// AddAnnotations adds the annotations to the specified report, replacing the ones with the same external IDs.
+//
+// A report can have up to 1000 annotations, they are sent in chunks of up to 100, the limit of a single request. The annotations created are returned
+// with the response of the last request, when a request fails the ones created by the previous requests are returned.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/reports/{reportId}/annotations
+func (r *CommitReportService) AddAnnotations(ctx context.Context, workspace, repoSlug, commit, reportID string, annotations []*model.CommitReportAnnotationScheme) ([]*model.CommitReportAnnotationScheme, *model.ResponseScheme, error) {
+	return r.internalClient.AddAnnotations(ctx, workspace, repoSlug, commit, reportID, annotations)
+}
+
+// DeleteAnnotation deletes the specified annotation.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/reports/{reportId}/annotations/{annotationId}
+func (r *CommitReportService) DeleteAnnotation(ctx context.Context, workspace, repoSlug, commit, reportID, annotationID string) (*model.ResponseScheme, error) {
+	return r.internalClient.DeleteAnnotation(ctx, workspace, repoSlug, commit, reportID, annotationID)
+}
+
+type internalCommitReportServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the reports of the specified commit.
+func (i *internalCommitReportServiceImpl) Gets(ctx context.Context, workspace, repoSlug, commit string) (*model.CommitReportPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if commit == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommit)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/commit/%v/reports", workspace, repoSlug, commit)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.CommitReportPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Get returns the specified report.
+func (i *internalCommitReportServiceImpl) Get(ctx context.Context, workspace, repoSlug, commit, reportID string) (*model.CommitReportScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if commit == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommit)
+	}
+
+	if reportID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommitReportID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/commit/%v/reports/%v", workspace, repoSlug, commit, url.PathEscape(reportID))
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	report := new(model.CommitReportScheme)
+	response, err := i.c.Call(request, report)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return report, response, nil
+}
+
+// Create creates or replaces the specified report.
+func (i *internalCommitReportServiceImpl) Create(ctx context.Context, workspace, repoSlug, commit, reportID string, payload *model.CommitReportScheme) (*model.CommitReportScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if commit == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommit)
+	}
+
+	if reportID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommitReportID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/commit/%v/reports/%v", workspace, repoSlug, commit, url.PathEscape(reportID))
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	report := new(model.CommitReportScheme)
+	response, err := i.c.Call(request, report)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return report, response, nil
+}
+
+// Delete deletes the specified report and its annotations.
+func (i *internalCommitReportServiceImpl) Delete(ctx context.Context, workspace, repoSlug, commit, reportID string) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if commit == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommit)
+	}
+
+	if reportID == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommitReportID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/commit/%v/reports/%v", workspace, repoSlug, commit, url.PathEscape(reportID))
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+// Annotations returns a paginated list of the annotations of the specified report.
+func (i *internalCommitReportServiceImpl) Annotations(ctx context.Context, workspace, repoSlug, commit, reportID string) (*model.CommitReportAnnotationPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if commit == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommit)
+	}
+
+	if reportID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommitReportID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/commit/%v/reports/%v/annotations", workspace, repoSlug, commit, url.PathEscape(reportID))
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.CommitReportAnnotationPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// AddAnnotations adds the annotations to the specified report, in chunks of up to 100.
+func (i *internalCommitReportServiceImpl) AddAnnotations(ctx context.Context, workspace, repoSlug, commit, reportID string, annotations []*model.CommitReportAnnotationScheme) ([]*model.CommitReportAnnotationScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if commit == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommit)
+	}
+
+	if reportID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommitReportID)
+	}
+
+	if len(annotations) == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommitReportAnnotations)
+	}
+
+	if len(annotations) > model.MaxCommitReportAnnotations {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrTooManyCommitReportAnnotations)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/commit/%v/reports/%v/annotations", workspace, repoSlug, commit, url.PathEscape(reportID))
+
+	var (
+		created  []*model.CommitReportAnnotationScheme
+		response *model.ResponseScheme
+	)
+
+	for start := 0; start < len(annotations); start += model.MaxCommitReportAnnotationsPerRequest {
+
+		end := min(start+model.MaxCommitReportAnnotationsPerRequest, len(annotations))
+
+		request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", annotations[start:end])
+		if err != nil {
+			return created, response, err
+		}
+
+		var chunk []*model.CommitReportAnnotationScheme
+		response, err = i.c.Call(request, &chunk)
+		if err != nil {
+			return created, response, err
+		}
+
+		created = append(created, chunk...)
+	}
+
+	return created, response, nil
+}
+
+// DeleteAnnotation deletes the specified annotation.
+func (i *internalCommitReportServiceImpl) DeleteAnnotation(ctx context.Context, workspace, repoSlug, commit, reportID, annotationID string) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if commit == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommit)
+	}
+
+	if reportID == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommitReportID)
+	}
+
+	if annotationID == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommitReportAnnotationID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/commit/%v/reports/%v/annotations/%v", workspace, repoSlug, commit, url.PathEscape(reportID), url.PathEscape(annotationID))
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
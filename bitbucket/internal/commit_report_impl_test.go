@@ -0,0 +1,1093 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func Test_internalCommitReportServiceImpl_Gets(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		repoSlug  string
+		commit    string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/commit/a4b4c8e1f0d9/reports",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.CommitReportPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/commit/a4b4c8e1f0d9/reports",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9",
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "",
+				commit:    "a4b4c8e1f0d9",
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the commit is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoCommit,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewCommitReportService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Gets(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.commit)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalCommitReportServiceImpl_Get(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		repoSlug  string
+		commit    string
+		reportID  string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9",
+				reportID:  "sonar-scan-001",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/commit/a4b4c8e1f0d9/reports/sonar-scan-001",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.CommitReportScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9",
+				reportID:  "sonar-scan-001",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/commit/a4b4c8e1f0d9/reports/sonar-scan-001",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9",
+				reportID:  "sonar-scan-001",
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "",
+				commit:    "a4b4c8e1f0d9",
+				reportID:  "sonar-scan-001",
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the commit is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "",
+				reportID:  "sonar-scan-001",
+			},
+			wantErr: true,
+			Err:     model.ErrNoCommit,
+		},
+
+		{
+			name: "when the report id is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9",
+				reportID:  "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoCommitReportID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewCommitReportService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Get(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.commit, testCase.args.reportID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalCommitReportServiceImpl_Create(t *testing.T) {
+
+	payloadMocked := &model.CommitReportScheme{
+		Title:      "SonarQube analysis",
+		Details:    "3 new issues found",
+		ReportType: "BUG",
+		Result:     "FAILED",
+		Data: []*model.CommitReportDataScheme{
+			{Type: model.CommitReportDataPercentage, Title: "Coverage", Value: 82.5},
+			{Type: model.CommitReportDataLink, Title: "Dashboard", Value: &model.CommitReportLinkScheme{Text: "Open", Href: "https://sonar.example.com"}},
+		},
+	}
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		repoSlug  string
+		commit    string
+		reportID  string
+		payload   *model.CommitReportScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9",
+				reportID:  "sonar-scan-001",
+				payload:   payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"2.0/repositories/work-space-name-sample/repository-sample/commit/a4b4c8e1f0d9/reports/sonar-scan-001",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.CommitReportScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9",
+				reportID:  "sonar-scan-001",
+				payload:   payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"2.0/repositories/work-space-name-sample/repository-sample/commit/a4b4c8e1f0d9/reports/sonar-scan-001",
+					"", payloadMocked).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9",
+				reportID:  "sonar-scan-001",
+				payload:   payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "",
+				commit:    "a4b4c8e1f0d9",
+				reportID:  "sonar-scan-001",
+				payload:   payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the commit is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "",
+				reportID:  "sonar-scan-001",
+				payload:   payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoCommit,
+		},
+
+		{
+			name: "when the report id is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9",
+				reportID:  "",
+				payload:   payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoCommitReportID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewCommitReportService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Create(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.commit, testCase.args.reportID, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalCommitReportServiceImpl_Delete(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		repoSlug  string
+		commit    string
+		reportID  string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9",
+				reportID:  "sonar-scan-001",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"2.0/repositories/work-space-name-sample/repository-sample/commit/a4b4c8e1f0d9/reports/sonar-scan-001",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9",
+				reportID:  "sonar-scan-001",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"2.0/repositories/work-space-name-sample/repository-sample/commit/a4b4c8e1f0d9/reports/sonar-scan-001",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9",
+				reportID:  "sonar-scan-001",
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "",
+				commit:    "a4b4c8e1f0d9",
+				reportID:  "sonar-scan-001",
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the commit is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "",
+				reportID:  "sonar-scan-001",
+			},
+			wantErr: true,
+			Err:     model.ErrNoCommit,
+		},
+
+		{
+			name: "when the report id is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9",
+				reportID:  "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoCommitReportID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewCommitReportService(testCase.fields.c)
+
+			gotResponse, err := newService.Delete(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.commit, testCase.args.reportID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalCommitReportServiceImpl_Annotations(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		repoSlug  string
+		commit    string
+		reportID  string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9",
+				reportID:  "sonar-scan-001",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/commit/a4b4c8e1f0d9/reports/sonar-scan-001/annotations",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.CommitReportAnnotationPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9",
+				reportID:  "sonar-scan-001",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/commit/a4b4c8e1f0d9/reports/sonar-scan-001/annotations",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9",
+				reportID:  "sonar-scan-001",
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "",
+				commit:    "a4b4c8e1f0d9",
+				reportID:  "sonar-scan-001",
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the commit is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "",
+				reportID:  "sonar-scan-001",
+			},
+			wantErr: true,
+			Err:     model.ErrNoCommit,
+		},
+
+		{
+			name: "when the report id is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9",
+				reportID:  "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoCommitReportID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewCommitReportService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Annotations(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.commit, testCase.args.reportID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalCommitReportServiceImpl_DeleteAnnotation(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx          context.Context
+		workspace    string
+		repoSlug     string
+		commit       string
+		reportID     string
+		annotationID string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "repository-sample",
+				commit:       "a4b4c8e1f0d9",
+				reportID:     "sonar-scan-001",
+				annotationID: "sonar-issue-42",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"2.0/repositories/work-space-name-sample/repository-sample/commit/a4b4c8e1f0d9/reports/sonar-scan-001/annotations/sonar-issue-42",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "repository-sample",
+				commit:       "a4b4c8e1f0d9",
+				reportID:     "sonar-scan-001",
+				annotationID: "sonar-issue-42",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"2.0/repositories/work-space-name-sample/repository-sample/commit/a4b4c8e1f0d9/reports/sonar-scan-001/annotations/sonar-issue-42",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "",
+				repoSlug:     "repository-sample",
+				commit:       "a4b4c8e1f0d9",
+				reportID:     "sonar-scan-001",
+				annotationID: "sonar-issue-42",
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "",
+				commit:       "a4b4c8e1f0d9",
+				reportID:     "sonar-scan-001",
+				annotationID: "sonar-issue-42",
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the commit is not provided",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "repository-sample",
+				commit:       "",
+				reportID:     "sonar-scan-001",
+				annotationID: "sonar-issue-42",
+			},
+			wantErr: true,
+			Err:     model.ErrNoCommit,
+		},
+
+		{
+			name: "when the report id is not provided",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "repository-sample",
+				commit:       "a4b4c8e1f0d9",
+				reportID:     "",
+				annotationID: "sonar-issue-42",
+			},
+			wantErr: true,
+			Err:     model.ErrNoCommitReportID,
+		},
+
+		{
+			name: "when the annotation id is not provided",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "repository-sample",
+				commit:       "a4b4c8e1f0d9",
+				reportID:     "sonar-scan-001",
+				annotationID: "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoCommitReportAnnotationID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewCommitReportService(testCase.fields.c)
+
+			gotResponse, err := newService.DeleteAnnotation(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.commit, testCase.args.reportID, testCase.args.annotationID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalCommitReportServiceImpl_AddAnnotations(t *testing.T) {
+
+	endpoint := "2.0/repositories/work-space-name-sample/repository-sample/commit/a4b4c8e1f0d9/reports/sonar-scan-001/annotations"
+
+	annotations := make([]*model.CommitReportAnnotationScheme, 250)
+	for index := range annotations {
+		annotations[index] = &model.CommitReportAnnotationScheme{
+			ExternalID:     fmt.Sprintf("sonar-issue-%d", index),
+			AnnotationType: "CODE_SMELL",
+			Summary:        "Cognitive complexity too high",
+			Path:           "service/handler.go",
+			Line:           index + 1,
+		}
+	}
+
+	t.Run("when the annotations are sent in chunks", func(t *testing.T) {
+
+		client := mocks.NewConnector(t)
+
+		var requests int
+		for _, chunk := range [][]*model.CommitReportAnnotationScheme{annotations[:100], annotations[100:200], annotations[200:]} {
+
+			request := &http.Request{Header: http.Header{"Chunk": {chunk[0].ExternalID}}}
+
+			client.On("NewRequest",
+				context.Background(),
+				http.MethodPost,
+				endpoint,
+				"", chunk).
+				Run(func(mock.Arguments) { requests++ }).
+				Return(request, nil).
+				Once()
+
+			client.On("Call", request, mock.Anything).
+				Run(func(args mock.Arguments) {
+					*args.Get(1).(*[]*model.CommitReportAnnotationScheme) = chunk
+				}).
+				Return(&model.ResponseScheme{}, nil).
+				Once()
+		}
+
+		newService := NewCommitReportService(client)
+
+		created, response, err := newService.AddAnnotations(context.Background(), "work-space-name-sample", "repository-sample", "a4b4c8e1f0d9", "sonar-scan-001", annotations)
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+		assert.Equal(t, 3, requests)
+		assert.Len(t, created, 250)
+		assert.Equal(t, "sonar-issue-249", created[249].ExternalID)
+	})
+
+	t.Run("when a chunk cannot be created", func(t *testing.T) {
+
+		client := mocks.NewConnector(t)
+
+		request := &http.Request{}
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			endpoint,
+			"", annotations[:100]).
+			Return(request, nil).
+			Once()
+
+		client.On("Call", request, mock.Anything).
+			Run(func(args mock.Arguments) {
+				*args.Get(1).(*[]*model.CommitReportAnnotationScheme) = annotations[:100]
+			}).
+			Return(&model.ResponseScheme{}, nil).
+			Once()
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			endpoint,
+			"", annotations[100:200]).
+			Return(&http.Request{}, model.ErrCreateHttpReq).
+			Once()
+
+		newService := NewCommitReportService(client)
+
+		created, _, err := newService.AddAnnotations(context.Background(), "work-space-name-sample", "repository-sample", "a4b4c8e1f0d9", "sonar-scan-001", annotations)
+		assert.True(t, errors.Is(err, model.ErrCreateHttpReq))
+		assert.Len(t, created, 100)
+	})
+
+	t.Run("when the annotations are not provided", func(t *testing.T) {
+
+		newService := NewCommitReportService(mocks.NewConnector(t))
+
+		_, _, err := newService.AddAnnotations(context.Background(), "work-space-name-sample", "repository-sample", "a4b4c8e1f0d9", "sonar-scan-001", nil)
+		assert.True(t, errors.Is(err, model.ErrNoCommitReportAnnotations))
+	})
+
+	t.Run("when more annotations than a report can have are provided", func(t *testing.T) {
+
+		newService := NewCommitReportService(mocks.NewConnector(t))
+
+		tooMany := make([]*model.CommitReportAnnotationScheme, model.MaxCommitReportAnnotations+1)
+		_, _, err := newService.AddAnnotations(context.Background(), "work-space-name-sample", "repository-sample", "a4b4c8e1f0d9", "sonar-scan-001", tooMany)
+		assert.True(t, errors.Is(err, model.ErrTooManyCommitReportAnnotations))
+	})
+}
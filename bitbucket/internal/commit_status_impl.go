@@ -0,0 +1,218 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewCommitStatusService handles communication with the commit build status related methods of the Bitbucket API.
+func NewCommitStatusService(client service.Connector) *CommitStatusService {
+
+	return &CommitStatusService{
+		internalClient: &internalCommitStatusServiceImpl{c: client},
+		c:              client,
+	}
+}
+
+// CommitStatusService handles communication with the commit build status related methods of the Bitbucket API.
+type CommitStatusService struct {
+	internalClient bitbucket.CommitStatusConnector
+	c              service.Connector
+}
+
+// Gets returns a paginated list of the statuses of the specified commit.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/statuses
+func (c *CommitStatusService) Gets(ctx context.Context, workspace, repoSlug, commit string) (*model.CommitStatusPageScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Gets(ctx, workspace, repoSlug, commit)
+}
+
+// GetsAll iterates over all the statuses of the specified commit.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/statuses
+func (c *CommitStatusService) GetsAll(ctx context.Context, workspace, repoSlug, commit string, opts ...paginate.Option) iter.Seq2[*model.CommitStatusScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.CommitStatusScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.CommitStatusScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	if commit == "" {
+		return paginateError[*model.CommitStatusScheme](fmt.Errorf("bitbucket: %w", model.ErrNoCommit))
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/commit/%v/statuses", workspace, repoSlug, commit)
+	return paginateLinks[*model.CommitStatusScheme](ctx, c.c, endpoint, opts)
+}
+
+// Get returns the specified build status of a commit.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/statuses/build/{key}
+func (c *CommitStatusService) Get(ctx context.Context, workspace, repoSlug, commit, key string) (*model.CommitStatusScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Get(ctx, workspace, repoSlug, commit, key)
+}
+
+// Create creates a new build status for the specified commit.
+//
+// The key of the payload identifies the build, use Update to report the next states of the same build.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/statuses/build
+func (c *CommitStatusService) Create(ctx context.Context, workspace, repoSlug, commit string, payload *model.CommitStatusPayloadScheme) (*model.CommitStatusScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Create(ctx, workspace, repoSlug, commit, payload)
+}
+
+// Update updates the specified build status of a commit.
+//
+// PUT /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/statuses/build/{key}
+func (c *CommitStatusService) Update(ctx context.Context, workspace, repoSlug, commit, key string, payload *model.CommitStatusPayloadScheme) (*model.CommitStatusScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Update(ctx, workspace, repoSlug, commit, key, payload)
+}
+
+type internalCommitStatusServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the statuses of the specified commit.
+func (i *internalCommitStatusServiceImpl) Gets(ctx context.Context, workspace, repoSlug, commit string) (*model.CommitStatusPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if commit == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommit)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/commit/%v/statuses", workspace, repoSlug, commit)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.CommitStatusPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Get returns the specified build status of a commit.
+func (i *internalCommitStatusServiceImpl) Get(ctx context.Context, workspace, repoSlug, commit, key string) (*model.CommitStatusScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if commit == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommit)
+	}
+
+	if key == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoBuildStatusKey)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/commit/%v/statuses/build/%v", workspace, repoSlug, commit, url.PathEscape(key))
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := new(model.CommitStatusScheme)
+	response, err := i.c.Call(request, status)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return status, response, nil
+}
+
+// Create creates a new build status for the specified commit.
+func (i *internalCommitStatusServiceImpl) Create(ctx context.Context, workspace, repoSlug, commit string, payload *model.CommitStatusPayloadScheme) (*model.CommitStatusScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if commit == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommit)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/commit/%v/statuses/build", workspace, repoSlug, commit)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := new(model.CommitStatusScheme)
+	response, err := i.c.Call(request, status)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return status, response, nil
+}
+
+// Update updates the specified build status of a commit.
+func (i *internalCommitStatusServiceImpl) Update(ctx context.Context, workspace, repoSlug, commit, key string, payload *model.CommitStatusPayloadScheme) (*model.CommitStatusScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if commit == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommit)
+	}
+
+	if key == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoBuildStatusKey)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/commit/%v/statuses/build/%v", workspace, repoSlug, commit, url.PathEscape(key))
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := new(model.CommitStatusScheme)
+	response, err := i.c.Call(request, status)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return status, response, nil
+}
@@ -0,0 +1,639 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func Test_internalCommitStatusServiceImpl_Gets(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		repoSlug  string
+		commit    string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/commit/a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0/statuses",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.CommitStatusPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/commit/a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0/statuses",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the commit is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoCommit,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewCommitStatusService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Gets(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.commit)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalCommitStatusServiceImpl_Get(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		repoSlug  string
+		commit    string
+		key       string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+				key:       "ci/unit-tests",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/commit/a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0/statuses/build/ci%2Funit-tests",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.CommitStatusScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+				key:       "ci/unit-tests",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/commit/a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0/statuses/build/ci%2Funit-tests",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+				key:       "ci/unit-tests",
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+				key:       "ci/unit-tests",
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the commit is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "",
+				key:       "ci/unit-tests",
+			},
+			wantErr: true,
+			Err:     model.ErrNoCommit,
+		},
+
+		{
+			name: "when the build status key is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+				key:       "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoBuildStatusKey,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewCommitStatusService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Get(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.commit, testCase.args.key)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalCommitStatusServiceImpl_Create(t *testing.T) {
+
+	payloadMocked := &model.CommitStatusPayloadScheme{
+		Key:   "ci/unit-tests",
+		State: "INPROGRESS",
+		URL:   "https://ci.example.com/builds/512",
+		Name:  "Unit tests",
+	}
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		repoSlug  string
+		commit    string
+		payload   *model.CommitStatusPayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+				payload:   payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"2.0/repositories/work-space-name-sample/repository-sample/commit/a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0/statuses/build",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.CommitStatusScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+				payload:   payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"2.0/repositories/work-space-name-sample/repository-sample/commit/a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0/statuses/build",
+					"", payloadMocked).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+				payload:   payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+				payload:   payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the commit is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "",
+				payload:   payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoCommit,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewCommitStatusService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Create(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.commit, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalCommitStatusServiceImpl_Update(t *testing.T) {
+
+	payloadMocked := &model.CommitStatusPayloadScheme{
+		Key:   "ci/unit-tests",
+		State: "INPROGRESS",
+		URL:   "https://ci.example.com/builds/512",
+		Name:  "Unit tests",
+	}
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		repoSlug  string
+		commit    string
+		key       string
+		payload   *model.CommitStatusPayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+				key:       "ci/unit-tests",
+				payload:   payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"2.0/repositories/work-space-name-sample/repository-sample/commit/a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0/statuses/build/ci%2Funit-tests",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.CommitStatusScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+				key:       "ci/unit-tests",
+				payload:   payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"2.0/repositories/work-space-name-sample/repository-sample/commit/a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0/statuses/build/ci%2Funit-tests",
+					"", payloadMocked).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+				key:       "ci/unit-tests",
+				payload:   payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+				key:       "ci/unit-tests",
+				payload:   payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the commit is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "",
+				key:       "ci/unit-tests",
+				payload:   payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoCommit,
+		},
+
+		{
+			name: "when the build status key is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				commit:    "a4b4c8e1f0d9e3c2b1a0f9e8d7c6b5a4f3e2d1c0",
+				key:       "",
+				payload:   payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoBuildStatusKey,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewCommitStatusService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Update(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.commit, testCase.args.key, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
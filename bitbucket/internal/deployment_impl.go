@@ -0,0 +1,123 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewDeploymentService handles communication with the deployment related methods of the Bitbucket API.
+func NewDeploymentService(client service.Connector) *DeploymentService {
+
+	return &DeploymentService{
+		internalClient: &internalDeploymentServiceImpl{c: client},
+		c:              client,
+	}
+}
+
+// DeploymentService handles communication with the deployment related methods of the Bitbucket API.
+type DeploymentService struct {
+	internalClient bitbucket.DeploymentConnector
+	c              service.Connector
+}
+
+// Gets returns a paginated list of the deployments of the specified repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/deployments
+func (d *DeploymentService) Gets(ctx context.Context, workspace, repoSlug string) (*model.DeploymentPageScheme, *model.ResponseScheme, error) {
+	return d.internalClient.Gets(ctx, workspace, repoSlug)
+}
+
+// GetsAll iterates over all the deployments of the specified repository.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/deployments
+func (d *DeploymentService) GetsAll(ctx context.Context, workspace, repoSlug string, opts ...paginate.Option) iter.Seq2[*model.DeploymentScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.DeploymentScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.DeploymentScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/deployments", workspace, repoSlug)
+	return paginateLinks[*model.DeploymentScheme](ctx, d.c, endpoint, opts)
+}
+
+// Get returns the specified deployment.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/deployments/{deployment_uuid}
+func (d *DeploymentService) Get(ctx context.Context, workspace, repoSlug, deploymentUUID string) (*model.DeploymentScheme, *model.ResponseScheme, error) {
+	return d.internalClient.Get(ctx, workspace, repoSlug, deploymentUUID)
+}
+
+type internalDeploymentServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the deployments of the specified repository.
+func (i *internalDeploymentServiceImpl) Gets(ctx context.Context, workspace, repoSlug string) (*model.DeploymentPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/deployments", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.DeploymentPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Get returns the specified deployment.
+func (i *internalDeploymentServiceImpl) Get(ctx context.Context, workspace, repoSlug, deploymentUUID string) (*model.DeploymentScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if deploymentUUID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoDeploymentUUID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/deployments/%v", workspace, repoSlug, deploymentUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deployment := new(model.DeploymentScheme)
+	response, err := i.c.Call(request, deployment)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return deployment, response, nil
+}
@@ -0,0 +1,34 @@
+package internal
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+)
+
+// download sends a GET request to the endpoint and returns the response body as a reader,
+// without buffering it in memory. The caller must close the reader.
+func download(ctx context.Context, c service.Connector, endpoint string) (io.ReadCloser, error) {
+
+	request, err := c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		defer response.Body.Close()
+
+		body, _ := io.ReadAll(response.Body)
+		return nil, model.NewAPIError(response, body)
+	}
+
+	return response.Body, nil
+}
@@ -0,0 +1,223 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewEnvironmentService handles communication with the deployment environment related methods of the Bitbucket API.
+func NewEnvironmentService(client service.Connector) *EnvironmentService {
+
+	return &EnvironmentService{
+		internalClient: &internalEnvironmentServiceImpl{c: client},
+		c:              client,
+	}
+}
+
+// EnvironmentService handles communication with the deployment environment related methods of the Bitbucket API.
+type EnvironmentService struct {
+	internalClient bitbucket.EnvironmentConnector
+	c              service.Connector
+}
+
+// Gets returns a paginated list of the deployment environments of the specified repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/environments
+func (e *EnvironmentService) Gets(ctx context.Context, workspace, repoSlug string) (*model.DeploymentEnvironmentPageScheme, *model.ResponseScheme, error) {
+	return e.internalClient.Gets(ctx, workspace, repoSlug)
+}
+
+// GetsAll iterates over all the deployment environments of the specified repository.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/environments
+func (e *EnvironmentService) GetsAll(ctx context.Context, workspace, repoSlug string, opts ...paginate.Option) iter.Seq2[*model.DeploymentEnvironmentScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.DeploymentEnvironmentScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.DeploymentEnvironmentScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/environments", workspace, repoSlug)
+	return paginateLinks[*model.DeploymentEnvironmentScheme](ctx, e.c, endpoint, opts)
+}
+
+// Get returns the specified deployment environment.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/environments/{environment_uuid}
+func (e *EnvironmentService) Get(ctx context.Context, workspace, repoSlug, environmentUUID string) (*model.DeploymentEnvironmentScheme, *model.ResponseScheme, error) {
+	return e.internalClient.Get(ctx, workspace, repoSlug, environmentUUID)
+}
+
+// Create creates a new deployment environment on the specified repository.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/environments
+func (e *EnvironmentService) Create(ctx context.Context, workspace, repoSlug string, payload *model.DeploymentEnvironmentPayloadScheme) (*model.DeploymentEnvironmentScheme, *model.ResponseScheme, error) {
+	return e.internalClient.Create(ctx, workspace, repoSlug, payload)
+}
+
+// Update changes the name, the rank, the visibility and the restrictions of the specified deployment environment.
+//
+// The changes are applied asynchronously, Bitbucket accepts them with a 202 status.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/environments/{environment_uuid}/changes
+func (e *EnvironmentService) Update(ctx context.Context, workspace, repoSlug, environmentUUID string, payload *model.DeploymentEnvironmentUpdatePayloadScheme) (*model.ResponseScheme, error) {
+	return e.internalClient.Update(ctx, workspace, repoSlug, environmentUUID, payload)
+}
+
+// Delete deletes the specified deployment environment, its deployment variables included.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/environments/{environment_uuid}
+func (e *EnvironmentService) Delete(ctx context.Context, workspace, repoSlug, environmentUUID string) (*model.ResponseScheme, error) {
+	return e.internalClient.Delete(ctx, workspace, repoSlug, environmentUUID)
+}
+
+type internalEnvironmentServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the deployment environments of the specified repository.
+func (i *internalEnvironmentServiceImpl) Gets(ctx context.Context, workspace, repoSlug string) (*model.DeploymentEnvironmentPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/environments", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.DeploymentEnvironmentPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Get returns the specified deployment environment.
+func (i *internalEnvironmentServiceImpl) Get(ctx context.Context, workspace, repoSlug, environmentUUID string) (*model.DeploymentEnvironmentScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if environmentUUID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoEnvironmentUUID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/environments/%v", workspace, repoSlug, environmentUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	environment := new(model.DeploymentEnvironmentScheme)
+	response, err := i.c.Call(request, environment)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return environment, response, nil
+}
+
+// Create creates a new deployment environment on the specified repository.
+func (i *internalEnvironmentServiceImpl) Create(ctx context.Context, workspace, repoSlug string, payload *model.DeploymentEnvironmentPayloadScheme) (*model.DeploymentEnvironmentScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/environments", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	environment := new(model.DeploymentEnvironmentScheme)
+	response, err := i.c.Call(request, environment)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return environment, response, nil
+}
+
+// Update changes the name, the rank, the visibility and the restrictions of the specified deployment environment.
+func (i *internalEnvironmentServiceImpl) Update(ctx context.Context, workspace, repoSlug, environmentUUID string, payload *model.DeploymentEnvironmentUpdatePayloadScheme) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if environmentUUID == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoEnvironmentUUID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/environments/%v/changes", workspace, repoSlug, environmentUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+// Delete deletes the specified deployment environment, its deployment variables included.
+func (i *internalEnvironmentServiceImpl) Delete(ctx context.Context, workspace, repoSlug, environmentUUID string) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if environmentUUID == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoEnvironmentUUID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/environments/%v", workspace, repoSlug, environmentUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
@@ -0,0 +1,201 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"net/url"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewIssueAttachmentService handles communication with the repository issue attachment related methods of the Bitbucket API.
+func NewIssueAttachmentService(client service.Connector) *IssueAttachmentService {
+
+	return &IssueAttachmentService{
+		internalClient: &internalIssueAttachmentServiceImpl{c: client},
+		c:              client,
+	}
+}
+
+// IssueAttachmentService handles communication with the repository issue attachment related methods of the Bitbucket API.
+type IssueAttachmentService struct {
+	internalClient bitbucket.IssueAttachmentConnector
+	c              service.Connector
+}
+
+// Gets returns a paginated list of the files attached to the specified issue.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/issues/{issue_id}/attachments
+func (a *IssueAttachmentService) Gets(ctx context.Context, workspace, repoSlug string, issueID int) (*model.RepositoryIssueAttachmentPageScheme, *model.ResponseScheme, error) {
+	return a.internalClient.Gets(ctx, workspace, repoSlug, issueID)
+}
+
+// GetsAll iterates over all the files attached to the specified issue.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/issues/{issue_id}/attachments
+func (a *IssueAttachmentService) GetsAll(ctx context.Context, workspace, repoSlug string, issueID int, opts ...paginate.Option) iter.Seq2[*model.RepositoryIssueAttachmentScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.RepositoryIssueAttachmentScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.RepositoryIssueAttachmentScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	if issueID == 0 {
+		return paginateError[*model.RepositoryIssueAttachmentScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepositoryIssueID))
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/issues/%v/attachments", workspace, repoSlug, issueID)
+	return paginateLinks[*model.RepositoryIssueAttachmentScheme](ctx, a.c, endpoint, opts)
+}
+
+// Upload attaches a file to the specified issue, replacing the attachment with the same name.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/issues/{issue_id}/attachments
+func (a *IssueAttachmentService) Upload(ctx context.Context, workspace, repoSlug string, issueID int, fileName string, file io.Reader) (*model.ResponseScheme, error) {
+	return a.internalClient.Upload(ctx, workspace, repoSlug, issueID, fileName, file)
+}
+
+// Download returns the contents of the specified attachment as a reader, the caller must close it.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/issues/{issue_id}/attachments/{path}
+func (a *IssueAttachmentService) Download(ctx context.Context, workspace, repoSlug string, issueID int, fileName string) (io.ReadCloser, error) {
+	return a.internalClient.Download(ctx, workspace, repoSlug, issueID, fileName)
+}
+
+// Delete deletes the specified attachment.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/issues/{issue_id}/attachments/{path}
+func (a *IssueAttachmentService) Delete(ctx context.Context, workspace, repoSlug string, issueID int, fileName string) (*model.ResponseScheme, error) {
+	return a.internalClient.Delete(ctx, workspace, repoSlug, issueID, fileName)
+}
+
+type internalIssueAttachmentServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the files attached to the specified issue.
+func (i *internalIssueAttachmentServiceImpl) Gets(ctx context.Context, workspace, repoSlug string, issueID int) (*model.RepositoryIssueAttachmentPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if issueID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepositoryIssueID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/issues/%v/attachments", workspace, repoSlug, issueID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.RepositoryIssueAttachmentPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Upload attaches a file to the specified issue, replacing the attachment with the same name.
+func (i *internalIssueAttachmentServiceImpl) Upload(ctx context.Context, workspace, repoSlug string, issueID int, fileName string, file io.Reader) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if issueID == 0 {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepositoryIssueID)
+	}
+
+	if fileName == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoFileName)
+	}
+
+	if file == nil {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoFileReader)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/issues/%v/attachments", workspace, repoSlug, issueID)
+
+	request, err := newMultipartRequest(ctx, i.c, http.MethodPost, endpoint, nil, "file", []formFile{{name: fileName, content: file}})
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+// Download returns the contents of the specified attachment as a reader, the caller must close it.
+func (i *internalIssueAttachmentServiceImpl) Download(ctx context.Context, workspace, repoSlug string, issueID int, fileName string) (io.ReadCloser, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if issueID == 0 {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepositoryIssueID)
+	}
+
+	if fileName == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoFileName)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/issues/%v/attachments/%v", workspace, repoSlug, issueID, url.PathEscape(fileName))
+	return download(ctx, i.c, endpoint)
+}
+
+// Delete deletes the specified attachment.
+func (i *internalIssueAttachmentServiceImpl) Delete(ctx context.Context, workspace, repoSlug string, issueID int, fileName string) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if issueID == 0 {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepositoryIssueID)
+	}
+
+	if fileName == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoFileName)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/issues/%v/attachments/%v", workspace, repoSlug, issueID, url.PathEscape(fileName))
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
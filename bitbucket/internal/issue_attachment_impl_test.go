@@ -0,0 +1,392 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func Test_internalIssueAttachmentServiceImpl_Gets(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		repoSlug  string
+		issueID   int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				issueID:   42,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/issues/42/attachments",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.RepositoryIssueAttachmentPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				issueID:   42,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/issues/42/attachments",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "",
+				repoSlug:  "repository-sample",
+				issueID:   42,
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "",
+				issueID:   42,
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the issue id is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				issueID:   0,
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepositoryIssueID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewIssueAttachmentService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Gets(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.issueID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalIssueAttachmentServiceImpl_Delete(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		repoSlug  string
+		issueID   int
+		fileName  string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				issueID:   42,
+				fileName:  "stack trace.log",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"2.0/repositories/work-space-name-sample/repository-sample/issues/42/attachments/stack%20trace.log",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				issueID:   42,
+				fileName:  "stack trace.log",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"2.0/repositories/work-space-name-sample/repository-sample/issues/42/attachments/stack%20trace.log",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "",
+				repoSlug:  "repository-sample",
+				issueID:   42,
+				fileName:  "stack trace.log",
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "",
+				issueID:   42,
+				fileName:  "stack trace.log",
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the issue id is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				issueID:   0,
+				fileName:  "stack trace.log",
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepositoryIssueID,
+		},
+
+		{
+			name: "when the file name is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				issueID:   42,
+				fileName:  "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoFileName,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewIssueAttachmentService(testCase.fields.c)
+
+			gotResponse, err := newService.Delete(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.issueID, testCase.args.fileName)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalIssueAttachmentServiceImpl_Upload(t *testing.T) {
+
+	client := mocks.NewConnector(t)
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodPost,
+		"2.0/repositories/work-space-name-sample/repository-sample/issues/42/attachments",
+		mock.MatchedBy(func(typ string) bool { return strings.HasPrefix(typ, "multipart/form-data; boundary=") }),
+		mock.AnythingOfType("*bytes.Buffer")).
+		Run(func(args mock.Arguments) {
+
+			_, params, err := mime.ParseMediaType(args.String(3))
+			assert.NoError(t, err)
+
+			form, err := multipart.NewReader(args.Get(4).(*bytes.Buffer), params["boundary"]).ReadForm(1 << 20)
+			assert.NoError(t, err)
+
+			files := form.File["file"]
+			if assert.Len(t, files, 1) {
+				assert.Equal(t, "stack trace.log", files[0].Filename)
+
+				file, err := files[0].Open()
+				assert.NoError(t, err)
+				contents, _ := io.ReadAll(file)
+				assert.Equal(t, "panic: runtime error", string(contents))
+			}
+		}).
+		Return(&http.Request{}, nil)
+
+	client.On("Call", &http.Request{}, nil).
+		Return(&model.ResponseScheme{}, nil)
+
+	newService := NewIssueAttachmentService(client)
+
+	response, err := newService.Upload(context.Background(), "work-space-name-sample", "repository-sample", 42, "stack trace.log", strings.NewReader("panic: runtime error"))
+	assert.NoError(t, err)
+	assert.NotNil(t, response)
+
+	_, err = newService.Upload(context.Background(), "work-space-name-sample", "repository-sample", 42, "stack trace.log", nil)
+	assert.True(t, errors.Is(err, model.ErrNoFileReader))
+}
+
+func Test_internalIssueAttachmentServiceImpl_Download(t *testing.T) {
+
+	client := mocks.NewConnector(t)
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodGet,
+		"2.0/repositories/work-space-name-sample/repository-sample/issues/42/attachments/stack%20trace.log",
+		"", nil).
+		Return(&http.Request{}, nil)
+
+	client.On("Do", &http.Request{}).
+		Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader("panic: runtime error")),
+		}, nil)
+
+	newService := NewIssueAttachmentService(client)
+
+	reader, err := newService.Download(context.Background(), "work-space-name-sample", "repository-sample", 42, "stack trace.log")
+	assert.NoError(t, err)
+	defer reader.Close()
+
+	contents, err := io.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, "panic: runtime error", string(contents))
+
+	_, err = newService.Download(context.Background(), "work-space-name-sample", "repository-sample", 0, "stack trace.log")
+	assert.True(t, errors.Is(err, model.ErrNoRepositoryIssueID))
+}
@@ -0,0 +1,173 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewIssueChangeService handles communication with the repository issue change related methods of the Bitbucket API.
+func NewIssueChangeService(client service.Connector) *IssueChangeService {
+
+	return &IssueChangeService{
+		internalClient: &internalIssueChangeServiceImpl{c: client},
+		c:              client,
+	}
+}
+
+// IssueChangeService handles communication with the repository issue change related methods of the Bitbucket API.
+type IssueChangeService struct {
+	internalClient bitbucket.IssueChangeConnector
+	c              service.Connector
+}
+
+// Gets returns a paginated list of the changes of the attributes of the specified issue, the oldest first.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/issues/{issue_id}/changes
+func (c *IssueChangeService) Gets(ctx context.Context, workspace, repoSlug string, issueID int) (*model.RepositoryIssueChangePageScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Gets(ctx, workspace, repoSlug, issueID)
+}
+
+// GetsAll iterates over all the changes of the attributes of the specified issue.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/issues/{issue_id}/changes
+func (c *IssueChangeService) GetsAll(ctx context.Context, workspace, repoSlug string, issueID int, opts ...paginate.Option) iter.Seq2[*model.RepositoryIssueChangeScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.RepositoryIssueChangeScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.RepositoryIssueChangeScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	if issueID == 0 {
+		return paginateError[*model.RepositoryIssueChangeScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepositoryIssueID))
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/issues/%v/changes", workspace, repoSlug, issueID)
+	return paginateLinks[*model.RepositoryIssueChangeScheme](ctx, c.c, endpoint, opts)
+}
+
+// Get returns the specified issue change.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/issues/{issue_id}/changes/{change_id}
+func (c *IssueChangeService) Get(ctx context.Context, workspace, repoSlug string, issueID int, changeID string) (*model.RepositoryIssueChangeScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Get(ctx, workspace, repoSlug, issueID, changeID)
+}
+
+// Create changes the attributes of the specified issue, e.g. to transition its state, and records the change.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/issues/{issue_id}/changes
+func (c *IssueChangeService) Create(ctx context.Context, workspace, repoSlug string, issueID int, payload *model.RepositoryIssueChangePayloadScheme) (*model.RepositoryIssueChangeScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Create(ctx, workspace, repoSlug, issueID, payload)
+}
+
+type internalIssueChangeServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the changes of the attributes of the specified issue, the oldest first.
+func (i *internalIssueChangeServiceImpl) Gets(ctx context.Context, workspace, repoSlug string, issueID int) (*model.RepositoryIssueChangePageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if issueID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepositoryIssueID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/issues/%v/changes", workspace, repoSlug, issueID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.RepositoryIssueChangePageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Get returns the specified issue change.
+func (i *internalIssueChangeServiceImpl) Get(ctx context.Context, workspace, repoSlug string, issueID int, changeID string) (*model.RepositoryIssueChangeScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if issueID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepositoryIssueID)
+	}
+
+	if changeID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoIssueChangeID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/issues/%v/changes/%v", workspace, repoSlug, issueID, changeID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	change := new(model.RepositoryIssueChangeScheme)
+	response, err := i.c.Call(request, change)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return change, response, nil
+}
+
+// Create changes the attributes of the specified issue.
+func (i *internalIssueChangeServiceImpl) Create(ctx context.Context, workspace, repoSlug string, issueID int, payload *model.RepositoryIssueChangePayloadScheme) (*model.RepositoryIssueChangeScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if issueID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepositoryIssueID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/issues/%v/changes", workspace, repoSlug, issueID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	change := new(model.RepositoryIssueChangeScheme)
+	response, err := i.c.Call(request, change)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return change, response, nil
+}
@@ -0,0 +1,251 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewIssueCommentService handles communication with the repository issue comment related methods of the Bitbucket API.
+func NewIssueCommentService(client service.Connector) *IssueCommentService {
+
+	return &IssueCommentService{
+		internalClient: &internalIssueCommentServiceImpl{c: client},
+		c:              client,
+	}
+}
+
+// IssueCommentService handles communication with the repository issue comment related methods of the Bitbucket API.
+type IssueCommentService struct {
+	internalClient bitbucket.IssueCommentConnector
+	c              service.Connector
+}
+
+// Gets returns a paginated list of the comments on the specified issue.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/issues/{issue_id}/comments
+func (c *IssueCommentService) Gets(ctx context.Context, workspace, repoSlug string, issueID int) (*model.RepositoryIssueCommentPageScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Gets(ctx, workspace, repoSlug, issueID)
+}
+
+// GetsAll iterates over all the comments on the specified issue.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/issues/{issue_id}/comments
+func (c *IssueCommentService) GetsAll(ctx context.Context, workspace, repoSlug string, issueID int, opts ...paginate.Option) iter.Seq2[*model.RepositoryIssueCommentScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.RepositoryIssueCommentScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.RepositoryIssueCommentScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	if issueID == 0 {
+		return paginateError[*model.RepositoryIssueCommentScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepositoryIssueID))
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/issues/%v/comments", workspace, repoSlug, issueID)
+	return paginateLinks[*model.RepositoryIssueCommentScheme](ctx, c.c, endpoint, opts)
+}
+
+// Get returns the specified issue comment.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/issues/{issue_id}/comments/{comment_id}
+func (c *IssueCommentService) Get(ctx context.Context, workspace, repoSlug string, issueID, commentID int) (*model.RepositoryIssueCommentScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Get(ctx, workspace, repoSlug, issueID, commentID)
+}
+
+// Create creates a new comment on the specified issue.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/issues/{issue_id}/comments
+func (c *IssueCommentService) Create(ctx context.Context, workspace, repoSlug string, issueID int, payload *model.RepositoryIssueCommentPayloadScheme) (*model.RepositoryIssueCommentScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Create(ctx, workspace, repoSlug, issueID, payload)
+}
+
+// Update updates the content of the specified issue comment.
+//
+// PUT /2.0/repositories/{workspace}/{repo_slug}/issues/{issue_id}/comments/{comment_id}
+func (c *IssueCommentService) Update(ctx context.Context, workspace, repoSlug string, issueID, commentID int, payload *model.RepositoryIssueCommentPayloadScheme) (*model.RepositoryIssueCommentScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Update(ctx, workspace, repoSlug, issueID, commentID, payload)
+}
+
+// Delete deletes the specified issue comment.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/issues/{issue_id}/comments/{comment_id}
+func (c *IssueCommentService) Delete(ctx context.Context, workspace, repoSlug string, issueID, commentID int) (*model.ResponseScheme, error) {
+	return c.internalClient.Delete(ctx, workspace, repoSlug, issueID, commentID)
+}
+
+type internalIssueCommentServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the comments on the specified issue.
+func (i *internalIssueCommentServiceImpl) Gets(ctx context.Context, workspace, repoSlug string, issueID int) (*model.RepositoryIssueCommentPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if issueID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepositoryIssueID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/issues/%v/comments", workspace, repoSlug, issueID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.RepositoryIssueCommentPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Get returns the specified issue comment.
+func (i *internalIssueCommentServiceImpl) Get(ctx context.Context, workspace, repoSlug string, issueID, commentID int) (*model.RepositoryIssueCommentScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if issueID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepositoryIssueID)
+	}
+
+	if commentID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommentID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/issues/%v/comments/%v", workspace, repoSlug, issueID, commentID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comment := new(model.RepositoryIssueCommentScheme)
+	response, err := i.c.Call(request, comment)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return comment, response, nil
+}
+
+// Create creates a new comment on the specified issue.
+func (i *internalIssueCommentServiceImpl) Create(ctx context.Context, workspace, repoSlug string, issueID int, payload *model.RepositoryIssueCommentPayloadScheme) (*model.RepositoryIssueCommentScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if issueID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepositoryIssueID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/issues/%v/comments", workspace, repoSlug, issueID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comment := new(model.RepositoryIssueCommentScheme)
+	response, err := i.c.Call(request, comment)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return comment, response, nil
+}
+
+// Update updates the content of the specified issue comment.
+func (i *internalIssueCommentServiceImpl) Update(ctx context.Context, workspace, repoSlug string, issueID, commentID int, payload *model.RepositoryIssueCommentPayloadScheme) (*model.RepositoryIssueCommentScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if issueID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepositoryIssueID)
+	}
+
+	if commentID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommentID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/issues/%v/comments/%v", workspace, repoSlug, issueID, commentID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comment := new(model.RepositoryIssueCommentScheme)
+	response, err := i.c.Call(request, comment)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return comment, response, nil
+}
+
+// Delete deletes the specified issue comment.
+func (i *internalIssueCommentServiceImpl) Delete(ctx context.Context, workspace, repoSlug string, issueID, commentID int) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if issueID == 0 {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepositoryIssueID)
+	}
+
+	if commentID == 0 {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommentID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/issues/%v/comments/%v", workspace, repoSlug, issueID, commentID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
@@ -0,0 +1,257 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewIssueService handles communication with the repository issue tracker related methods of the Bitbucket API.
+func NewIssueService(client service.Connector, comment *IssueCommentService, attachment *IssueAttachmentService, change *IssueChangeService) *IssueService {
+
+	return &IssueService{
+		internalClient: &internalIssueServiceImpl{c: client},
+		c:              client,
+		Comment:        comment,
+		Attachment:     attachment,
+		Change:         change,
+	}
+}
+
+// IssueService handles communication with the repository issue tracker related methods of the Bitbucket API.
+type IssueService struct {
+	internalClient bitbucket.IssueConnector
+	c              service.Connector
+	Comment        *IssueCommentService
+	Attachment     *IssueAttachmentService
+	Change         *IssueChangeService
+}
+
+// Gets returns a paginated list of the issues of the specified repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/issues
+func (i *IssueService) Gets(ctx context.Context, workspace, repoSlug string, options *model.RepositoryIssueOptionsScheme) (*model.RepositoryIssuePageScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Gets(ctx, workspace, repoSlug, options)
+}
+
+// GetsAll iterates over all the issues of the specified repository matching the options.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/issues
+func (i *IssueService) GetsAll(ctx context.Context, workspace, repoSlug string, options *model.RepositoryIssueOptionsScheme, opts ...paginate.Option) iter.Seq2[*model.RepositoryIssueScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.RepositoryIssueScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.RepositoryIssueScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	endpoint := issueListEndpoint(fmt.Sprintf("2.0/repositories/%v/%v/issues", workspace, repoSlug), options)
+	return paginateLinks[*model.RepositoryIssueScheme](ctx, i.c, endpoint, opts)
+}
+
+// Get returns the specified issue.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/issues/{issue_id}
+func (i *IssueService) Get(ctx context.Context, workspace, repoSlug string, issueID int) (*model.RepositoryIssueScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Get(ctx, workspace, repoSlug, issueID)
+}
+
+// Create creates a new issue, the authenticated user is the reporter.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/issues
+func (i *IssueService) Create(ctx context.Context, workspace, repoSlug string, payload *model.RepositoryIssuePayloadScheme) (*model.RepositoryIssueScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Create(ctx, workspace, repoSlug, payload)
+}
+
+// Update updates the attributes of the specified issue.
+//
+// PUT /2.0/repositories/{workspace}/{repo_slug}/issues/{issue_id}
+func (i *IssueService) Update(ctx context.Context, workspace, repoSlug string, issueID int, payload *model.RepositoryIssuePayloadScheme) (*model.RepositoryIssueScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Update(ctx, workspace, repoSlug, issueID, payload)
+}
+
+// Delete deletes the specified issue.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/issues/{issue_id}
+func (i *IssueService) Delete(ctx context.Context, workspace, repoSlug string, issueID int) (*model.ResponseScheme, error) {
+	return i.internalClient.Delete(ctx, workspace, repoSlug, issueID)
+}
+
+type internalIssueServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the issues of the specified repository.
+func (i *internalIssueServiceImpl) Gets(ctx context.Context, workspace, repoSlug string, options *model.RepositoryIssueOptionsScheme) (*model.RepositoryIssuePageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := issueListEndpoint(fmt.Sprintf("2.0/repositories/%v/%v/issues", workspace, repoSlug), options)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.RepositoryIssuePageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Get returns the specified issue.
+func (i *internalIssueServiceImpl) Get(ctx context.Context, workspace, repoSlug string, issueID int) (*model.RepositoryIssueScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if issueID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepositoryIssueID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/issues/%v", workspace, repoSlug, issueID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issue := new(model.RepositoryIssueScheme)
+	response, err := i.c.Call(request, issue)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return issue, response, nil
+}
+
+// Create creates a new issue.
+func (i *internalIssueServiceImpl) Create(ctx context.Context, workspace, repoSlug string, payload *model.RepositoryIssuePayloadScheme) (*model.RepositoryIssueScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/issues", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issue := new(model.RepositoryIssueScheme)
+	response, err := i.c.Call(request, issue)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return issue, response, nil
+}
+
+// Update updates the attributes of the specified issue.
+func (i *internalIssueServiceImpl) Update(ctx context.Context, workspace, repoSlug string, issueID int, payload *model.RepositoryIssuePayloadScheme) (*model.RepositoryIssueScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if issueID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepositoryIssueID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/issues/%v", workspace, repoSlug, issueID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issue := new(model.RepositoryIssueScheme)
+	response, err := i.c.Call(request, issue)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return issue, response, nil
+}
+
+// Delete deletes the specified issue.
+func (i *internalIssueServiceImpl) Delete(ctx context.Context, workspace, repoSlug string, issueID int) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if issueID == 0 {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepositoryIssueID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/issues/%v", workspace, repoSlug, issueID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+// issueListEndpoint appends the query and sort filters to the endpoint listing issues.
+func issueListEndpoint(base string, options *model.RepositoryIssueOptionsScheme) string {
+
+	if options == nil {
+		return base
+	}
+
+	params := url.Values{}
+	if options.Query != "" {
+		params.Add("q", options.Query)
+	}
+
+	if options.Sort != "" {
+		params.Add("sort", options.Sort)
+	}
+
+	if len(params) == 0 {
+		return base
+	}
+
+	return fmt.Sprintf("%v?%v", base, params.Encode())
+}
@@ -0,0 +1,62 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"sort"
+
+	"github.com/ctreminiom/go-atlassian/v2/service"
+)
+
+// formFile represents a file sent in a multipart form.
+type formFile struct {
+	name    string
+	content io.Reader
+}
+
+// newMultipartRequest creates a request sending the fields and the files as a multipart form,
+// the files are sent in the parts named after the field.
+//
+// The form is buffered in a *bytes.Buffer, so the client sends it as is with the form content type.
+func newMultipartRequest(ctx context.Context, c service.Connector, method, endpoint string, fields url.Values, field string, files []formFile) (*http.Request, error) {
+
+	reader := &bytes.Buffer{}
+	writer := multipart.NewWriter(reader)
+
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		for _, value := range fields[key] {
+			if err := writer.WriteField(key, value); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, file := range files {
+
+		part, err := writer.CreateFormFile(field, file.name)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err = io.Copy(part, file.content); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return c.NewRequest(ctx, method, endpoint, writer.FormDataContentType(), reader)
+}
@@ -0,0 +1,63 @@
+package internal
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+)
+
+// pageScheme is the envelope shared by the Bitbucket paginated collections.
+type pageScheme[T any] struct {
+	Size   int    `json:"size,omitempty"`
+	Next   string `json:"next,omitempty"`
+	Values []T    `json:"values,omitempty"`
+}
+
+// paginateLinks iterates over a Bitbucket paginated collection.
+//
+// The first page is requested from the endpoint, using the page size as pagelen,
+// the following pages are requested from the next links returned by Bitbucket.
+func paginateLinks[T any](ctx context.Context, c service.Connector, endpoint string, options []paginate.Option) iter.Seq2[T, error] {
+	return paginate.Cursor(ctx, func(ctx context.Context, next string, limit int) (*paginate.Page[T], error) {
+
+		link := next
+		if link == "" {
+
+			u, err := url.Parse(endpoint)
+			if err != nil {
+				return nil, err
+			}
+
+			params := u.Query()
+			params.Set("pagelen", strconv.Itoa(limit))
+			u.RawQuery = params.Encode()
+
+			link = u.String()
+		}
+
+		request, err := c.NewRequest(ctx, http.MethodGet, link, "", nil)
+		if err != nil {
+			return nil, err
+		}
+
+		page := new(pageScheme[T])
+		if _, err = c.Call(request, page); err != nil {
+			return nil, err
+		}
+
+		return &paginate.Page[T]{Items: page.Values, Total: page.Size, Next: page.Next}, nil
+	}, options...)
+}
+
+// paginateError returns an iterator yielding the error, used when the parameters of an iteration are invalid.
+func paginateError[T any](err error) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		yield(zero, err)
+	}
+}
@@ -0,0 +1,79 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func TestRepositoryService_GetsAll(t *testing.T) {
+
+	next := "https://api.bitbucket.org/2.0/repositories/work-space-name-sample?page=2&pagelen=2&q=is_private+%3D+true"
+
+	firstRequest, _ := http.NewRequest(http.MethodGet, "https://api.bitbucket.org/first", nil)
+	secondRequest, _ := http.NewRequest(http.MethodGet, next, nil)
+
+	client := mocks.NewConnector(t)
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodGet,
+		"2.0/repositories/work-space-name-sample?pagelen=2&q=is_private+%3D+true",
+		"", nil).
+		Return(firstRequest, nil)
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodGet,
+		next,
+		"", nil).
+		Return(secondRequest, nil)
+
+	client.On("Call", firstRequest, mock.Anything).
+		Run(func(args mock.Arguments) {
+			page := args.Get(1).(*pageScheme[*model.RepositoryScheme])
+			page.Size, page.Next = 3, next
+			page.Values = []*model.RepositoryScheme{{Slug: "first"}, {Slug: "second"}}
+		}).
+		Return(&model.ResponseScheme{}, nil)
+
+	client.On("Call", secondRequest, mock.Anything).
+		Run(func(args mock.Arguments) {
+			page := args.Get(1).(*pageScheme[*model.RepositoryScheme])
+			page.Size = 3
+			page.Values = []*model.RepositoryScheme{{Slug: "third"}}
+		}).
+		Return(&model.ResponseScheme{}, nil)
+
+	service := NewRepositoryService(client, nil)
+	options := &model.RepositoryOptionsScheme{Query: "is_private = true"}
+
+	var slugs []string
+	for repository, err := range service.GetsAll(context.Background(), "work-space-name-sample", options, paginate.WithPageSize(2)) {
+		assert.NoError(t, err)
+		slugs = append(slugs, repository.Slug)
+	}
+
+	assert.Equal(t, []string{"first", "second", "third"}, slugs)
+}
+
+func TestRepositoryService_GetsAll_NoWorkspace(t *testing.T) {
+
+	service := NewRepositoryService(mocks.NewConnector(t), nil)
+
+	var calls int
+	for _, err := range service.GetsAll(context.Background(), "", nil) {
+		calls++
+		assert.True(t, errors.Is(err, model.ErrNoWorkspace))
+	}
+
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,209 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewPipelineDeploymentVariableService handles communication with the deployment pipeline variable related methods of the Bitbucket API.
+func NewPipelineDeploymentVariableService(client service.Connector) *PipelineDeploymentVariableService {
+
+	return &PipelineDeploymentVariableService{
+		internalClient: &internalPipelineDeploymentVariableServiceImpl{c: client},
+		c:              client,
+	}
+}
+
+// PipelineDeploymentVariableService handles communication with the deployment pipeline variable related methods of the Bitbucket API.
+type PipelineDeploymentVariableService struct {
+	internalClient bitbucket.PipelineDeploymentVariableConnector
+	c              service.Connector
+}
+
+// Gets returns a paginated list of the pipeline variables of the specified deployment environment.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/deployments_config/environments/{environment_uuid}/variables
+func (p *PipelineDeploymentVariableService) Gets(ctx context.Context, workspace, repoSlug, environmentUUID string) (*model.PipelineVariablePageScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Gets(ctx, workspace, repoSlug, environmentUUID)
+}
+
+// GetsAll iterates over all the pipeline variables of the specified deployment environment.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/deployments_config/environments/{environment_uuid}/variables
+func (p *PipelineDeploymentVariableService) GetsAll(ctx context.Context, workspace, repoSlug, environmentUUID string, opts ...paginate.Option) iter.Seq2[*model.PipelineVariableScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.PipelineVariableScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.PipelineVariableScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	if environmentUUID == "" {
+		return paginateError[*model.PipelineVariableScheme](fmt.Errorf("bitbucket: %w", model.ErrNoEnvironmentUUID))
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/deployments_config/environments/%v/variables", workspace, repoSlug, environmentUUID)
+	return paginateLinks[*model.PipelineVariableScheme](ctx, p.c, endpoint, opts)
+}
+
+// Create creates a deployment pipeline variable.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/deployments_config/environments/{environment_uuid}/variables
+func (p *PipelineDeploymentVariableService) Create(ctx context.Context, workspace, repoSlug, environmentUUID string, payload *model.PipelineVariableScheme) (*model.PipelineVariableScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Create(ctx, workspace, repoSlug, environmentUUID, payload)
+}
+
+// Update updates the specified deployment pipeline variable.
+//
+// PUT /2.0/repositories/{workspace}/{repo_slug}/deployments_config/environments/{environment_uuid}/variables/{variable_uuid}
+func (p *PipelineDeploymentVariableService) Update(ctx context.Context, workspace, repoSlug, environmentUUID, variableUUID string, payload *model.PipelineVariableScheme) (*model.PipelineVariableScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Update(ctx, workspace, repoSlug, environmentUUID, variableUUID, payload)
+}
+
+// Delete deletes the specified deployment pipeline variable.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/deployments_config/environments/{environment_uuid}/variables/{variable_uuid}
+func (p *PipelineDeploymentVariableService) Delete(ctx context.Context, workspace, repoSlug, environmentUUID, variableUUID string) (*model.ResponseScheme, error) {
+	return p.internalClient.Delete(ctx, workspace, repoSlug, environmentUUID, variableUUID)
+}
+
+type internalPipelineDeploymentVariableServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the pipeline variables of the specified deployment environment.
+func (i *internalPipelineDeploymentVariableServiceImpl) Gets(ctx context.Context, workspace, repoSlug, environmentUUID string) (*model.PipelineVariablePageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if environmentUUID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoEnvironmentUUID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/deployments_config/environments/%v/variables", workspace, repoSlug, environmentUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.PipelineVariablePageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Create creates a deployment pipeline variable.
+func (i *internalPipelineDeploymentVariableServiceImpl) Create(ctx context.Context, workspace, repoSlug, environmentUUID string, payload *model.PipelineVariableScheme) (*model.PipelineVariableScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if environmentUUID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoEnvironmentUUID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/deployments_config/environments/%v/variables", workspace, repoSlug, environmentUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	variable := new(model.PipelineVariableScheme)
+	response, err := i.c.Call(request, variable)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return variable, response, nil
+}
+
+// Update updates the specified deployment pipeline variable.
+func (i *internalPipelineDeploymentVariableServiceImpl) Update(ctx context.Context, workspace, repoSlug, environmentUUID, variableUUID string, payload *model.PipelineVariableScheme) (*model.PipelineVariableScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if environmentUUID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoEnvironmentUUID)
+	}
+
+	if variableUUID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoVariableUUID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/deployments_config/environments/%v/variables/%v", workspace, repoSlug, environmentUUID, variableUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	variable := new(model.PipelineVariableScheme)
+	response, err := i.c.Call(request, variable)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return variable, response, nil
+}
+
+// Delete deletes the specified deployment pipeline variable.
+func (i *internalPipelineDeploymentVariableServiceImpl) Delete(ctx context.Context, workspace, repoSlug, environmentUUID, variableUUID string) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if environmentUUID == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoEnvironmentUUID)
+	}
+
+	if variableUUID == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoVariableUUID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/deployments_config/environments/%v/variables/%v", workspace, repoSlug, environmentUUID, variableUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
@@ -0,0 +1,636 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func Test_internalPipelineDeploymentVariableServiceImpl_Gets(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx             context.Context
+		workspace       string
+		repoSlug        string
+		environmentUUID string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:             context.Background(),
+				workspace:       "work-space-name-sample",
+				repoSlug:        "repository-sample",
+				environmentUUID: "{a1d3e7b2-9c4f-4e1a-b5d6-7f8e9a0b1c2d}",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/deployments_config/environments/{a1d3e7b2-9c4f-4e1a-b5d6-7f8e9a0b1c2d}/variables",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.PipelineVariablePageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:             context.Background(),
+				workspace:       "work-space-name-sample",
+				repoSlug:        "repository-sample",
+				environmentUUID: "{a1d3e7b2-9c4f-4e1a-b5d6-7f8e9a0b1c2d}",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/deployments_config/environments/{a1d3e7b2-9c4f-4e1a-b5d6-7f8e9a0b1c2d}/variables",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:             context.Background(),
+				workspace:       "",
+				repoSlug:        "repository-sample",
+				environmentUUID: "{a1d3e7b2-9c4f-4e1a-b5d6-7f8e9a0b1c2d}",
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:             context.Background(),
+				workspace:       "work-space-name-sample",
+				repoSlug:        "",
+				environmentUUID: "{a1d3e7b2-9c4f-4e1a-b5d6-7f8e9a0b1c2d}",
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the environment uuid is not provided",
+			args: args{
+				ctx:             context.Background(),
+				workspace:       "work-space-name-sample",
+				repoSlug:        "repository-sample",
+				environmentUUID: "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoEnvironmentUUID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPipelineDeploymentVariableService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Gets(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.environmentUUID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPipelineDeploymentVariableServiceImpl_Create(t *testing.T) {
+
+	payloadMocked := &model.PipelineVariableScheme{
+		Key:     "DEPLOY_TOKEN",
+		Value:   "s3cr3t",
+		Secured: true,
+	}
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx             context.Context
+		workspace       string
+		repoSlug        string
+		environmentUUID string
+		payload         *model.PipelineVariableScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:             context.Background(),
+				workspace:       "work-space-name-sample",
+				repoSlug:        "repository-sample",
+				environmentUUID: "{a1d3e7b2-9c4f-4e1a-b5d6-7f8e9a0b1c2d}",
+				payload:         payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"2.0/repositories/work-space-name-sample/repository-sample/deployments_config/environments/{a1d3e7b2-9c4f-4e1a-b5d6-7f8e9a0b1c2d}/variables",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.PipelineVariableScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:             context.Background(),
+				workspace:       "work-space-name-sample",
+				repoSlug:        "repository-sample",
+				environmentUUID: "{a1d3e7b2-9c4f-4e1a-b5d6-7f8e9a0b1c2d}",
+				payload:         payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"2.0/repositories/work-space-name-sample/repository-sample/deployments_config/environments/{a1d3e7b2-9c4f-4e1a-b5d6-7f8e9a0b1c2d}/variables",
+					"", payloadMocked).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:             context.Background(),
+				workspace:       "",
+				repoSlug:        "repository-sample",
+				environmentUUID: "{a1d3e7b2-9c4f-4e1a-b5d6-7f8e9a0b1c2d}",
+				payload:         payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:             context.Background(),
+				workspace:       "work-space-name-sample",
+				repoSlug:        "",
+				environmentUUID: "{a1d3e7b2-9c4f-4e1a-b5d6-7f8e9a0b1c2d}",
+				payload:         payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the environment uuid is not provided",
+			args: args{
+				ctx:             context.Background(),
+				workspace:       "work-space-name-sample",
+				repoSlug:        "repository-sample",
+				environmentUUID: "",
+				payload:         payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoEnvironmentUUID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPipelineDeploymentVariableService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Create(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.environmentUUID, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPipelineDeploymentVariableServiceImpl_Update(t *testing.T) {
+
+	payloadMocked := &model.PipelineVariableScheme{
+		Key:     "DEPLOY_TOKEN",
+		Value:   "s3cr3t",
+		Secured: true,
+	}
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx             context.Context
+		workspace       string
+		repoSlug        string
+		environmentUUID string
+		variableUUID    string
+		payload         *model.PipelineVariableScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:             context.Background(),
+				workspace:       "work-space-name-sample",
+				repoSlug:        "repository-sample",
+				environmentUUID: "{a1d3e7b2-9c4f-4e1a-b5d6-7f8e9a0b1c2d}",
+				variableUUID:    "{2e1c7a0b-5a3c-4f8e-8d7b-6c9f1a2b3c4d}",
+				payload:         payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"2.0/repositories/work-space-name-sample/repository-sample/deployments_config/environments/{a1d3e7b2-9c4f-4e1a-b5d6-7f8e9a0b1c2d}/variables/{2e1c7a0b-5a3c-4f8e-8d7b-6c9f1a2b3c4d}",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.PipelineVariableScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:             context.Background(),
+				workspace:       "work-space-name-sample",
+				repoSlug:        "repository-sample",
+				environmentUUID: "{a1d3e7b2-9c4f-4e1a-b5d6-7f8e9a0b1c2d}",
+				variableUUID:    "{2e1c7a0b-5a3c-4f8e-8d7b-6c9f1a2b3c4d}",
+				payload:         payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"2.0/repositories/work-space-name-sample/repository-sample/deployments_config/environments/{a1d3e7b2-9c4f-4e1a-b5d6-7f8e9a0b1c2d}/variables/{2e1c7a0b-5a3c-4f8e-8d7b-6c9f1a2b3c4d}",
+					"", payloadMocked).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:             context.Background(),
+				workspace:       "",
+				repoSlug:        "repository-sample",
+				environmentUUID: "{a1d3e7b2-9c4f-4e1a-b5d6-7f8e9a0b1c2d}",
+				variableUUID:    "{2e1c7a0b-5a3c-4f8e-8d7b-6c9f1a2b3c4d}",
+				payload:         payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:             context.Background(),
+				workspace:       "work-space-name-sample",
+				repoSlug:        "",
+				environmentUUID: "{a1d3e7b2-9c4f-4e1a-b5d6-7f8e9a0b1c2d}",
+				variableUUID:    "{2e1c7a0b-5a3c-4f8e-8d7b-6c9f1a2b3c4d}",
+				payload:         payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the environment uuid is not provided",
+			args: args{
+				ctx:             context.Background(),
+				workspace:       "work-space-name-sample",
+				repoSlug:        "repository-sample",
+				environmentUUID: "",
+				variableUUID:    "{2e1c7a0b-5a3c-4f8e-8d7b-6c9f1a2b3c4d}",
+				payload:         payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoEnvironmentUUID,
+		},
+
+		{
+			name: "when the variable uuid is not provided",
+			args: args{
+				ctx:             context.Background(),
+				workspace:       "work-space-name-sample",
+				repoSlug:        "repository-sample",
+				environmentUUID: "{a1d3e7b2-9c4f-4e1a-b5d6-7f8e9a0b1c2d}",
+				variableUUID:    "",
+				payload:         payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoVariableUUID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPipelineDeploymentVariableService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Update(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.environmentUUID, testCase.args.variableUUID, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPipelineDeploymentVariableServiceImpl_Delete(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx             context.Context
+		workspace       string
+		repoSlug        string
+		environmentUUID string
+		variableUUID    string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:             context.Background(),
+				workspace:       "work-space-name-sample",
+				repoSlug:        "repository-sample",
+				environmentUUID: "{a1d3e7b2-9c4f-4e1a-b5d6-7f8e9a0b1c2d}",
+				variableUUID:    "{2e1c7a0b-5a3c-4f8e-8d7b-6c9f1a2b3c4d}",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"2.0/repositories/work-space-name-sample/repository-sample/deployments_config/environments/{a1d3e7b2-9c4f-4e1a-b5d6-7f8e9a0b1c2d}/variables/{2e1c7a0b-5a3c-4f8e-8d7b-6c9f1a2b3c4d}",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:             context.Background(),
+				workspace:       "work-space-name-sample",
+				repoSlug:        "repository-sample",
+				environmentUUID: "{a1d3e7b2-9c4f-4e1a-b5d6-7f8e9a0b1c2d}",
+				variableUUID:    "{2e1c7a0b-5a3c-4f8e-8d7b-6c9f1a2b3c4d}",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"2.0/repositories/work-space-name-sample/repository-sample/deployments_config/environments/{a1d3e7b2-9c4f-4e1a-b5d6-7f8e9a0b1c2d}/variables/{2e1c7a0b-5a3c-4f8e-8d7b-6c9f1a2b3c4d}",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:             context.Background(),
+				workspace:       "",
+				repoSlug:        "repository-sample",
+				environmentUUID: "{a1d3e7b2-9c4f-4e1a-b5d6-7f8e9a0b1c2d}",
+				variableUUID:    "{2e1c7a0b-5a3c-4f8e-8d7b-6c9f1a2b3c4d}",
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:             context.Background(),
+				workspace:       "work-space-name-sample",
+				repoSlug:        "",
+				environmentUUID: "{a1d3e7b2-9c4f-4e1a-b5d6-7f8e9a0b1c2d}",
+				variableUUID:    "{2e1c7a0b-5a3c-4f8e-8d7b-6c9f1a2b3c4d}",
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the environment uuid is not provided",
+			args: args{
+				ctx:             context.Background(),
+				workspace:       "work-space-name-sample",
+				repoSlug:        "repository-sample",
+				environmentUUID: "",
+				variableUUID:    "{2e1c7a0b-5a3c-4f8e-8d7b-6c9f1a2b3c4d}",
+			},
+			wantErr: true,
+			Err:     model.ErrNoEnvironmentUUID,
+		},
+
+		{
+			name: "when the variable uuid is not provided",
+			args: args{
+				ctx:             context.Background(),
+				workspace:       "work-space-name-sample",
+				repoSlug:        "repository-sample",
+				environmentUUID: "{a1d3e7b2-9c4f-4e1a-b5d6-7f8e9a0b1c2d}",
+				variableUUID:    "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoVariableUUID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPipelineDeploymentVariableService(testCase.fields.c)
+
+			gotResponse, err := newService.Delete(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.environmentUUID, testCase.args.variableUUID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
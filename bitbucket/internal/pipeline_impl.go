@@ -0,0 +1,264 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"time"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/poll"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+const (
+	// defaultPipelinePollInterval is the interval WaitForCompletion polls a pipeline at when none is provided.
+	defaultPipelinePollInterval = 10 * time.Second
+
+	// pipelineStateCompleted is the name of the state of a completed pipeline, whatever its result.
+	pipelineStateCompleted = "COMPLETED"
+)
+
+// NewPipelineService handles communication with the pipeline related methods of the Bitbucket API.
+func NewPipelineService(client service.Connector, step *PipelineStepService, variable *PipelineVariableService, workspaceVariable *PipelineWorkspaceVariableService, deploymentVariable *PipelineDeploymentVariableService) *PipelineService {
+
+	return &PipelineService{
+		internalClient:     &internalPipelineServiceImpl{c: client},
+		c:                  client,
+		Step:               step,
+		Variable:           variable,
+		WorkspaceVariable:  workspaceVariable,
+		DeploymentVariable: deploymentVariable,
+	}
+}
+
+// PipelineService handles communication with the pipeline related methods of the Bitbucket API.
+type PipelineService struct {
+	internalClient     bitbucket.PipelineConnector
+	c                  service.Connector
+	Step               *PipelineStepService
+	Variable           *PipelineVariableService
+	WorkspaceVariable  *PipelineWorkspaceVariableService
+	DeploymentVariable *PipelineDeploymentVariableService
+}
+
+// Gets returns a paginated list of the pipelines of the specified repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines
+func (p *PipelineService) Gets(ctx context.Context, workspace, repoSlug string, options *model.PipelineOptionsScheme) (*model.PipelinePageScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Gets(ctx, workspace, repoSlug, options)
+}
+
+// GetsAll iterates over all the pipelines of the specified repository matching the options.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines
+func (p *PipelineService) GetsAll(ctx context.Context, workspace, repoSlug string, options *model.PipelineOptionsScheme, opts ...paginate.Option) iter.Seq2[*model.PipelineScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.PipelineScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.PipelineScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	endpoint := pipelineListEndpoint(fmt.Sprintf("2.0/repositories/%v/%v/pipelines", workspace, repoSlug), options)
+	return paginateLinks[*model.PipelineScheme](ctx, p.c, endpoint, opts)
+}
+
+// Get returns the specified pipeline.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines/{pipeline_uuid}
+func (p *PipelineService) Get(ctx context.Context, workspace, repoSlug, pipelineUUID string) (*model.PipelineScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Get(ctx, workspace, repoSlug, pipelineUUID)
+}
+
+// WaitForCompletion polls the specified pipeline until it is completed and returns it,
+// the result of its state being SUCCESSFUL, FAILED, ERROR, STOPPED or EXPIRED.
+//
+// The pipeline is fetched every poll interval, every 10 seconds when the interval isn't positive.
+// When a request fails or the context is done, it returns the error along with the last fetched pipeline.
+//
+// A pipeline paused on a manual step doesn't complete, use a context with a deadline to bound the wait.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines/{pipeline_uuid}
+func (p *PipelineService) WaitForCompletion(ctx context.Context, workspace, repoSlug, pipelineUUID string, pollInterval time.Duration) (*model.PipelineScheme, error) {
+
+	if pollInterval <= 0 {
+		pollInterval = defaultPipelinePollInterval
+	}
+
+	return poll.Until(ctx, pollInterval, func(ctx context.Context) (*model.PipelineScheme, error) {
+		pipeline, _, err := p.internalClient.Get(ctx, workspace, repoSlug, pipelineUUID)
+		return pipeline, err
+	}, func(pipeline *model.PipelineScheme) bool {
+		return pipeline.State != nil && pipeline.State.Name == pipelineStateCompleted
+	})
+}
+
+// Trigger triggers a pipeline on the target of the payload: a branch, a tag or a commit.
+//
+// Set the selector of the target to run a custom pipeline, using the variables of the payload.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/pipelines
+func (p *PipelineService) Trigger(ctx context.Context, workspace, repoSlug string, payload *model.PipelinePayloadScheme) (*model.PipelineScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Trigger(ctx, workspace, repoSlug, payload)
+}
+
+// Stop signals the specified pipeline to stop.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/pipelines/{pipeline_uuid}/stopPipeline
+func (p *PipelineService) Stop(ctx context.Context, workspace, repoSlug, pipelineUUID string) (*model.ResponseScheme, error) {
+	return p.internalClient.Stop(ctx, workspace, repoSlug, pipelineUUID)
+}
+
+type internalPipelineServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the pipelines of the specified repository.
+func (i *internalPipelineServiceImpl) Gets(ctx context.Context, workspace, repoSlug string, options *model.PipelineOptionsScheme) (*model.PipelinePageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := pipelineListEndpoint(fmt.Sprintf("2.0/repositories/%v/%v/pipelines", workspace, repoSlug), options)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.PipelinePageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Get returns the specified pipeline.
+func (i *internalPipelineServiceImpl) Get(ctx context.Context, workspace, repoSlug, pipelineUUID string) (*model.PipelineScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if pipelineUUID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoPipelineUUID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pipelines/%v", workspace, repoSlug, pipelineUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pipeline := new(model.PipelineScheme)
+	response, err := i.c.Call(request, pipeline)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return pipeline, response, nil
+}
+
+// Trigger triggers a pipeline on the target of the payload: a branch, a tag or a commit.
+func (i *internalPipelineServiceImpl) Trigger(ctx context.Context, workspace, repoSlug string, payload *model.PipelinePayloadScheme) (*model.PipelineScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pipelines", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pipeline := new(model.PipelineScheme)
+	response, err := i.c.Call(request, pipeline)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return pipeline, response, nil
+}
+
+// Stop signals the specified pipeline to stop.
+func (i *internalPipelineServiceImpl) Stop(ctx context.Context, workspace, repoSlug, pipelineUUID string) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if pipelineUUID == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoPipelineUUID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pipelines/%v/stopPipeline", workspace, repoSlug, pipelineUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+// pipelineListEndpoint appends the branch, status, trigger type and sort filters to the endpoint listing pipelines.
+func pipelineListEndpoint(base string, options *model.PipelineOptionsScheme) string {
+
+	if options == nil {
+		return base
+	}
+
+	params := url.Values{}
+	if options.Branch != "" {
+		params.Add("target.branch", options.Branch)
+	}
+
+	if options.Status != "" {
+		params.Add("status", options.Status)
+	}
+
+	if options.TriggerType != "" {
+		params.Add("trigger_type", options.TriggerType)
+	}
+
+	if options.Sort != "" {
+		params.Add("sort", options.Sort)
+	}
+
+	if len(params) == 0 {
+		return base
+	}
+
+	return fmt.Sprintf("%v?%v", base, params.Encode())
+}
@@ -0,0 +1,645 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func Test_internalPipelineServiceImpl_Gets(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		repoSlug  string
+		options   *model.PipelineOptionsScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				options: &model.PipelineOptionsScheme{
+					Branch: "main",
+					Status: "COMPLETED",
+					Sort:   "-created_on",
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/pipelines?sort=-created_on&status=COMPLETED&target.branch=main",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.PipelinePageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				options: &model.PipelineOptionsScheme{
+					Branch: "main",
+					Status: "COMPLETED",
+					Sort:   "-created_on",
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/pipelines?sort=-created_on&status=COMPLETED&target.branch=main",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "",
+				repoSlug:  "repository-sample",
+				options: &model.PipelineOptionsScheme{
+					Branch: "main",
+					Status: "COMPLETED",
+					Sort:   "-created_on",
+				},
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "",
+				options: &model.PipelineOptionsScheme{
+					Branch: "main",
+					Status: "COMPLETED",
+					Sort:   "-created_on",
+				},
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPipelineService(testCase.fields.c, nil, nil, nil, nil)
+
+			gotResult, gotResponse, err := newService.Gets(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPipelineServiceImpl_Get(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx          context.Context
+		workspace    string
+		repoSlug     string
+		pipelineUUID string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "repository-sample",
+				pipelineUUID: "{5b29e1a8-7d8f-4e55-9a53-2f43c1e7f6a1}",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/pipelines/{5b29e1a8-7d8f-4e55-9a53-2f43c1e7f6a1}",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.PipelineScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "repository-sample",
+				pipelineUUID: "{5b29e1a8-7d8f-4e55-9a53-2f43c1e7f6a1}",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/pipelines/{5b29e1a8-7d8f-4e55-9a53-2f43c1e7f6a1}",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "",
+				repoSlug:     "repository-sample",
+				pipelineUUID: "{5b29e1a8-7d8f-4e55-9a53-2f43c1e7f6a1}",
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "",
+				pipelineUUID: "{5b29e1a8-7d8f-4e55-9a53-2f43c1e7f6a1}",
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the pipeline uuid is not provided",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "repository-sample",
+				pipelineUUID: "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoPipelineUUID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPipelineService(testCase.fields.c, nil, nil, nil, nil)
+
+			gotResult, gotResponse, err := newService.Get(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.pipelineUUID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPipelineServiceImpl_Trigger(t *testing.T) {
+
+	payloadMocked := &model.PipelinePayloadScheme{
+		Target: &model.PipelineTargetScheme{
+			Type:     "pipeline_ref_target",
+			RefType:  "branch",
+			RefName:  "main",
+			Selector: &model.PipelineSelectorScheme{Type: "custom", Pattern: "release"},
+		},
+		Variables: []*model.PipelineVariableScheme{{Key: "VERSION", Value: "2.4.0"}},
+	}
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		repoSlug  string
+		payload   *model.PipelinePayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				payload:   payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"2.0/repositories/work-space-name-sample/repository-sample/pipelines",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.PipelineScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				payload:   payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"2.0/repositories/work-space-name-sample/repository-sample/pipelines",
+					"", payloadMocked).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "",
+				repoSlug:  "repository-sample",
+				payload:   payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "",
+				payload:   payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPipelineService(testCase.fields.c, nil, nil, nil, nil)
+
+			gotResult, gotResponse, err := newService.Trigger(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPipelineServiceImpl_Stop(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx          context.Context
+		workspace    string
+		repoSlug     string
+		pipelineUUID string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "repository-sample",
+				pipelineUUID: "{5b29e1a8-7d8f-4e55-9a53-2f43c1e7f6a1}",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"2.0/repositories/work-space-name-sample/repository-sample/pipelines/{5b29e1a8-7d8f-4e55-9a53-2f43c1e7f6a1}/stopPipeline",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "repository-sample",
+				pipelineUUID: "{5b29e1a8-7d8f-4e55-9a53-2f43c1e7f6a1}",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"2.0/repositories/work-space-name-sample/repository-sample/pipelines/{5b29e1a8-7d8f-4e55-9a53-2f43c1e7f6a1}/stopPipeline",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "",
+				repoSlug:     "repository-sample",
+				pipelineUUID: "{5b29e1a8-7d8f-4e55-9a53-2f43c1e7f6a1}",
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "",
+				pipelineUUID: "{5b29e1a8-7d8f-4e55-9a53-2f43c1e7f6a1}",
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the pipeline uuid is not provided",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "repository-sample",
+				pipelineUUID: "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoPipelineUUID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPipelineService(testCase.fields.c, nil, nil, nil, nil)
+
+			gotResponse, err := newService.Stop(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.pipelineUUID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
+
+func TestPipelineService_WaitForCompletion(t *testing.T) {
+
+	endpoint := "2.0/repositories/work-space-name-sample/repository-sample/pipelines/{5b29e1a8-7d8f-4e55-9a53-2f43c1e7f6a1}"
+
+	t.Run("when the pipeline completes", func(t *testing.T) {
+
+		client := mocks.NewConnector(t)
+
+		client.On("NewRequest", context.Background(), http.MethodGet, endpoint, "", nil).
+			Return(&http.Request{}, nil)
+
+		states := []string{"PENDING", "IN_PROGRESS", "COMPLETED"}
+		client.On("Call", &http.Request{}, &model.PipelineScheme{}).
+			Run(func(args mock.Arguments) {
+				pipeline := args.Get(1).(*model.PipelineScheme)
+				pipeline.State = &model.PipelineStateScheme{Name: states[0]}
+				if states[0] == "COMPLETED" {
+					pipeline.State.Result = &model.PipelineStateNameScheme{Name: "SUCCESSFUL"}
+				}
+				states = states[1:]
+			}).
+			Return(&model.ResponseScheme{}, nil).
+			Times(3)
+
+		newService := NewPipelineService(client, nil, nil, nil, nil)
+
+		pipeline, err := newService.WaitForCompletion(context.Background(), "work-space-name-sample", "repository-sample",
+			"{5b29e1a8-7d8f-4e55-9a53-2f43c1e7f6a1}", time.Millisecond)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "SUCCESSFUL", pipeline.State.Result.Name)
+	})
+
+	t.Run("when the context is done", func(t *testing.T) {
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		client := mocks.NewConnector(t)
+
+		client.On("NewRequest", ctx, http.MethodGet, endpoint, "", nil).
+			Return(&http.Request{}, nil)
+
+		client.On("Call", &http.Request{}, &model.PipelineScheme{}).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.PipelineScheme).State = &model.PipelineStateScheme{Name: "IN_PROGRESS"}
+				cancel()
+			}).
+			Return(&model.ResponseScheme{}, nil).
+			Once()
+
+		newService := NewPipelineService(client, nil, nil, nil, nil)
+
+		pipeline, err := newService.WaitForCompletion(ctx, "work-space-name-sample", "repository-sample",
+			"{5b29e1a8-7d8f-4e55-9a53-2f43c1e7f6a1}", time.Hour)
+
+		assert.True(t, errors.Is(err, context.Canceled))
+		assert.Equal(t, "IN_PROGRESS", pipeline.State.Name)
+	})
+
+	t.Run("when the pipeline uuid is not provided", func(t *testing.T) {
+
+		newService := NewPipelineService(mocks.NewConnector(t), nil, nil, nil, nil)
+
+		_, err := newService.WaitForCompletion(context.Background(), "work-space-name-sample", "repository-sample", "", 0)
+		assert.True(t, errors.Is(err, model.ErrNoPipelineUUID))
+	})
+}
@@ -0,0 +1,166 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewPipelineStepService handles communication with the pipeline step related methods of the Bitbucket API.
+func NewPipelineStepService(client service.Connector) *PipelineStepService {
+
+	return &PipelineStepService{
+		internalClient: &internalPipelineStepServiceImpl{c: client},
+		c:              client,
+	}
+}
+
+// PipelineStepService handles communication with the pipeline step related methods of the Bitbucket API.
+type PipelineStepService struct {
+	internalClient bitbucket.PipelineStepConnector
+	c              service.Connector
+}
+
+// Gets returns a paginated list of the steps of the specified pipeline.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines/{pipeline_uuid}/steps
+func (p *PipelineStepService) Gets(ctx context.Context, workspace, repoSlug, pipelineUUID string) (*model.PipelineStepPageScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Gets(ctx, workspace, repoSlug, pipelineUUID)
+}
+
+// GetsAll iterates over all the steps of the specified pipeline.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines/{pipeline_uuid}/steps
+func (p *PipelineStepService) GetsAll(ctx context.Context, workspace, repoSlug, pipelineUUID string, opts ...paginate.Option) iter.Seq2[*model.PipelineStepScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.PipelineStepScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.PipelineStepScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	if pipelineUUID == "" {
+		return paginateError[*model.PipelineStepScheme](fmt.Errorf("bitbucket: %w", model.ErrNoPipelineUUID))
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pipelines/%v/steps", workspace, repoSlug, pipelineUUID)
+	return paginateLinks[*model.PipelineStepScheme](ctx, p.c, endpoint, opts)
+}
+
+// Get returns the specified pipeline step.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines/{pipeline_uuid}/steps/{step_uuid}
+func (p *PipelineStepService) Get(ctx context.Context, workspace, repoSlug, pipelineUUID, stepUUID string) (*model.PipelineStepScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Get(ctx, workspace, repoSlug, pipelineUUID, stepUUID)
+}
+
+// Log returns the log of the specified pipeline step as a reader, the caller must close it.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines/{pipeline_uuid}/steps/{step_uuid}/log
+func (p *PipelineStepService) Log(ctx context.Context, workspace, repoSlug, pipelineUUID, stepUUID string) (io.ReadCloser, error) {
+	return p.internalClient.Log(ctx, workspace, repoSlug, pipelineUUID, stepUUID)
+}
+
+type internalPipelineStepServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the steps of the specified pipeline.
+func (i *internalPipelineStepServiceImpl) Gets(ctx context.Context, workspace, repoSlug, pipelineUUID string) (*model.PipelineStepPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if pipelineUUID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoPipelineUUID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pipelines/%v/steps", workspace, repoSlug, pipelineUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.PipelineStepPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Get returns the specified pipeline step.
+func (i *internalPipelineStepServiceImpl) Get(ctx context.Context, workspace, repoSlug, pipelineUUID, stepUUID string) (*model.PipelineStepScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if pipelineUUID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoPipelineUUID)
+	}
+
+	if stepUUID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoPipelineStepUUID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pipelines/%v/steps/%v", workspace, repoSlug, pipelineUUID, stepUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	step := new(model.PipelineStepScheme)
+	response, err := i.c.Call(request, step)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return step, response, nil
+}
+
+// Log returns the log of the specified pipeline step as a reader, the caller must close it.
+func (i *internalPipelineStepServiceImpl) Log(ctx context.Context, workspace, repoSlug, pipelineUUID, stepUUID string) (io.ReadCloser, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if pipelineUUID == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoPipelineUUID)
+	}
+
+	if stepUUID == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoPipelineStepUUID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pipelines/%v/steps/%v/log", workspace, repoSlug, pipelineUUID, stepUUID)
+	return download(ctx, i.c, endpoint)
+}
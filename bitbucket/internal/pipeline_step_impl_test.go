@@ -0,0 +1,347 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func Test_internalPipelineStepServiceImpl_Gets(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx          context.Context
+		workspace    string
+		repoSlug     string
+		pipelineUUID string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "repository-sample",
+				pipelineUUID: "{5b29e1a8-7d8f-4e55-9a53-2f43c1e7f6a1}",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/pipelines/{5b29e1a8-7d8f-4e55-9a53-2f43c1e7f6a1}/steps",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.PipelineStepPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "repository-sample",
+				pipelineUUID: "{5b29e1a8-7d8f-4e55-9a53-2f43c1e7f6a1}",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/pipelines/{5b29e1a8-7d8f-4e55-9a53-2f43c1e7f6a1}/steps",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "",
+				repoSlug:     "repository-sample",
+				pipelineUUID: "{5b29e1a8-7d8f-4e55-9a53-2f43c1e7f6a1}",
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "",
+				pipelineUUID: "{5b29e1a8-7d8f-4e55-9a53-2f43c1e7f6a1}",
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the pipeline uuid is not provided",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "repository-sample",
+				pipelineUUID: "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoPipelineUUID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPipelineStepService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Gets(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.pipelineUUID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPipelineStepServiceImpl_Get(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx          context.Context
+		workspace    string
+		repoSlug     string
+		pipelineUUID string
+		stepUUID     string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "repository-sample",
+				pipelineUUID: "{5b29e1a8-7d8f-4e55-9a53-2f43c1e7f6a1}",
+				stepUUID:     "{8f6c5e0d-0f4b-4d2b-a9a5-3b1f2f0c6c11}",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/pipelines/{5b29e1a8-7d8f-4e55-9a53-2f43c1e7f6a1}/steps/{8f6c5e0d-0f4b-4d2b-a9a5-3b1f2f0c6c11}",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.PipelineStepScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "repository-sample",
+				pipelineUUID: "{5b29e1a8-7d8f-4e55-9a53-2f43c1e7f6a1}",
+				stepUUID:     "{8f6c5e0d-0f4b-4d2b-a9a5-3b1f2f0c6c11}",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/pipelines/{5b29e1a8-7d8f-4e55-9a53-2f43c1e7f6a1}/steps/{8f6c5e0d-0f4b-4d2b-a9a5-3b1f2f0c6c11}",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "",
+				repoSlug:     "repository-sample",
+				pipelineUUID: "{5b29e1a8-7d8f-4e55-9a53-2f43c1e7f6a1}",
+				stepUUID:     "{8f6c5e0d-0f4b-4d2b-a9a5-3b1f2f0c6c11}",
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "",
+				pipelineUUID: "{5b29e1a8-7d8f-4e55-9a53-2f43c1e7f6a1}",
+				stepUUID:     "{8f6c5e0d-0f4b-4d2b-a9a5-3b1f2f0c6c11}",
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the pipeline uuid is not provided",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "repository-sample",
+				pipelineUUID: "",
+				stepUUID:     "{8f6c5e0d-0f4b-4d2b-a9a5-3b1f2f0c6c11}",
+			},
+			wantErr: true,
+			Err:     model.ErrNoPipelineUUID,
+		},
+
+		{
+			name: "when the step uuid is not provided",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "repository-sample",
+				pipelineUUID: "{5b29e1a8-7d8f-4e55-9a53-2f43c1e7f6a1}",
+				stepUUID:     "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoPipelineStepUUID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPipelineStepService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Get(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.pipelineUUID, testCase.args.stepUUID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPipelineStepServiceImpl_Log(t *testing.T) {
+
+	client := mocks.NewConnector(t)
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodGet,
+		"2.0/repositories/work-space-name-sample/repository-sample/pipelines/{5b29e1a8-7d8f-4e55-9a53-2f43c1e7f6a1}/steps/{8f6c5e0d-0f4b-4d2b-a9a5-3b1f2f0c6c11}/log",
+		"", nil).
+		Return(&http.Request{}, nil)
+
+	client.On("Do", &http.Request{}).
+		Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader("+ go test ./...")),
+		}, nil)
+
+	newService := NewPipelineStepService(client)
+
+	reader, err := newService.Log(context.Background(), "work-space-name-sample", "repository-sample",
+		"{5b29e1a8-7d8f-4e55-9a53-2f43c1e7f6a1}", "{8f6c5e0d-0f4b-4d2b-a9a5-3b1f2f0c6c11}")
+	assert.NoError(t, err)
+	defer reader.Close()
+
+	log, err := io.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, "+ go test ./...", string(log))
+
+	_, err = newService.Log(context.Background(), "work-space-name-sample", "repository-sample", "{5b29e1a8-7d8f-4e55-9a53-2f43c1e7f6a1}", "")
+	assert.True(t, errors.Is(err, model.ErrNoPipelineStepUUID))
+}
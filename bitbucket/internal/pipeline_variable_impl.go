@@ -0,0 +1,227 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewPipelineVariableService handles communication with the repository pipeline variable related methods of the Bitbucket API.
+func NewPipelineVariableService(client service.Connector) *PipelineVariableService {
+
+	return &PipelineVariableService{
+		internalClient: &internalPipelineVariableServiceImpl{c: client},
+		c:              client,
+	}
+}
+
+// PipelineVariableService handles communication with the repository pipeline variable related methods of the Bitbucket API.
+type PipelineVariableService struct {
+	internalClient bitbucket.PipelineVariableConnector
+	c              service.Connector
+}
+
+// Gets returns a paginated list of the pipeline variables of the specified repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines_config/variables
+func (p *PipelineVariableService) Gets(ctx context.Context, workspace, repoSlug string) (*model.PipelineVariablePageScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Gets(ctx, workspace, repoSlug)
+}
+
+// GetsAll iterates over all the pipeline variables of the specified repository.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines_config/variables
+func (p *PipelineVariableService) GetsAll(ctx context.Context, workspace, repoSlug string, opts ...paginate.Option) iter.Seq2[*model.PipelineVariableScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.PipelineVariableScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.PipelineVariableScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pipelines_config/variables", workspace, repoSlug)
+	return paginateLinks[*model.PipelineVariableScheme](ctx, p.c, endpoint, opts)
+}
+
+// Get returns the specified repository pipeline variable.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines_config/variables/{variable_uuid}
+func (p *PipelineVariableService) Get(ctx context.Context, workspace, repoSlug, variableUUID string) (*model.PipelineVariableScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Get(ctx, workspace, repoSlug, variableUUID)
+}
+
+// Create creates a repository pipeline variable.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/pipelines_config/variables
+func (p *PipelineVariableService) Create(ctx context.Context, workspace, repoSlug string, payload *model.PipelineVariableScheme) (*model.PipelineVariableScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Create(ctx, workspace, repoSlug, payload)
+}
+
+// Update updates the specified repository pipeline variable.
+//
+// PUT /2.0/repositories/{workspace}/{repo_slug}/pipelines_config/variables/{variable_uuid}
+func (p *PipelineVariableService) Update(ctx context.Context, workspace, repoSlug, variableUUID string, payload *model.PipelineVariableScheme) (*model.PipelineVariableScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Update(ctx, workspace, repoSlug, variableUUID, payload)
+}
+
+// Delete deletes the specified repository pipeline variable.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/pipelines_config/variables/{variable_uuid}
+func (p *PipelineVariableService) Delete(ctx context.Context, workspace, repoSlug, variableUUID string) (*model.ResponseScheme, error) {
+	return p.internalClient.Delete(ctx, workspace, repoSlug, variableUUID)
+}
+
+type internalPipelineVariableServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the pipeline variables of the specified repository.
+func (i *internalPipelineVariableServiceImpl) Gets(ctx context.Context, workspace, repoSlug string) (*model.PipelineVariablePageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pipelines_config/variables", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.PipelineVariablePageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Get returns the specified repository pipeline variable.
+func (i *internalPipelineVariableServiceImpl) Get(ctx context.Context, workspace, repoSlug, variableUUID string) (*model.PipelineVariableScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if variableUUID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoVariableUUID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pipelines_config/variables/%v", workspace, repoSlug, variableUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	variable := new(model.PipelineVariableScheme)
+	response, err := i.c.Call(request, variable)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return variable, response, nil
+}
+
+// Create creates a repository pipeline variable.
+func (i *internalPipelineVariableServiceImpl) Create(ctx context.Context, workspace, repoSlug string, payload *model.PipelineVariableScheme) (*model.PipelineVariableScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pipelines_config/variables", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	variable := new(model.PipelineVariableScheme)
+	response, err := i.c.Call(request, variable)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return variable, response, nil
+}
+
+// Update updates the specified repository pipeline variable.
+func (i *internalPipelineVariableServiceImpl) Update(ctx context.Context, workspace, repoSlug, variableUUID string, payload *model.PipelineVariableScheme) (*model.PipelineVariableScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if variableUUID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoVariableUUID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pipelines_config/variables/%v", workspace, repoSlug, variableUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	variable := new(model.PipelineVariableScheme)
+	response, err := i.c.Call(request, variable)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return variable, response, nil
+}
+
+// Delete deletes the specified repository pipeline variable.
+func (i *internalPipelineVariableServiceImpl) Delete(ctx context.Context, workspace, repoSlug, variableUUID string) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if variableUUID == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoVariableUUID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pipelines_config/variables/%v", workspace, repoSlug, variableUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
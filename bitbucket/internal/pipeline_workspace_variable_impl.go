@@ -0,0 +1,203 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewPipelineWorkspaceVariableService handles communication with the workspace pipeline variable related methods of the Bitbucket API.
+func NewPipelineWorkspaceVariableService(client service.Connector) *PipelineWorkspaceVariableService {
+
+	return &PipelineWorkspaceVariableService{
+		internalClient: &internalPipelineWorkspaceVariableServiceImpl{c: client},
+		c:              client,
+	}
+}
+
+// PipelineWorkspaceVariableService handles communication with the workspace pipeline variable related methods of the Bitbucket API.
+type PipelineWorkspaceVariableService struct {
+	internalClient bitbucket.PipelineWorkspaceVariableConnector
+	c              service.Connector
+}
+
+// Gets returns a paginated list of the pipeline variables of the specified workspace.
+//
+// GET /2.0/workspaces/{workspace}/pipelines-config/variables
+func (p *PipelineWorkspaceVariableService) Gets(ctx context.Context, workspace string) (*model.PipelineVariablePageScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Gets(ctx, workspace)
+}
+
+// GetsAll iterates over all the pipeline variables of the specified workspace.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/workspaces/{workspace}/pipelines-config/variables
+func (p *PipelineWorkspaceVariableService) GetsAll(ctx context.Context, workspace string, opts ...paginate.Option) iter.Seq2[*model.PipelineVariableScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.PipelineVariableScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/pipelines-config/variables", workspace)
+	return paginateLinks[*model.PipelineVariableScheme](ctx, p.c, endpoint, opts)
+}
+
+// Get returns the specified workspace pipeline variable.
+//
+// GET /2.0/workspaces/{workspace}/pipelines-config/variables/{variable_uuid}
+func (p *PipelineWorkspaceVariableService) Get(ctx context.Context, workspace, variableUUID string) (*model.PipelineVariableScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Get(ctx, workspace, variableUUID)
+}
+
+// Create creates a workspace pipeline variable.
+//
+// POST /2.0/workspaces/{workspace}/pipelines-config/variables
+func (p *PipelineWorkspaceVariableService) Create(ctx context.Context, workspace string, payload *model.PipelineVariableScheme) (*model.PipelineVariableScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Create(ctx, workspace, payload)
+}
+
+// Update updates the specified workspace pipeline variable.
+//
+// PUT /2.0/workspaces/{workspace}/pipelines-config/variables/{variable_uuid}
+func (p *PipelineWorkspaceVariableService) Update(ctx context.Context, workspace, variableUUID string, payload *model.PipelineVariableScheme) (*model.PipelineVariableScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Update(ctx, workspace, variableUUID, payload)
+}
+
+// Delete deletes the specified workspace pipeline variable.
+//
+// DELETE /2.0/workspaces/{workspace}/pipelines-config/variables/{variable_uuid}
+func (p *PipelineWorkspaceVariableService) Delete(ctx context.Context, workspace, variableUUID string) (*model.ResponseScheme, error) {
+	return p.internalClient.Delete(ctx, workspace, variableUUID)
+}
+
+type internalPipelineWorkspaceVariableServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the pipeline variables of the specified workspace.
+func (i *internalPipelineWorkspaceVariableServiceImpl) Gets(ctx context.Context, workspace string) (*model.PipelineVariablePageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/pipelines-config/variables", workspace)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.PipelineVariablePageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Get returns the specified workspace pipeline variable.
+func (i *internalPipelineWorkspaceVariableServiceImpl) Get(ctx context.Context, workspace, variableUUID string) (*model.PipelineVariableScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if variableUUID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoVariableUUID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/pipelines-config/variables/%v", workspace, variableUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	variable := new(model.PipelineVariableScheme)
+	response, err := i.c.Call(request, variable)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return variable, response, nil
+}
+
+// Create creates a workspace pipeline variable.
+func (i *internalPipelineWorkspaceVariableServiceImpl) Create(ctx context.Context, workspace string, payload *model.PipelineVariableScheme) (*model.PipelineVariableScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/pipelines-config/variables", workspace)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	variable := new(model.PipelineVariableScheme)
+	response, err := i.c.Call(request, variable)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return variable, response, nil
+}
+
+// Update updates the specified workspace pipeline variable.
+func (i *internalPipelineWorkspaceVariableServiceImpl) Update(ctx context.Context, workspace, variableUUID string, payload *model.PipelineVariableScheme) (*model.PipelineVariableScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if variableUUID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoVariableUUID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/pipelines-config/variables/%v", workspace, variableUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	variable := new(model.PipelineVariableScheme)
+	response, err := i.c.Call(request, variable)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return variable, response, nil
+}
+
+// Delete deletes the specified workspace pipeline variable.
+func (i *internalPipelineWorkspaceVariableServiceImpl) Delete(ctx context.Context, workspace, variableUUID string) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if variableUUID == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoVariableUUID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/pipelines-config/variables/%v", workspace, variableUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
@@ -0,0 +1,227 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewProjectBranchRestrictionService handles communication with the project branch restriction related methods of the Bitbucket API.
+func NewProjectBranchRestrictionService(client service.Connector) *ProjectBranchRestrictionService {
+
+	return &ProjectBranchRestrictionService{
+		internalClient: &internalProjectBranchRestrictionServiceImpl{c: client},
+		c:              client,
+	}
+}
+
+// ProjectBranchRestrictionService handles communication with the project branch restriction related methods of the Bitbucket API.
+type ProjectBranchRestrictionService struct {
+	internalClient bitbucket.ProjectBranchRestrictionConnector
+	c              service.Connector
+}
+
+// Gets returns a paginated list of the branch restrictions of the specified project.
+//
+// GET /2.0/workspaces/{workspace}/projects/{project_key}/branch-restrictions
+func (p *ProjectBranchRestrictionService) Gets(ctx context.Context, workspace, projectKey string, options *model.BranchRestrictionOptionsScheme) (*model.BranchRestrictionPageScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Gets(ctx, workspace, projectKey, options)
+}
+
+// GetsAll iterates over all the branch restrictions of the specified project.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/workspaces/{workspace}/projects/{project_key}/branch-restrictions
+func (p *ProjectBranchRestrictionService) GetsAll(ctx context.Context, workspace, projectKey string, options *model.BranchRestrictionOptionsScheme, opts ...paginate.Option) iter.Seq2[*model.BranchRestrictionScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.BranchRestrictionScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if projectKey == "" {
+		return paginateError[*model.BranchRestrictionScheme](fmt.Errorf("bitbucket: %w", model.ErrNoProjectKey))
+	}
+
+	endpoint := branchRestrictionListEndpoint(fmt.Sprintf("2.0/workspaces/%v/projects/%v/branch-restrictions", workspace, projectKey), options)
+	return paginateLinks[*model.BranchRestrictionScheme](ctx, p.c, endpoint, opts)
+}
+
+// Get returns the specified branch restriction of the project.
+//
+// GET /2.0/workspaces/{workspace}/projects/{project_key}/branch-restrictions/{id}
+func (p *ProjectBranchRestrictionService) Get(ctx context.Context, workspace, projectKey string, restrictionID int) (*model.BranchRestrictionScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Get(ctx, workspace, projectKey, restrictionID)
+}
+
+// Create creates a new branch restriction on the specified project.
+//
+// POST /2.0/workspaces/{workspace}/projects/{project_key}/branch-restrictions
+func (p *ProjectBranchRestrictionService) Create(ctx context.Context, workspace, projectKey string, payload *model.BranchRestrictionScheme) (*model.BranchRestrictionScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Create(ctx, workspace, projectKey, payload)
+}
+
+// Update updates the specified branch restriction of the project.
+//
+// PUT /2.0/workspaces/{workspace}/projects/{project_key}/branch-restrictions/{id}
+func (p *ProjectBranchRestrictionService) Update(ctx context.Context, workspace, projectKey string, restrictionID int, payload *model.BranchRestrictionScheme) (*model.BranchRestrictionScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Update(ctx, workspace, projectKey, restrictionID, payload)
+}
+
+// Delete deletes the specified branch restriction of the project.
+//
+// DELETE /2.0/workspaces/{workspace}/projects/{project_key}/branch-restrictions/{id}
+func (p *ProjectBranchRestrictionService) Delete(ctx context.Context, workspace, projectKey string, restrictionID int) (*model.ResponseScheme, error) {
+	return p.internalClient.Delete(ctx, workspace, projectKey, restrictionID)
+}
+
+type internalProjectBranchRestrictionServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the branch restrictions of the specified project.
+func (i *internalProjectBranchRestrictionServiceImpl) Gets(ctx context.Context, workspace, projectKey string, options *model.BranchRestrictionOptionsScheme) (*model.BranchRestrictionPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if projectKey == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoProjectKey)
+	}
+
+	endpoint := branchRestrictionListEndpoint(fmt.Sprintf("2.0/workspaces/%v/projects/%v/branch-restrictions", workspace, projectKey), options)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.BranchRestrictionPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Get returns the specified branch restriction of the project.
+func (i *internalProjectBranchRestrictionServiceImpl) Get(ctx context.Context, workspace, projectKey string, restrictionID int) (*model.BranchRestrictionScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if projectKey == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoProjectKey)
+	}
+
+	if restrictionID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoBranchRestrictionID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/projects/%v/branch-restrictions/%v", workspace, projectKey, restrictionID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	restriction := new(model.BranchRestrictionScheme)
+	response, err := i.c.Call(request, restriction)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return restriction, response, nil
+}
+
+// Create creates a new branch restriction on the specified project.
+func (i *internalProjectBranchRestrictionServiceImpl) Create(ctx context.Context, workspace, projectKey string, payload *model.BranchRestrictionScheme) (*model.BranchRestrictionScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if projectKey == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoProjectKey)
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/projects/%v/branch-restrictions", workspace, projectKey)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	restriction := new(model.BranchRestrictionScheme)
+	response, err := i.c.Call(request, restriction)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return restriction, response, nil
+}
+
+// Update updates the specified branch restriction of the project.
+func (i *internalProjectBranchRestrictionServiceImpl) Update(ctx context.Context, workspace, projectKey string, restrictionID int, payload *model.BranchRestrictionScheme) (*model.BranchRestrictionScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if projectKey == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoProjectKey)
+	}
+
+	if restrictionID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoBranchRestrictionID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/projects/%v/branch-restrictions/%v", workspace, projectKey, restrictionID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	restriction := new(model.BranchRestrictionScheme)
+	response, err := i.c.Call(request, restriction)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return restriction, response, nil
+}
+
+// Delete deletes the specified branch restriction of the project.
+func (i *internalProjectBranchRestrictionServiceImpl) Delete(ctx context.Context, workspace, projectKey string, restrictionID int) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if projectKey == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoProjectKey)
+	}
+
+	if restrictionID == 0 {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoBranchRestrictionID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/projects/%v/branch-restrictions/%v", workspace, projectKey, restrictionID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
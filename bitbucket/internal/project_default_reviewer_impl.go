@@ -0,0 +1,193 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewProjectDefaultReviewerService handles communication with the project default reviewer related methods of the Bitbucket API.
+func NewProjectDefaultReviewerService(client service.Connector) *ProjectDefaultReviewerService {
+
+	return &ProjectDefaultReviewerService{
+		internalClient: &internalProjectDefaultReviewerServiceImpl{c: client},
+		c:              client,
+	}
+}
+
+// ProjectDefaultReviewerService handles communication with the project default reviewer related methods of the Bitbucket API.
+type ProjectDefaultReviewerService struct {
+	internalClient bitbucket.ProjectDefaultReviewerConnector
+	c              service.Connector
+}
+
+// Gets returns a paginated list of the default reviewers of the specified project.
+//
+// GET /2.0/workspaces/{workspace}/projects/{project_key}/default-reviewers
+func (p *ProjectDefaultReviewerService) Gets(ctx context.Context, workspace, projectKey string) (*model.DefaultReviewerPageScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Gets(ctx, workspace, projectKey)
+}
+
+// GetsAll iterates over all the default reviewers of the specified project.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/workspaces/{workspace}/projects/{project_key}/default-reviewers
+func (p *ProjectDefaultReviewerService) GetsAll(ctx context.Context, workspace, projectKey string, opts ...paginate.Option) iter.Seq2[*model.DefaultReviewerScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.DefaultReviewerScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if projectKey == "" {
+		return paginateError[*model.DefaultReviewerScheme](fmt.Errorf("bitbucket: %w", model.ErrNoProjectKey))
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/projects/%v/default-reviewers", workspace, projectKey)
+	return paginateLinks[*model.DefaultReviewerScheme](ctx, p.c, endpoint, opts)
+}
+
+// Get returns the specified default reviewer of the project.
+//
+// GET /2.0/workspaces/{workspace}/projects/{project_key}/default-reviewers/{selected_user}
+func (p *ProjectDefaultReviewerService) Get(ctx context.Context, workspace, projectKey, accountID string) (*model.BitbucketAccountScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Get(ctx, workspace, projectKey, accountID)
+}
+
+// Add adds the specified user to the default reviewers of the project.
+//
+// PUT /2.0/workspaces/{workspace}/projects/{project_key}/default-reviewers/{selected_user}
+func (p *ProjectDefaultReviewerService) Add(ctx context.Context, workspace, projectKey, accountID string) (*model.BitbucketAccountScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Add(ctx, workspace, projectKey, accountID)
+}
+
+// Remove removes the specified user from the default reviewers of the project.
+//
+// DELETE /2.0/workspaces/{workspace}/projects/{project_key}/default-reviewers/{selected_user}
+func (p *ProjectDefaultReviewerService) Remove(ctx context.Context, workspace, projectKey, accountID string) (*model.ResponseScheme, error) {
+	return p.internalClient.Remove(ctx, workspace, projectKey, accountID)
+}
+
+type internalProjectDefaultReviewerServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the default reviewers of the specified project.
+func (i *internalProjectDefaultReviewerServiceImpl) Gets(ctx context.Context, workspace, projectKey string) (*model.DefaultReviewerPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if projectKey == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoProjectKey)
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/projects/%v/default-reviewers", workspace, projectKey)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.DefaultReviewerPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Get returns the specified default reviewer of the project.
+func (i *internalProjectDefaultReviewerServiceImpl) Get(ctx context.Context, workspace, projectKey, accountID string) (*model.BitbucketAccountScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if projectKey == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoProjectKey)
+	}
+
+	if accountID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoAccountID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/projects/%v/default-reviewers/%v", workspace, projectKey, accountID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reviewer := new(model.BitbucketAccountScheme)
+	response, err := i.c.Call(request, reviewer)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return reviewer, response, nil
+}
+
+// Add adds the specified user to the default reviewers of the project.
+func (i *internalProjectDefaultReviewerServiceImpl) Add(ctx context.Context, workspace, projectKey, accountID string) (*model.BitbucketAccountScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if projectKey == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoProjectKey)
+	}
+
+	if accountID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoAccountID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/projects/%v/default-reviewers/%v", workspace, projectKey, accountID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reviewer := new(model.BitbucketAccountScheme)
+	response, err := i.c.Call(request, reviewer)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return reviewer, response, nil
+}
+
+// Remove removes the specified user from the default reviewers of the project.
+func (i *internalProjectDefaultReviewerServiceImpl) Remove(ctx context.Context, workspace, projectKey, accountID string) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if projectKey == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoProjectKey)
+	}
+
+	if accountID == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoAccountID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/projects/%v/default-reviewers/%v", workspace, projectKey, accountID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
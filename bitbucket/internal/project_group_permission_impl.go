@@ -0,0 +1,193 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewProjectGroupPermissionService handles communication with the project group permission related methods of the Bitbucket API.
+func NewProjectGroupPermissionService(client service.Connector) *ProjectGroupPermissionService {
+
+	return &ProjectGroupPermissionService{
+		internalClient: &internalProjectGroupPermissionServiceImpl{c: client},
+		c:              client,
+	}
+}
+
+// ProjectGroupPermissionService handles communication with the project group permission related methods of the Bitbucket API.
+type ProjectGroupPermissionService struct {
+	internalClient bitbucket.ProjectGroupPermissionConnector
+	c              service.Connector
+}
+
+// Gets returns a paginated list of the explicit group permissions of the specified project.
+//
+// GET /2.0/workspaces/{workspace}/projects/{project_key}/permissions-config/groups
+func (p *ProjectGroupPermissionService) Gets(ctx context.Context, workspace, projectKey string) (*model.ProjectGroupPermissionPageScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Gets(ctx, workspace, projectKey)
+}
+
+// GetsAll iterates over all the explicit group permissions of the specified project.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/workspaces/{workspace}/projects/{project_key}/permissions-config/groups
+func (p *ProjectGroupPermissionService) GetsAll(ctx context.Context, workspace, projectKey string, opts ...paginate.Option) iter.Seq2[*model.ProjectGroupPermissionScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.ProjectGroupPermissionScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if projectKey == "" {
+		return paginateError[*model.ProjectGroupPermissionScheme](fmt.Errorf("bitbucket: %w", model.ErrNoProjectKey))
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/projects/%v/permissions-config/groups", workspace, projectKey)
+	return paginateLinks[*model.ProjectGroupPermissionScheme](ctx, p.c, endpoint, opts)
+}
+
+// Get returns the explicit permission of the specified group on the project.
+//
+// GET /2.0/workspaces/{workspace}/projects/{project_key}/permissions-config/groups/{group_slug}
+func (p *ProjectGroupPermissionService) Get(ctx context.Context, workspace, projectKey, groupSlug string) (*model.ProjectGroupPermissionScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Get(ctx, workspace, projectKey, groupSlug)
+}
+
+// Update grants, or changes, the explicit permission of the specified group on the project.
+//
+// PUT /2.0/workspaces/{workspace}/projects/{project_key}/permissions-config/groups/{group_slug}
+func (p *ProjectGroupPermissionService) Update(ctx context.Context, workspace, projectKey, groupSlug string, payload *model.ProjectPermissionPayloadScheme) (*model.ProjectGroupPermissionScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Update(ctx, workspace, projectKey, groupSlug, payload)
+}
+
+// Delete revokes the explicit permission of the specified group on the project.
+//
+// DELETE /2.0/workspaces/{workspace}/projects/{project_key}/permissions-config/groups/{group_slug}
+func (p *ProjectGroupPermissionService) Delete(ctx context.Context, workspace, projectKey, groupSlug string) (*model.ResponseScheme, error) {
+	return p.internalClient.Delete(ctx, workspace, projectKey, groupSlug)
+}
+
+type internalProjectGroupPermissionServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the explicit group permissions of the specified project.
+func (i *internalProjectGroupPermissionServiceImpl) Gets(ctx context.Context, workspace, projectKey string) (*model.ProjectGroupPermissionPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if projectKey == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoProjectKey)
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/projects/%v/permissions-config/groups", workspace, projectKey)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.ProjectGroupPermissionPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Get returns the explicit permission of the specified group on the project.
+func (i *internalProjectGroupPermissionServiceImpl) Get(ctx context.Context, workspace, projectKey, groupSlug string) (*model.ProjectGroupPermissionScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if projectKey == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoProjectKey)
+	}
+
+	if groupSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoGroupSlug)
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/projects/%v/permissions-config/groups/%v", workspace, projectKey, groupSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	permission := new(model.ProjectGroupPermissionScheme)
+	response, err := i.c.Call(request, permission)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return permission, response, nil
+}
+
+// Update grants, or changes, the explicit permission of the specified group on the project.
+func (i *internalProjectGroupPermissionServiceImpl) Update(ctx context.Context, workspace, projectKey, groupSlug string, payload *model.ProjectPermissionPayloadScheme) (*model.ProjectGroupPermissionScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if projectKey == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoProjectKey)
+	}
+
+	if groupSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoGroupSlug)
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/projects/%v/permissions-config/groups/%v", workspace, projectKey, groupSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	permission := new(model.ProjectGroupPermissionScheme)
+	response, err := i.c.Call(request, permission)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return permission, response, nil
+}
+
+// Delete revokes the explicit permission of the specified group on the project.
+func (i *internalProjectGroupPermissionServiceImpl) Delete(ctx context.Context, workspace, projectKey, groupSlug string) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if projectKey == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoProjectKey)
+	}
+
+	if groupSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoGroupSlug)
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/projects/%v/permissions-config/groups/%v", workspace, projectKey, groupSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
@@ -0,0 +1,211 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewProjectService handles communication with the project related methods of the Bitbucket API.
+func NewProjectService(client service.Connector, reviewer *ProjectDefaultReviewerService, restriction *ProjectBranchRestrictionService, group *ProjectGroupPermissionService, user *ProjectUserPermissionService) *ProjectService {
+
+	return &ProjectService{
+		internalClient:    &internalProjectServiceImpl{c: client},
+		c:                 client,
+		DefaultReviewer:   reviewer,
+		BranchRestriction: restriction,
+		GroupPermission:   group,
+		UserPermission:    user,
+	}
+}
+
+// ProjectService handles communication with the project related methods of the Bitbucket API.
+type ProjectService struct {
+	internalClient    bitbucket.ProjectConnector
+	c                 service.Connector
+	DefaultReviewer   *ProjectDefaultReviewerService
+	BranchRestriction *ProjectBranchRestrictionService
+	GroupPermission   *ProjectGroupPermissionService
+	UserPermission    *ProjectUserPermissionService
+}
+
+// Gets returns a paginated list of the projects of the specified workspace.
+//
+// GET /2.0/workspaces/{workspace}/projects
+func (p *ProjectService) Gets(ctx context.Context, workspace string) (*model.BitbucketProjectPageScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Gets(ctx, workspace)
+}
+
+// GetsAll iterates over all the projects of the specified workspace.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/workspaces/{workspace}/projects
+func (p *ProjectService) GetsAll(ctx context.Context, workspace string, opts ...paginate.Option) iter.Seq2[*model.BitbucketProjectScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.BitbucketProjectScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/projects", workspace)
+	return paginateLinks[*model.BitbucketProjectScheme](ctx, p.c, endpoint, opts)
+}
+
+// Get returns the specified project.
+//
+// GET /2.0/workspaces/{workspace}/projects/{project_key}
+func (p *ProjectService) Get(ctx context.Context, workspace, projectKey string) (*model.BitbucketProjectScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Get(ctx, workspace, projectKey)
+}
+
+// Create creates a new project in the specified workspace.
+//
+// POST /2.0/workspaces/{workspace}/projects
+func (p *ProjectService) Create(ctx context.Context, workspace string, payload *model.BitbucketProjectPayloadScheme) (*model.BitbucketProjectScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Create(ctx, workspace, payload)
+}
+
+// Update updates the specified project, a new key in the payload renames it.
+//
+// PUT /2.0/workspaces/{workspace}/projects/{project_key}
+func (p *ProjectService) Update(ctx context.Context, workspace, projectKey string, payload *model.BitbucketProjectPayloadScheme) (*model.BitbucketProjectScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Update(ctx, workspace, projectKey, payload)
+}
+
+// Delete deletes the specified project, it must not contain any repository.
+//
+// DELETE /2.0/workspaces/{workspace}/projects/{project_key}
+func (p *ProjectService) Delete(ctx context.Context, workspace, projectKey string) (*model.ResponseScheme, error) {
+	return p.internalClient.Delete(ctx, workspace, projectKey)
+}
+
+type internalProjectServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the projects of the specified workspace.
+func (i *internalProjectServiceImpl) Gets(ctx context.Context, workspace string) (*model.BitbucketProjectPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/projects", workspace)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.BitbucketProjectPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Get returns the specified project.
+func (i *internalProjectServiceImpl) Get(ctx context.Context, workspace, projectKey string) (*model.BitbucketProjectScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if projectKey == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoProjectKey)
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/projects/%v", workspace, projectKey)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	project := new(model.BitbucketProjectScheme)
+	response, err := i.c.Call(request, project)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return project, response, nil
+}
+
+// Create creates a new project in the specified workspace.
+func (i *internalProjectServiceImpl) Create(ctx context.Context, workspace string, payload *model.BitbucketProjectPayloadScheme) (*model.BitbucketProjectScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/projects", workspace)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	project := new(model.BitbucketProjectScheme)
+	response, err := i.c.Call(request, project)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return project, response, nil
+}
+
+// Update updates the specified project, a new key in the payload renames it.
+func (i *internalProjectServiceImpl) Update(ctx context.Context, workspace, projectKey string, payload *model.BitbucketProjectPayloadScheme) (*model.BitbucketProjectScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if projectKey == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoProjectKey)
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/projects/%v", workspace, projectKey)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	project := new(model.BitbucketProjectScheme)
+	response, err := i.c.Call(request, project)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return project, response, nil
+}
+
+// Delete deletes the specified project, it must not contain any repository.
+func (i *internalProjectServiceImpl) Delete(ctx context.Context, workspace, projectKey string) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if projectKey == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoProjectKey)
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/projects/%v", workspace, projectKey)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
@@ -0,0 +1,193 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewProjectUserPermissionService handles communication with the project user permission related methods of the Bitbucket API.
+func NewProjectUserPermissionService(client service.Connector) *ProjectUserPermissionService {
+
+	return &ProjectUserPermissionService{
+		internalClient: &internalProjectUserPermissionServiceImpl{c: client},
+		c:              client,
+	}
+}
+
+// ProjectUserPermissionService handles communication with the project user permission related methods of the Bitbucket API.
+type ProjectUserPermissionService struct {
+	internalClient bitbucket.ProjectUserPermissionConnector
+	c              service.Connector
+}
+
+// Gets returns a paginated list of the explicit user permissions of the specified project.
+//
+// GET /2.0/workspaces/{workspace}/projects/{project_key}/permissions-config/users
+func (p *ProjectUserPermissionService) Gets(ctx context.Context, workspace, projectKey string) (*model.ProjectUserPermissionPageScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Gets(ctx, workspace, projectKey)
+}
+
+// GetsAll iterates over all the explicit user permissions of the specified project.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/workspaces/{workspace}/projects/{project_key}/permissions-config/users
+func (p *ProjectUserPermissionService) GetsAll(ctx context.Context, workspace, projectKey string, opts ...paginate.Option) iter.Seq2[*model.ProjectUserPermissionScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.ProjectUserPermissionScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if projectKey == "" {
+		return paginateError[*model.ProjectUserPermissionScheme](fmt.Errorf("bitbucket: %w", model.ErrNoProjectKey))
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/projects/%v/permissions-config/users", workspace, projectKey)
+	return paginateLinks[*model.ProjectUserPermissionScheme](ctx, p.c, endpoint, opts)
+}
+
+// Get returns the explicit permission of the specified user on the project.
+//
+// GET /2.0/workspaces/{workspace}/projects/{project_key}/permissions-config/users/{selected_user_id}
+func (p *ProjectUserPermissionService) Get(ctx context.Context, workspace, projectKey, accountID string) (*model.ProjectUserPermissionScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Get(ctx, workspace, projectKey, accountID)
+}
+
+// Update grants, or changes, the explicit permission of the specified user on the project.
+//
+// PUT /2.0/workspaces/{workspace}/projects/{project_key}/permissions-config/users/{selected_user_id}
+func (p *ProjectUserPermissionService) Update(ctx context.Context, workspace, projectKey, accountID string, payload *model.ProjectPermissionPayloadScheme) (*model.ProjectUserPermissionScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Update(ctx, workspace, projectKey, accountID, payload)
+}
+
+// Delete revokes the explicit permission of the specified user on the project.
+//
+// DELETE /2.0/workspaces/{workspace}/projects/{project_key}/permissions-config/users/{selected_user_id}
+func (p *ProjectUserPermissionService) Delete(ctx context.Context, workspace, projectKey, accountID string) (*model.ResponseScheme, error) {
+	return p.internalClient.Delete(ctx, workspace, projectKey, accountID)
+}
+
+type internalProjectUserPermissionServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the explicit user permissions of the specified project.
+func (i *internalProjectUserPermissionServiceImpl) Gets(ctx context.Context, workspace, projectKey string) (*model.ProjectUserPermissionPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if projectKey == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoProjectKey)
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/projects/%v/permissions-config/users", workspace, projectKey)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.ProjectUserPermissionPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Get returns the explicit permission of the specified user on the project.
+func (i *internalProjectUserPermissionServiceImpl) Get(ctx context.Context, workspace, projectKey, accountID string) (*model.ProjectUserPermissionScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if projectKey == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoProjectKey)
+	}
+
+	if accountID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoAccountID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/projects/%v/permissions-config/users/%v", workspace, projectKey, accountID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	permission := new(model.ProjectUserPermissionScheme)
+	response, err := i.c.Call(request, permission)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return permission, response, nil
+}
+
+// Update grants, or changes, the explicit permission of the specified user on the project.
+func (i *internalProjectUserPermissionServiceImpl) Update(ctx context.Context, workspace, projectKey, accountID string, payload *model.ProjectPermissionPayloadScheme) (*model.ProjectUserPermissionScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if projectKey == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoProjectKey)
+	}
+
+	if accountID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoAccountID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/projects/%v/permissions-config/users/%v", workspace, projectKey, accountID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	permission := new(model.ProjectUserPermissionScheme)
+	response, err := i.c.Call(request, permission)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return permission, response, nil
+}
+
+// Delete revokes the explicit permission of the specified user on the project.
+func (i *internalProjectUserPermissionServiceImpl) Delete(ctx context.Context, workspace, projectKey, accountID string) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if projectKey == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoProjectKey)
+	}
+
+	if accountID == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoAccountID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/projects/%v/permissions-config/users/%v", workspace, projectKey, accountID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
@@ -0,0 +1,564 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func Test_internalProjectUserPermissionServiceImpl_Gets(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx        context.Context
+		workspace  string
+		projectKey string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:        context.Background(),
+				workspace:  "work-space-name-sample",
+				projectKey: "PRJ",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/workspaces/work-space-name-sample/projects/PRJ/permissions-config/users",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ProjectUserPermissionPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:        context.Background(),
+				workspace:  "work-space-name-sample",
+				projectKey: "PRJ",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/workspaces/work-space-name-sample/projects/PRJ/permissions-config/users",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:        context.Background(),
+				workspace:  "",
+				projectKey: "PRJ",
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the project key is not provided",
+			args: args{
+				ctx:        context.Background(),
+				workspace:  "work-space-name-sample",
+				projectKey: "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoProjectKey,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewProjectUserPermissionService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Gets(testCase.args.ctx, testCase.args.workspace, testCase.args.projectKey)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalProjectUserPermissionServiceImpl_Get(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx        context.Context
+		workspace  string
+		projectKey string
+		accountID  string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:        context.Background(),
+				workspace:  "work-space-name-sample",
+				projectKey: "PRJ",
+				accountID:  "{d301aafa-d676-4ee0-88be-962be7417567}",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/workspaces/work-space-name-sample/projects/PRJ/permissions-config/users/{d301aafa-d676-4ee0-88be-962be7417567}",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ProjectUserPermissionScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:        context.Background(),
+				workspace:  "work-space-name-sample",
+				projectKey: "PRJ",
+				accountID:  "{d301aafa-d676-4ee0-88be-962be7417567}",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/workspaces/work-space-name-sample/projects/PRJ/permissions-config/users/{d301aafa-d676-4ee0-88be-962be7417567}",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:        context.Background(),
+				workspace:  "",
+				projectKey: "PRJ",
+				accountID:  "{d301aafa-d676-4ee0-88be-962be7417567}",
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the project key is not provided",
+			args: args{
+				ctx:        context.Background(),
+				workspace:  "work-space-name-sample",
+				projectKey: "",
+				accountID:  "{d301aafa-d676-4ee0-88be-962be7417567}",
+			},
+			wantErr: true,
+			Err:     model.ErrNoProjectKey,
+		},
+
+		{
+			name: "when the account id is not provided",
+			args: args{
+				ctx:        context.Background(),
+				workspace:  "work-space-name-sample",
+				projectKey: "PRJ",
+				accountID:  "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoAccountID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewProjectUserPermissionService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Get(testCase.args.ctx, testCase.args.workspace, testCase.args.projectKey, testCase.args.accountID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalProjectUserPermissionServiceImpl_Update(t *testing.T) {
+
+	payloadMocked := &model.ProjectPermissionPayloadScheme{Permission: "create-repo"}
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx        context.Context
+		workspace  string
+		projectKey string
+		accountID  string
+		payload    *model.ProjectPermissionPayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:        context.Background(),
+				workspace:  "work-space-name-sample",
+				projectKey: "PRJ",
+				accountID:  "{d301aafa-d676-4ee0-88be-962be7417567}",
+				payload:    payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"2.0/workspaces/work-space-name-sample/projects/PRJ/permissions-config/users/{d301aafa-d676-4ee0-88be-962be7417567}",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ProjectUserPermissionScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:        context.Background(),
+				workspace:  "work-space-name-sample",
+				projectKey: "PRJ",
+				accountID:  "{d301aafa-d676-4ee0-88be-962be7417567}",
+				payload:    payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"2.0/workspaces/work-space-name-sample/projects/PRJ/permissions-config/users/{d301aafa-d676-4ee0-88be-962be7417567}",
+					"", payloadMocked).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:        context.Background(),
+				workspace:  "",
+				projectKey: "PRJ",
+				accountID:  "{d301aafa-d676-4ee0-88be-962be7417567}",
+				payload:    payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the project key is not provided",
+			args: args{
+				ctx:        context.Background(),
+				workspace:  "work-space-name-sample",
+				projectKey: "",
+				accountID:  "{d301aafa-d676-4ee0-88be-962be7417567}",
+				payload:    payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoProjectKey,
+		},
+
+		{
+			name: "when the account id is not provided",
+			args: args{
+				ctx:        context.Background(),
+				workspace:  "work-space-name-sample",
+				projectKey: "PRJ",
+				accountID:  "",
+				payload:    payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoAccountID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewProjectUserPermissionService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Update(testCase.args.ctx, testCase.args.workspace, testCase.args.projectKey, testCase.args.accountID, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalProjectUserPermissionServiceImpl_Delete(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx        context.Context
+		workspace  string
+		projectKey string
+		accountID  string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:        context.Background(),
+				workspace:  "work-space-name-sample",
+				projectKey: "PRJ",
+				accountID:  "{d301aafa-d676-4ee0-88be-962be7417567}",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"2.0/workspaces/work-space-name-sample/projects/PRJ/permissions-config/users/{d301aafa-d676-4ee0-88be-962be7417567}",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:        context.Background(),
+				workspace:  "work-space-name-sample",
+				projectKey: "PRJ",
+				accountID:  "{d301aafa-d676-4ee0-88be-962be7417567}",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"2.0/workspaces/work-space-name-sample/projects/PRJ/permissions-config/users/{d301aafa-d676-4ee0-88be-962be7417567}",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:        context.Background(),
+				workspace:  "",
+				projectKey: "PRJ",
+				accountID:  "{d301aafa-d676-4ee0-88be-962be7417567}",
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the project key is not provided",
+			args: args{
+				ctx:        context.Background(),
+				workspace:  "work-space-name-sample",
+				projectKey: "",
+				accountID:  "{d301aafa-d676-4ee0-88be-962be7417567}",
+			},
+			wantErr: true,
+			Err:     model.ErrNoProjectKey,
+		},
+
+		{
+			name: "when the account id is not provided",
+			args: args{
+				ctx:        context.Background(),
+				workspace:  "work-space-name-sample",
+				projectKey: "PRJ",
+				accountID:  "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoAccountID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewProjectUserPermissionService(testCase.fields.c)
+
+			gotResponse, err := newService.Delete(testCase.args.ctx, testCase.args.workspace, testCase.args.projectKey, testCase.args.accountID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
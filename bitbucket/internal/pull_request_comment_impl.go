@@ -0,0 +1,331 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewPullRequestCommentService handles communication with the pull request comment related methods of the Bitbucket API.
+func NewPullRequestCommentService(client service.Connector) *PullRequestCommentService {
+
+	return &PullRequestCommentService{
+		internalClient: &internalPullRequestCommentServiceImpl{c: client},
+		c:              client,
+	}
+}
+
+// PullRequestCommentService handles communication with the pull request comment related methods of the Bitbucket API.
+type PullRequestCommentService struct {
+	internalClient bitbucket.PullRequestCommentConnector
+	c              service.Connector
+}
+
+// Gets returns a paginated list of the comments on the specified pull request, the deleted comments included.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments
+func (p *PullRequestCommentService) Gets(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*model.PullRequestCommentPageScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Gets(ctx, workspace, repoSlug, pullRequestID)
+}
+
+// GetsAll iterates over all the comments on the specified pull request.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments
+func (p *PullRequestCommentService) GetsAll(ctx context.Context, workspace, repoSlug string, pullRequestID int, opts ...paginate.Option) iter.Seq2[*model.PullRequestCommentScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.PullRequestCommentScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.PullRequestCommentScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	if pullRequestID == 0 {
+		return paginateError[*model.PullRequestCommentScheme](fmt.Errorf("bitbucket: %w", model.ErrNoPullRequestID))
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/comments", workspace, repoSlug, pullRequestID)
+	return paginateLinks[*model.PullRequestCommentScheme](ctx, p.c, endpoint, opts)
+}
+
+// Get returns the specified pull request comment.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments/{comment_id}
+func (p *PullRequestCommentService) Get(ctx context.Context, workspace, repoSlug string, pullRequestID, commentID int) (*model.PullRequestCommentScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Get(ctx, workspace, repoSlug, pullRequestID, commentID)
+}
+
+// Create creates a new pull request comment.
+//
+// Set the parent of the payload to reply to a comment, and its inline location to comment a line of a file.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments
+func (p *PullRequestCommentService) Create(ctx context.Context, workspace, repoSlug string, pullRequestID int, payload *model.PullRequestCommentPayloadScheme) (*model.PullRequestCommentScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Create(ctx, workspace, repoSlug, pullRequestID, payload)
+}
+
+// Update updates the specified pull request comment.
+//
+// PUT /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments/{comment_id}
+func (p *PullRequestCommentService) Update(ctx context.Context, workspace, repoSlug string, pullRequestID, commentID int, payload *model.PullRequestCommentPayloadScheme) (*model.PullRequestCommentScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Update(ctx, workspace, repoSlug, pullRequestID, commentID, payload)
+}
+
+// Delete deletes the specified pull request comment.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments/{comment_id}
+func (p *PullRequestCommentService) Delete(ctx context.Context, workspace, repoSlug string, pullRequestID, commentID int) (*model.ResponseScheme, error) {
+	return p.internalClient.Delete(ctx, workspace, repoSlug, pullRequestID, commentID)
+}
+
+// Resolve resolves the thread of the specified pull request comment.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments/{comment_id}/resolve
+func (p *PullRequestCommentService) Resolve(ctx context.Context, workspace, repoSlug string, pullRequestID, commentID int) (*model.PullRequestCommentResolutionScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Resolve(ctx, workspace, repoSlug, pullRequestID, commentID)
+}
+
+// Reopen reopens the resolved thread of the specified pull request comment.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments/{comment_id}/resolve
+func (p *PullRequestCommentService) Reopen(ctx context.Context, workspace, repoSlug string, pullRequestID, commentID int) (*model.ResponseScheme, error) {
+	return p.internalClient.Reopen(ctx, workspace, repoSlug, pullRequestID, commentID)
+}
+
+type internalPullRequestCommentServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the comments on the specified pull request, the deleted comments included.
+func (i *internalPullRequestCommentServiceImpl) Gets(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*model.PullRequestCommentPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if pullRequestID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoPullRequestID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/comments", workspace, repoSlug, pullRequestID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.PullRequestCommentPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Get returns the specified pull request comment.
+func (i *internalPullRequestCommentServiceImpl) Get(ctx context.Context, workspace, repoSlug string, pullRequestID, commentID int) (*model.PullRequestCommentScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if pullRequestID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoPullRequestID)
+	}
+
+	if commentID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommentID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/comments/%v", workspace, repoSlug, pullRequestID, commentID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comment := new(model.PullRequestCommentScheme)
+	response, err := i.c.Call(request, comment)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return comment, response, nil
+}
+
+// Create creates a new pull request comment.
+func (i *internalPullRequestCommentServiceImpl) Create(ctx context.Context, workspace, repoSlug string, pullRequestID int, payload *model.PullRequestCommentPayloadScheme) (*model.PullRequestCommentScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if pullRequestID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoPullRequestID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/comments", workspace, repoSlug, pullRequestID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comment := new(model.PullRequestCommentScheme)
+	response, err := i.c.Call(request, comment)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return comment, response, nil
+}
+
+// Update updates the specified pull request comment.
+func (i *internalPullRequestCommentServiceImpl) Update(ctx context.Context, workspace, repoSlug string, pullRequestID, commentID int, payload *model.PullRequestCommentPayloadScheme) (*model.PullRequestCommentScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if pullRequestID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoPullRequestID)
+	}
+
+	if commentID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommentID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/comments/%v", workspace, repoSlug, pullRequestID, commentID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comment := new(model.PullRequestCommentScheme)
+	response, err := i.c.Call(request, comment)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return comment, response, nil
+}
+
+// Delete deletes the specified pull request comment.
+func (i *internalPullRequestCommentServiceImpl) Delete(ctx context.Context, workspace, repoSlug string, pullRequestID, commentID int) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if pullRequestID == 0 {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoPullRequestID)
+	}
+
+	if commentID == 0 {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommentID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/comments/%v", workspace, repoSlug, pullRequestID, commentID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+// Resolve resolves the thread of the specified pull request comment.
+func (i *internalPullRequestCommentServiceImpl) Resolve(ctx context.Context, workspace, repoSlug string, pullRequestID, commentID int) (*model.PullRequestCommentResolutionScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if pullRequestID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoPullRequestID)
+	}
+
+	if commentID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommentID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/comments/%v/resolve", workspace, repoSlug, pullRequestID, commentID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resolution := new(model.PullRequestCommentResolutionScheme)
+	response, err := i.c.Call(request, resolution)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return resolution, response, nil
+}
+
+// Reopen reopens the resolved thread of the specified pull request comment.
+func (i *internalPullRequestCommentServiceImpl) Reopen(ctx context.Context, workspace, repoSlug string, pullRequestID, commentID int) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if pullRequestID == 0 {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoPullRequestID)
+	}
+
+	if commentID == 0 {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommentID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/comments/%v/resolve", workspace, repoSlug, pullRequestID, commentID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
@@ -0,0 +1,624 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"net/url"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewPullRequestService handles communication with the pull request related methods of the Bitbucket API.
+func NewPullRequestService(client service.Connector, comment *PullRequestCommentService, task *PullRequestTaskService) *PullRequestService {
+
+	return &PullRequestService{
+		internalClient: &internalPullRequestServiceImpl{c: client},
+		c:              client,
+		Comment:        comment,
+		Task:           task,
+	}
+}
+
+// PullRequestService handles communication with the pull request related methods of the Bitbucket API.
+type PullRequestService struct {
+	internalClient bitbucket.PullRequestConnector
+	c              service.Connector
+	Comment        *PullRequestCommentService
+	Task           *PullRequestTaskService
+}
+
+// Gets returns a paginated list of the pull requests of the specified repository.
+//
+// Only the open pull requests are returned, unless other states are requested.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests
+func (p *PullRequestService) Gets(ctx context.Context, workspace, repoSlug string, options *model.PullRequestOptionsScheme) (*model.PullRequestPageScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Gets(ctx, workspace, repoSlug, options)
+}
+
+// GetsAll iterates over all the pull requests of the specified repository matching the options.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests
+func (p *PullRequestService) GetsAll(ctx context.Context, workspace, repoSlug string, options *model.PullRequestOptionsScheme, opts ...paginate.Option) iter.Seq2[*model.PullRequestScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.PullRequestScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.PullRequestScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	endpoint := pullRequestListEndpoint(fmt.Sprintf("2.0/repositories/%v/%v/pullrequests", workspace, repoSlug), options)
+	return paginateLinks[*model.PullRequestScheme](ctx, p.c, endpoint, opts)
+}
+
+// Get returns the specified pull request.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}
+func (p *PullRequestService) Get(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*model.PullRequestScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Get(ctx, workspace, repoSlug, pullRequestID)
+}
+
+// Create creates a new pull request where the destination repository is this repository and the author is the authenticated user.
+//
+// The source branch is required, the destination branch defaults to the main branch of the repository.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/pullrequests
+func (p *PullRequestService) Create(ctx context.Context, workspace, repoSlug string, payload *model.PullRequestPayloadScheme) (*model.PullRequestScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Create(ctx, workspace, repoSlug, payload)
+}
+
+// Update mutates the specified pull request.
+//
+// PUT /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}
+func (p *PullRequestService) Update(ctx context.Context, workspace, repoSlug string, pullRequestID int, payload *model.PullRequestPayloadScheme) (*model.PullRequestScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Update(ctx, workspace, repoSlug, pullRequestID, payload)
+}
+
+// Approve approves the specified pull request as the authenticated user.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/approve
+func (p *PullRequestService) Approve(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*model.PullRequestParticipantScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Approve(ctx, workspace, repoSlug, pullRequestID)
+}
+
+// Unapprove redacts the authenticated user's approval of the specified pull request.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/approve
+func (p *PullRequestService) Unapprove(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*model.ResponseScheme, error) {
+	return p.internalClient.Unapprove(ctx, workspace, repoSlug, pullRequestID)
+}
+
+// RequestChanges requests changes on the specified pull request as the authenticated user.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/request-changes
+func (p *PullRequestService) RequestChanges(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*model.PullRequestParticipantScheme, *model.ResponseScheme, error) {
+	return p.internalClient.RequestChanges(ctx, workspace, repoSlug, pullRequestID)
+}
+
+// RemoveChangeRequest removes the change request of the authenticated user on the specified pull request.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/request-changes
+func (p *PullRequestService) RemoveChangeRequest(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*model.ResponseScheme, error) {
+	return p.internalClient.RemoveChangeRequest(ctx, workspace, repoSlug, pullRequestID)
+}
+
+// Decline declines the specified pull request.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/decline
+func (p *PullRequestService) Decline(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*model.PullRequestScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Decline(ctx, workspace, repoSlug, pullRequestID)
+}
+
+// Merge merges the specified pull request, using the merge strategy of the payload when provided.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/merge
+func (p *PullRequestService) Merge(ctx context.Context, workspace, repoSlug string, pullRequestID int, payload *model.PullRequestMergePayloadScheme) (*model.PullRequestScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Merge(ctx, workspace, repoSlug, pullRequestID, payload)
+}
+
+// Diff returns the diff of the specified pull request as a reader, the caller must close it.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/diff
+func (p *PullRequestService) Diff(ctx context.Context, workspace, repoSlug string, pullRequestID int) (io.ReadCloser, error) {
+	return p.internalClient.Diff(ctx, workspace, repoSlug, pullRequestID)
+}
+
+// Patch returns the patch of the specified pull request as a reader, the caller must close it.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/patch
+func (p *PullRequestService) Patch(ctx context.Context, workspace, repoSlug string, pullRequestID int) (io.ReadCloser, error) {
+	return p.internalClient.Patch(ctx, workspace, repoSlug, pullRequestID)
+}
+
+// DiffStat returns a paginated list of the files modified by the specified pull request.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/diffstat
+func (p *PullRequestService) DiffStat(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*model.DiffStatPageScheme, *model.ResponseScheme, error) {
+	return p.internalClient.DiffStat(ctx, workspace, repoSlug, pullRequestID)
+}
+
+// DiffStatAll iterates over all the files modified by the specified pull request.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/diffstat
+func (p *PullRequestService) DiffStatAll(ctx context.Context, workspace, repoSlug string, pullRequestID int, opts ...paginate.Option) iter.Seq2[*model.DiffStatScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.DiffStatScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.DiffStatScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	if pullRequestID == 0 {
+		return paginateError[*model.DiffStatScheme](fmt.Errorf("bitbucket: %w", model.ErrNoPullRequestID))
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/diffstat", workspace, repoSlug, pullRequestID)
+	return paginateLinks[*model.DiffStatScheme](ctx, p.c, endpoint, opts)
+}
+
+// Activity returns a paginated list of the activity of the specified pull request:
+//
+// its updates, approvals, change requests and comments.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/activity
+func (p *PullRequestService) Activity(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*model.PullRequestActivityPageScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Activity(ctx, workspace, repoSlug, pullRequestID)
+}
+
+// ActivityAll iterates over all the activity of the specified pull request.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/activity
+func (p *PullRequestService) ActivityAll(ctx context.Context, workspace, repoSlug string, pullRequestID int, opts ...paginate.Option) iter.Seq2[*model.PullRequestActivityScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.PullRequestActivityScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.PullRequestActivityScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	if pullRequestID == 0 {
+		return paginateError[*model.PullRequestActivityScheme](fmt.Errorf("bitbucket: %w", model.ErrNoPullRequestID))
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/activity", workspace, repoSlug, pullRequestID)
+	return paginateLinks[*model.PullRequestActivityScheme](ctx, p.c, endpoint, opts)
+}
+
+type internalPullRequestServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the pull requests of the specified repository.
+func (i *internalPullRequestServiceImpl) Gets(ctx context.Context, workspace, repoSlug string, options *model.PullRequestOptionsScheme) (*model.PullRequestPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := pullRequestListEndpoint(fmt.Sprintf("2.0/repositories/%v/%v/pullrequests", workspace, repoSlug), options)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.PullRequestPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Get returns the specified pull request.
+func (i *internalPullRequestServiceImpl) Get(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*model.PullRequestScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if pullRequestID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoPullRequestID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v", workspace, repoSlug, pullRequestID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pullRequest := new(model.PullRequestScheme)
+	response, err := i.c.Call(request, pullRequest)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return pullRequest, response, nil
+}
+
+// Create creates a new pull request.
+func (i *internalPullRequestServiceImpl) Create(ctx context.Context, workspace, repoSlug string, payload *model.PullRequestPayloadScheme) (*model.PullRequestScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pullRequest := new(model.PullRequestScheme)
+	response, err := i.c.Call(request, pullRequest)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return pullRequest, response, nil
+}
+
+// Update mutates the specified pull request.
+func (i *internalPullRequestServiceImpl) Update(ctx context.Context, workspace, repoSlug string, pullRequestID int, payload *model.PullRequestPayloadScheme) (*model.PullRequestScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if pullRequestID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoPullRequestID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v", workspace, repoSlug, pullRequestID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pullRequest := new(model.PullRequestScheme)
+	response, err := i.c.Call(request, pullRequest)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return pullRequest, response, nil
+}
+
+// Approve approves the specified pull request as the authenticated user.
+func (i *internalPullRequestServiceImpl) Approve(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*model.PullRequestParticipantScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if pullRequestID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoPullRequestID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/approve", workspace, repoSlug, pullRequestID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	participant := new(model.PullRequestParticipantScheme)
+	response, err := i.c.Call(request, participant)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return participant, response, nil
+}
+
+// Unapprove redacts the authenticated user's approval of the specified pull request.
+func (i *internalPullRequestServiceImpl) Unapprove(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if pullRequestID == 0 {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoPullRequestID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/approve", workspace, repoSlug, pullRequestID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+// RequestChanges requests changes on the specified pull request as the authenticated user.
+func (i *internalPullRequestServiceImpl) RequestChanges(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*model.PullRequestParticipantScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if pullRequestID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoPullRequestID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/request-changes", workspace, repoSlug, pullRequestID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	participant := new(model.PullRequestParticipantScheme)
+	response, err := i.c.Call(request, participant)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return participant, response, nil
+}
+
+// RemoveChangeRequest removes the change request of the authenticated user on the specified pull request.
+func (i *internalPullRequestServiceImpl) RemoveChangeRequest(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if pullRequestID == 0 {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoPullRequestID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/request-changes", workspace, repoSlug, pullRequestID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+// Decline declines the specified pull request.
+func (i *internalPullRequestServiceImpl) Decline(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*model.PullRequestScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if pullRequestID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoPullRequestID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/decline", workspace, repoSlug, pullRequestID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pullRequest := new(model.PullRequestScheme)
+	response, err := i.c.Call(request, pullRequest)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return pullRequest, response, nil
+}
+
+// Merge merges the specified pull request, using the merge strategy of the payload when provided.
+func (i *internalPullRequestServiceImpl) Merge(ctx context.Context, workspace, repoSlug string, pullRequestID int, payload *model.PullRequestMergePayloadScheme) (*model.PullRequestScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if pullRequestID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoPullRequestID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/merge", workspace, repoSlug, pullRequestID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pullRequest := new(model.PullRequestScheme)
+	response, err := i.c.Call(request, pullRequest)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return pullRequest, response, nil
+}
+
+// Diff returns the diff of the specified pull request as a reader, the caller must close it.
+func (i *internalPullRequestServiceImpl) Diff(ctx context.Context, workspace, repoSlug string, pullRequestID int) (io.ReadCloser, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if pullRequestID == 0 {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoPullRequestID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/diff", workspace, repoSlug, pullRequestID)
+	return download(ctx, i.c, endpoint)
+}
+
+// Patch returns the patch of the specified pull request as a reader, the caller must close it.
+func (i *internalPullRequestServiceImpl) Patch(ctx context.Context, workspace, repoSlug string, pullRequestID int) (io.ReadCloser, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if pullRequestID == 0 {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoPullRequestID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/patch", workspace, repoSlug, pullRequestID)
+	return download(ctx, i.c, endpoint)
+}
+
+// DiffStat returns a paginated list of the files modified by the specified pull request.
+func (i *internalPullRequestServiceImpl) DiffStat(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*model.DiffStatPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if pullRequestID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoPullRequestID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/diffstat", workspace, repoSlug, pullRequestID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.DiffStatPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Activity returns a paginated list of the activity of the specified pull request.
+func (i *internalPullRequestServiceImpl) Activity(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*model.PullRequestActivityPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if pullRequestID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoPullRequestID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/activity", workspace, repoSlug, pullRequestID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.PullRequestActivityPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// pullRequestListEndpoint appends the state, query and sort filters to the endpoint listing pull requests.
+func pullRequestListEndpoint(base string, options *model.PullRequestOptionsScheme) string {
+
+	if options == nil {
+		return base
+	}
+
+	params := url.Values{}
+	for _, state := range options.States {
+		params.Add("state", state)
+	}
+
+	if options.Query != "" {
+		params.Add("q", options.Query)
+	}
+
+	if options.Sort != "" {
+		params.Add("sort", options.Sort)
+	}
+
+	if len(params) == 0 {
+		return base
+	}
+
+	return fmt.Sprintf("%v?%v", base, params.Encode())
+}
@@ -0,0 +1,1801 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func Test_internalPullRequestServiceImpl_Gets(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		repoSlug  string
+		options   *model.PullRequestOptionsScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				options: &model.PullRequestOptionsScheme{
+					States: []string{"OPEN", "MERGED"},
+					Query:  `author.nickname = "jdoe"`,
+					Sort:   "-updated_on",
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests?q=author.nickname+%3D+%22jdoe%22&sort=-updated_on&state=OPEN&state=MERGED",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.PullRequestPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				options: &model.PullRequestOptionsScheme{
+					States: []string{"OPEN", "MERGED"},
+					Query:  `author.nickname = "jdoe"`,
+					Sort:   "-updated_on",
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests?q=author.nickname+%3D+%22jdoe%22&sort=-updated_on&state=OPEN&state=MERGED",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "",
+				repoSlug:  "repository-sample",
+				options: &model.PullRequestOptionsScheme{
+					States: []string{"OPEN", "MERGED"},
+					Query:  `author.nickname = "jdoe"`,
+					Sort:   "-updated_on",
+				},
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "",
+				options: &model.PullRequestOptionsScheme{
+					States: []string{"OPEN", "MERGED"},
+					Query:  `author.nickname = "jdoe"`,
+					Sort:   "-updated_on",
+				},
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPullRequestService(testCase.fields.c, nil, nil)
+
+			gotResult, gotResponse, err := newService.Gets(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPullRequestServiceImpl_Get(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx           context.Context
+		workspace     string
+		repoSlug      string
+		pullRequestID int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests/12",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.PullRequestScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests/12",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "",
+				pullRequestID: 12,
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the pull request id is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 0,
+			},
+			wantErr: true,
+			Err:     model.ErrNoPullRequestID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPullRequestService(testCase.fields.c, nil, nil)
+
+			gotResult, gotResponse, err := newService.Get(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.pullRequestID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPullRequestServiceImpl_Create(t *testing.T) {
+
+	payloadMocked := &model.PullRequestPayloadScheme{
+		Title:  "Add the pull request service",
+		Source: &model.PullRequestEndpointScheme{Branch: &model.PullRequestBranchScheme{Name: "feature/pull-requests"}},
+	}
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		repoSlug  string
+		payload   *model.PullRequestPayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				payload:   payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.PullRequestScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				payload:   payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests",
+					"", payloadMocked).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "",
+				repoSlug:  "repository-sample",
+				payload:   payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "",
+				payload:   payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPullRequestService(testCase.fields.c, nil, nil)
+
+			gotResult, gotResponse, err := newService.Create(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPullRequestServiceImpl_Update(t *testing.T) {
+
+	payloadMocked := &model.PullRequestPayloadScheme{Title: "Add the pull request service"}
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx           context.Context
+		workspace     string
+		repoSlug      string
+		pullRequestID int
+		payload       *model.PullRequestPayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+				payload:       payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests/12",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.PullRequestScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+				payload:       payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests/12",
+					"", payloadMocked).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+				payload:       payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "",
+				pullRequestID: 12,
+				payload:       payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the pull request id is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 0,
+				payload:       payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoPullRequestID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPullRequestService(testCase.fields.c, nil, nil)
+
+			gotResult, gotResponse, err := newService.Update(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.pullRequestID, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPullRequestServiceImpl_Approve(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx           context.Context
+		workspace     string
+		repoSlug      string
+		pullRequestID int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests/12/approve",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.PullRequestParticipantScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests/12/approve",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "",
+				pullRequestID: 12,
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the pull request id is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 0,
+			},
+			wantErr: true,
+			Err:     model.ErrNoPullRequestID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPullRequestService(testCase.fields.c, nil, nil)
+
+			gotResult, gotResponse, err := newService.Approve(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.pullRequestID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPullRequestServiceImpl_Unapprove(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx           context.Context
+		workspace     string
+		repoSlug      string
+		pullRequestID int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests/12/approve",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests/12/approve",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "",
+				pullRequestID: 12,
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the pull request id is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 0,
+			},
+			wantErr: true,
+			Err:     model.ErrNoPullRequestID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPullRequestService(testCase.fields.c, nil, nil)
+
+			gotResponse, err := newService.Unapprove(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.pullRequestID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPullRequestServiceImpl_RequestChanges(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx           context.Context
+		workspace     string
+		repoSlug      string
+		pullRequestID int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests/12/request-changes",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.PullRequestParticipantScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests/12/request-changes",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "",
+				pullRequestID: 12,
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the pull request id is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 0,
+			},
+			wantErr: true,
+			Err:     model.ErrNoPullRequestID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPullRequestService(testCase.fields.c, nil, nil)
+
+			gotResult, gotResponse, err := newService.RequestChanges(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.pullRequestID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPullRequestServiceImpl_RemoveChangeRequest(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx           context.Context
+		workspace     string
+		repoSlug      string
+		pullRequestID int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests/12/request-changes",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests/12/request-changes",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "",
+				pullRequestID: 12,
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the pull request id is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 0,
+			},
+			wantErr: true,
+			Err:     model.ErrNoPullRequestID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPullRequestService(testCase.fields.c, nil, nil)
+
+			gotResponse, err := newService.RemoveChangeRequest(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.pullRequestID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPullRequestServiceImpl_Decline(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx           context.Context
+		workspace     string
+		repoSlug      string
+		pullRequestID int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests/12/decline",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.PullRequestScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests/12/decline",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "",
+				pullRequestID: 12,
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the pull request id is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 0,
+			},
+			wantErr: true,
+			Err:     model.ErrNoPullRequestID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPullRequestService(testCase.fields.c, nil, nil)
+
+			gotResult, gotResponse, err := newService.Decline(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.pullRequestID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPullRequestServiceImpl_Merge(t *testing.T) {
+
+	payloadMocked := &model.PullRequestMergePayloadScheme{
+		MergeStrategy:     "squash",
+		CloseSourceBranch: true,
+	}
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx           context.Context
+		workspace     string
+		repoSlug      string
+		pullRequestID int
+		payload       *model.PullRequestMergePayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+				payload:       payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests/12/merge",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.PullRequestScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+				payload:       payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests/12/merge",
+					"", payloadMocked).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+				payload:       payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "",
+				pullRequestID: 12,
+				payload:       payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the pull request id is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 0,
+				payload:       payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoPullRequestID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPullRequestService(testCase.fields.c, nil, nil)
+
+			gotResult, gotResponse, err := newService.Merge(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.pullRequestID, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPullRequestServiceImpl_DiffStat(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx           context.Context
+		workspace     string
+		repoSlug      string
+		pullRequestID int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests/12/diffstat",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.DiffStatPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests/12/diffstat",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "",
+				pullRequestID: 12,
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the pull request id is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 0,
+			},
+			wantErr: true,
+			Err:     model.ErrNoPullRequestID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPullRequestService(testCase.fields.c, nil, nil)
+
+			gotResult, gotResponse, err := newService.DiffStat(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.pullRequestID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPullRequestServiceImpl_Activity(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx           context.Context
+		workspace     string
+		repoSlug      string
+		pullRequestID int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests/12/activity",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.PullRequestActivityPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests/12/activity",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "",
+				pullRequestID: 12,
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the pull request id is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 0,
+			},
+			wantErr: true,
+			Err:     model.ErrNoPullRequestID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPullRequestService(testCase.fields.c, nil, nil)
+
+			gotResult, gotResponse, err := newService.Activity(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.pullRequestID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPullRequestServiceImpl_Diff(t *testing.T) {
+
+	testCases := []struct {
+		name      string
+		on        func() *mocks.Connector
+		workspace string
+		wantBody  string
+		wantErr   bool
+		Err       error
+	}{
+		{
+			name:      "when the diff is streamed",
+			workspace: "work-space-name-sample",
+			on: func() *mocks.Connector {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests/12/diff",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Do", &http.Request{}).
+					Return(&http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader("diff --git a/go.mod b/go.mod")),
+					}, nil)
+
+				return client
+			},
+			wantBody: "diff --git a/go.mod b/go.mod",
+		},
+
+		{
+			name:      "when the pull request is not found",
+			workspace: "work-space-name-sample",
+			on: func() *mocks.Connector {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests/12/diff",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Do", &http.Request{}).
+					Return(&http.Response{
+						StatusCode: http.StatusNotFound,
+						Body:       io.NopCloser(strings.NewReader(`{"type": "error"}`)),
+					}, nil)
+
+				return client
+			},
+			wantErr: true,
+			Err:     model.ErrNotFound,
+		},
+
+		{
+			name:    "when the workspace is not provided",
+			on:      func() *mocks.Connector { return mocks.NewConnector(t) },
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			newService := NewPullRequestService(testCase.on(), nil, nil)
+
+			reader, err := newService.Diff(context.Background(), testCase.workspace, "repository-sample", 12)
+
+			if testCase.wantErr {
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			defer reader.Close()
+
+			body, err := io.ReadAll(reader)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.wantBody, string(body))
+		})
+	}
+}
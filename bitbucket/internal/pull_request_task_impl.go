@@ -0,0 +1,251 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewPullRequestTaskService handles communication with the pull request task related methods of the Bitbucket API.
+func NewPullRequestTaskService(client service.Connector) *PullRequestTaskService {
+
+	return &PullRequestTaskService{
+		internalClient: &internalPullRequestTaskServiceImpl{c: client},
+		c:              client,
+	}
+}
+
+// PullRequestTaskService handles communication with the pull request task related methods of the Bitbucket API.
+type PullRequestTaskService struct {
+	internalClient bitbucket.PullRequestTaskConnector
+	c              service.Connector
+}
+
+// Gets returns a paginated list of the tasks on the specified pull request.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/tasks
+func (p *PullRequestTaskService) Gets(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*model.PullRequestTaskPageScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Gets(ctx, workspace, repoSlug, pullRequestID)
+}
+
+// GetsAll iterates over all the tasks on the specified pull request.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/tasks
+func (p *PullRequestTaskService) GetsAll(ctx context.Context, workspace, repoSlug string, pullRequestID int, opts ...paginate.Option) iter.Seq2[*model.PullRequestTaskScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.PullRequestTaskScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.PullRequestTaskScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	if pullRequestID == 0 {
+		return paginateError[*model.PullRequestTaskScheme](fmt.Errorf("bitbucket: %w", model.ErrNoPullRequestID))
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/tasks", workspace, repoSlug, pullRequestID)
+	return paginateLinks[*model.PullRequestTaskScheme](ctx, p.c, endpoint, opts)
+}
+
+// Get returns the specified pull request task.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/tasks/{task_id}
+func (p *PullRequestTaskService) Get(ctx context.Context, workspace, repoSlug string, pullRequestID, taskID int) (*model.PullRequestTaskScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Get(ctx, workspace, repoSlug, pullRequestID, taskID)
+}
+
+// Create creates a new pull request task, optionally attached to a comment.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/tasks
+func (p *PullRequestTaskService) Create(ctx context.Context, workspace, repoSlug string, pullRequestID int, payload *model.PullRequestTaskPayloadScheme) (*model.PullRequestTaskScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Create(ctx, workspace, repoSlug, pullRequestID, payload)
+}
+
+// Update updates the content or the state of the specified pull request task.
+//
+// PUT /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/tasks/{task_id}
+func (p *PullRequestTaskService) Update(ctx context.Context, workspace, repoSlug string, pullRequestID, taskID int, payload *model.PullRequestTaskPayloadScheme) (*model.PullRequestTaskScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Update(ctx, workspace, repoSlug, pullRequestID, taskID, payload)
+}
+
+// Delete deletes the specified pull request task.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/tasks/{task_id}
+func (p *PullRequestTaskService) Delete(ctx context.Context, workspace, repoSlug string, pullRequestID, taskID int) (*model.ResponseScheme, error) {
+	return p.internalClient.Delete(ctx, workspace, repoSlug, pullRequestID, taskID)
+}
+
+type internalPullRequestTaskServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the tasks on the specified pull request.
+func (i *internalPullRequestTaskServiceImpl) Gets(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*model.PullRequestTaskPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if pullRequestID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoPullRequestID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/tasks", workspace, repoSlug, pullRequestID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.PullRequestTaskPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Get returns the specified pull request task.
+func (i *internalPullRequestTaskServiceImpl) Get(ctx context.Context, workspace, repoSlug string, pullRequestID, taskID int) (*model.PullRequestTaskScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if pullRequestID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoPullRequestID)
+	}
+
+	if taskID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoTaskID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/tasks/%v", workspace, repoSlug, pullRequestID, taskID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	task := new(model.PullRequestTaskScheme)
+	response, err := i.c.Call(request, task)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return task, response, nil
+}
+
+// Create creates a new pull request task, optionally attached to a comment.
+func (i *internalPullRequestTaskServiceImpl) Create(ctx context.Context, workspace, repoSlug string, pullRequestID int, payload *model.PullRequestTaskPayloadScheme) (*model.PullRequestTaskScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if pullRequestID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoPullRequestID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/tasks", workspace, repoSlug, pullRequestID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	task := new(model.PullRequestTaskScheme)
+	response, err := i.c.Call(request, task)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return task, response, nil
+}
+
+// Update updates the content or the state of the specified pull request task.
+func (i *internalPullRequestTaskServiceImpl) Update(ctx context.Context, workspace, repoSlug string, pullRequestID, taskID int, payload *model.PullRequestTaskPayloadScheme) (*model.PullRequestTaskScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if pullRequestID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoPullRequestID)
+	}
+
+	if taskID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoTaskID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/tasks/%v", workspace, repoSlug, pullRequestID, taskID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	task := new(model.PullRequestTaskScheme)
+	response, err := i.c.Call(request, task)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return task, response, nil
+}
+
+// Delete deletes the specified pull request task.
+func (i *internalPullRequestTaskServiceImpl) Delete(ctx context.Context, workspace, repoSlug string, pullRequestID, taskID int) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if pullRequestID == 0 {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoPullRequestID)
+	}
+
+	if taskID == 0 {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoTaskID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/tasks/%v", workspace, repoSlug, pullRequestID, taskID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
@@ -0,0 +1,793 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func Test_internalPullRequestTaskServiceImpl_Gets(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx           context.Context
+		workspace     string
+		repoSlug      string
+		pullRequestID int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests/12/tasks",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.PullRequestTaskPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests/12/tasks",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "",
+				pullRequestID: 12,
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the pull request id is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 0,
+			},
+			wantErr: true,
+			Err:     model.ErrNoPullRequestID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPullRequestTaskService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Gets(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.pullRequestID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPullRequestTaskServiceImpl_Get(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx           context.Context
+		workspace     string
+		repoSlug      string
+		pullRequestID int
+		taskID        int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+				taskID:        88,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests/12/tasks/88",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.PullRequestTaskScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+				taskID:        88,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests/12/tasks/88",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+				taskID:        88,
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "",
+				pullRequestID: 12,
+				taskID:        88,
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the pull request id is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 0,
+				taskID:        88,
+			},
+			wantErr: true,
+			Err:     model.ErrNoPullRequestID,
+		},
+
+		{
+			name: "when the task id is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+				taskID:        0,
+			},
+			wantErr: true,
+			Err:     model.ErrNoTaskID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPullRequestTaskService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Get(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.pullRequestID, testCase.args.taskID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPullRequestTaskServiceImpl_Create(t *testing.T) {
+
+	payloadMocked := &model.PullRequestTaskPayloadScheme{
+		Content: &model.BitbucketContentScheme{Raw: "Update the documentation"},
+		State:   "UNRESOLVED",
+	}
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx           context.Context
+		workspace     string
+		repoSlug      string
+		pullRequestID int
+		payload       *model.PullRequestTaskPayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+				payload:       payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests/12/tasks",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.PullRequestTaskScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+				payload:       payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests/12/tasks",
+					"", payloadMocked).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+				payload:       payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "",
+				pullRequestID: 12,
+				payload:       payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the pull request id is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 0,
+				payload:       payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoPullRequestID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPullRequestTaskService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Create(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.pullRequestID, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPullRequestTaskServiceImpl_Update(t *testing.T) {
+
+	payloadMocked := &model.PullRequestTaskPayloadScheme{
+		Content: &model.BitbucketContentScheme{Raw: "Update the documentation"},
+		State:   "UNRESOLVED",
+	}
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx           context.Context
+		workspace     string
+		repoSlug      string
+		pullRequestID int
+		taskID        int
+		payload       *model.PullRequestTaskPayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+				taskID:        88,
+				payload:       payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests/12/tasks/88",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.PullRequestTaskScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+				taskID:        88,
+				payload:       payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests/12/tasks/88",
+					"", payloadMocked).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+				taskID:        88,
+				payload:       payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "",
+				pullRequestID: 12,
+				taskID:        88,
+				payload:       payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the pull request id is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 0,
+				taskID:        88,
+				payload:       payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoPullRequestID,
+		},
+
+		{
+			name: "when the task id is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+				taskID:        0,
+				payload:       payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoTaskID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPullRequestTaskService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Update(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.pullRequestID, testCase.args.taskID, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPullRequestTaskServiceImpl_Delete(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx           context.Context
+		workspace     string
+		repoSlug      string
+		pullRequestID int
+		taskID        int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+				taskID:        88,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests/12/tasks/88",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+				taskID:        88,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"2.0/repositories/work-space-name-sample/repository-sample/pullrequests/12/tasks/88",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+				taskID:        88,
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "",
+				pullRequestID: 12,
+				taskID:        88,
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the pull request id is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 0,
+				taskID:        88,
+			},
+			wantErr: true,
+			Err:     model.ErrNoPullRequestID,
+		},
+
+		{
+			name: "when the task id is not provided",
+			args: args{
+				ctx:           context.Background(),
+				workspace:     "work-space-name-sample",
+				repoSlug:      "repository-sample",
+				pullRequestID: 12,
+				taskID:        0,
+			},
+			wantErr: true,
+			Err:     model.ErrNoTaskID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPullRequestTaskService(testCase.fields.c)
+
+			gotResponse, err := newService.Delete(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.pullRequestID, testCase.args.taskID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
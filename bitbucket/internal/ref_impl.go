@@ -0,0 +1,423 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewRefService handles communication with the branch and tag related methods of the Bitbucket API.
+func NewRefService(client service.Connector) *RefService {
+
+	return &RefService{
+		internalClient: &internalRefServiceImpl{c: client},
+		c:              client,
+	}
+}
+
+// RefService handles communication with the branch and tag related methods of the Bitbucket API.
+type RefService struct {
+	internalClient bitbucket.RefConnector
+	c              service.Connector
+}
+
+// Gets returns a paginated list of the branches and the tags of the specified repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/refs
+func (r *RefService) Gets(ctx context.Context, workspace, repoSlug string, options *model.RefOptionsScheme) (*model.RefPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Gets(ctx, workspace, repoSlug, options)
+}
+
+// GetsAll iterates over all the branches and the tags of the specified repository matching the options.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/refs
+func (r *RefService) GetsAll(ctx context.Context, workspace, repoSlug string, options *model.RefOptionsScheme, opts ...paginate.Option) iter.Seq2[*model.RefScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.RefScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.RefScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	endpoint := refListEndpoint(fmt.Sprintf("2.0/repositories/%v/%v/refs", workspace, repoSlug), options)
+	return paginateLinks[*model.RefScheme](ctx, r.c, endpoint, opts)
+}
+
+// Branches returns a paginated list of the branches of the specified repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/refs/branches
+func (r *RefService) Branches(ctx context.Context, workspace, repoSlug string, options *model.RefOptionsScheme) (*model.BranchPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Branches(ctx, workspace, repoSlug, options)
+}
+
+// BranchesAll iterates over all the branches of the specified repository matching the options.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/refs/branches
+func (r *RefService) BranchesAll(ctx context.Context, workspace, repoSlug string, options *model.RefOptionsScheme, opts ...paginate.Option) iter.Seq2[*model.BranchScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.BranchScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.BranchScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	endpoint := refListEndpoint(fmt.Sprintf("2.0/repositories/%v/%v/refs/branches", workspace, repoSlug), options)
+	return paginateLinks[*model.BranchScheme](ctx, r.c, endpoint, opts)
+}
+
+// Branch returns the specified branch.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/refs/branches/{name}
+func (r *RefService) Branch(ctx context.Context, workspace, repoSlug, name string) (*model.BranchScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Branch(ctx, workspace, repoSlug, name)
+}
+
+// CreateBranch creates a new branch pointing to the target of the payload.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/refs/branches
+func (r *RefService) CreateBranch(ctx context.Context, workspace, repoSlug string, payload *model.RefPayloadScheme) (*model.BranchScheme, *model.ResponseScheme, error) {
+	return r.internalClient.CreateBranch(ctx, workspace, repoSlug, payload)
+}
+
+// DeleteBranch deletes the specified branch, the main branch can't be deleted.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/refs/branches/{name}
+func (r *RefService) DeleteBranch(ctx context.Context, workspace, repoSlug, name string) (*model.ResponseScheme, error) {
+	return r.internalClient.DeleteBranch(ctx, workspace, repoSlug, name)
+}
+
+// Tags returns a paginated list of the tags of the specified repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/refs/tags
+func (r *RefService) Tags(ctx context.Context, workspace, repoSlug string, options *model.RefOptionsScheme) (*model.TagPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Tags(ctx, workspace, repoSlug, options)
+}
+
+// TagsAll iterates over all the tags of the specified repository matching the options.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/refs/tags
+func (r *RefService) TagsAll(ctx context.Context, workspace, repoSlug string, options *model.RefOptionsScheme, opts ...paginate.Option) iter.Seq2[*model.TagScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.TagScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.TagScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	endpoint := refListEndpoint(fmt.Sprintf("2.0/repositories/%v/%v/refs/tags", workspace, repoSlug), options)
+	return paginateLinks[*model.TagScheme](ctx, r.c, endpoint, opts)
+}
+
+// Tag returns the specified tag.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/refs/tags/{name}
+func (r *RefService) Tag(ctx context.Context, workspace, repoSlug, name string) (*model.TagScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Tag(ctx, workspace, repoSlug, name)
+}
+
+// CreateTag creates a new tag pointing to the target of the payload, an annotated tag when the payload has a message.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/refs/tags
+func (r *RefService) CreateTag(ctx context.Context, workspace, repoSlug string, payload *model.RefPayloadScheme) (*model.TagScheme, *model.ResponseScheme, error) {
+	return r.internalClient.CreateTag(ctx, workspace, repoSlug, payload)
+}
+
+// DeleteTag deletes the specified tag.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/refs/tags/{name}
+func (r *RefService) DeleteTag(ctx context.Context, workspace, repoSlug, name string) (*model.ResponseScheme, error) {
+	return r.internalClient.DeleteTag(ctx, workspace, repoSlug, name)
+}
+
+type internalRefServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the branches and the tags of the specified repository.
+func (i *internalRefServiceImpl) Gets(ctx context.Context, workspace, repoSlug string, options *model.RefOptionsScheme) (*model.RefPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := refListEndpoint(fmt.Sprintf("2.0/repositories/%v/%v/refs", workspace, repoSlug), options)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.RefPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Branches returns a paginated list of the branches of the specified repository.
+func (i *internalRefServiceImpl) Branches(ctx context.Context, workspace, repoSlug string, options *model.RefOptionsScheme) (*model.BranchPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := refListEndpoint(fmt.Sprintf("2.0/repositories/%v/%v/refs/branches", workspace, repoSlug), options)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.BranchPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Branch returns the specified branch.
+func (i *internalRefServiceImpl) Branch(ctx context.Context, workspace, repoSlug, name string) (*model.BranchScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if name == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoBranchName)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/refs/branches/%v", workspace, repoSlug, url.PathEscape(name))
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	branch := new(model.BranchScheme)
+	response, err := i.c.Call(request, branch)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return branch, response, nil
+}
+
+// CreateBranch creates a new branch pointing to the target of the payload.
+func (i *internalRefServiceImpl) CreateBranch(ctx context.Context, workspace, repoSlug string, payload *model.RefPayloadScheme) (*model.BranchScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/refs/branches", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	branch := new(model.BranchScheme)
+	response, err := i.c.Call(request, branch)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return branch, response, nil
+}
+
+// DeleteBranch deletes the specified branch, the main branch can't be deleted.
+func (i *internalRefServiceImpl) DeleteBranch(ctx context.Context, workspace, repoSlug, name string) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoBranchName)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/refs/branches/%v", workspace, repoSlug, url.PathEscape(name))
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+// Tags returns a paginated list of the tags of the specified repository.
+func (i *internalRefServiceImpl) Tags(ctx context.Context, workspace, repoSlug string, options *model.RefOptionsScheme) (*model.TagPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := refListEndpoint(fmt.Sprintf("2.0/repositories/%v/%v/refs/tags", workspace, repoSlug), options)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.TagPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Tag returns the specified tag.
+func (i *internalRefServiceImpl) Tag(ctx context.Context, workspace, repoSlug, name string) (*model.TagScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if name == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoTagName)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/refs/tags/%v", workspace, repoSlug, url.PathEscape(name))
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tag := new(model.TagScheme)
+	response, err := i.c.Call(request, tag)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return tag, response, nil
+}
+
+// CreateTag creates a new tag pointing to the target of the payload, an annotated tag when the payload has a message.
+func (i *internalRefServiceImpl) CreateTag(ctx context.Context, workspace, repoSlug string, payload *model.RefPayloadScheme) (*model.TagScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/refs/tags", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tag := new(model.TagScheme)
+	response, err := i.c.Call(request, tag)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return tag, response, nil
+}
+
+// DeleteTag deletes the specified tag.
+func (i *internalRefServiceImpl) DeleteTag(ctx context.Context, workspace, repoSlug, name string) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoTagName)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/refs/tags/%v", workspace, repoSlug, url.PathEscape(name))
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+// refListEndpoint appends the query and sort filters to the endpoint listing references.
+func refListEndpoint(base string, options *model.RefOptionsScheme) string {
+
+	if options == nil {
+		return base
+	}
+
+	params := url.Values{}
+	if options.Query != "" {
+		params.Add("q", options.Query)
+	}
+
+	if options.Sort != "" {
+		params.Add("sort", options.Sort)
+	}
+
+	if len(params) == 0 {
+		return base
+	}
+
+	return fmt.Sprintf("%v?%v", base, params.Encode())
+}
@@ -0,0 +1,249 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewRepositoryDefaultReviewerService handles communication with the repository default reviewer related methods of the Bitbucket API.
+func NewRepositoryDefaultReviewerService(client service.Connector) *RepositoryDefaultReviewerService {
+
+	return &RepositoryDefaultReviewerService{
+		internalClient: &internalRepositoryDefaultReviewerServiceImpl{c: client},
+		c:              client,
+	}
+}
+
+// RepositoryDefaultReviewerService handles communication with the repository default reviewer related methods of the Bitbucket API.
+type RepositoryDefaultReviewerService struct {
+	internalClient bitbucket.RepositoryDefaultReviewerConnector
+	c              service.Connector
+}
+
+// Gets returns a paginated list of the default reviewers set on the specified repository.
+//
+// The default reviewers inherited from the project are not returned, use Effective to include them.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/default-reviewers
+func (r *RepositoryDefaultReviewerService) Gets(ctx context.Context, workspace, repoSlug string) (*model.BitbucketAccountPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Gets(ctx, workspace, repoSlug)
+}
+
+// GetsAll iterates over all the default reviewers set on the specified repository.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/default-reviewers
+func (r *RepositoryDefaultReviewerService) GetsAll(ctx context.Context, workspace, repoSlug string, opts ...paginate.Option) iter.Seq2[*model.BitbucketAccountScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.BitbucketAccountScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.BitbucketAccountScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/default-reviewers", workspace, repoSlug)
+	return paginateLinks[*model.BitbucketAccountScheme](ctx, r.c, endpoint, opts)
+}
+
+// Get returns the specified default reviewer of the repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/default-reviewers/{target_username}
+func (r *RepositoryDefaultReviewerService) Get(ctx context.Context, workspace, repoSlug, accountID string) (*model.BitbucketAccountScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Get(ctx, workspace, repoSlug, accountID)
+}
+
+// Add adds the specified user to the default reviewers of the repository.
+//
+// PUT /2.0/repositories/{workspace}/{repo_slug}/default-reviewers/{target_username}
+func (r *RepositoryDefaultReviewerService) Add(ctx context.Context, workspace, repoSlug, accountID string) (*model.BitbucketAccountScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Add(ctx, workspace, repoSlug, accountID)
+}
+
+// Remove removes the specified user from the default reviewers of the repository.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/default-reviewers/{target_username}
+func (r *RepositoryDefaultReviewerService) Remove(ctx context.Context, workspace, repoSlug, accountID string) (*model.ResponseScheme, error) {
+	return r.internalClient.Remove(ctx, workspace, repoSlug, accountID)
+}
+
+// Effective returns a paginated list of the effective default reviewers of the specified repository,
+// the default reviewers of the repository and the ones inherited from its project.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/effective-default-reviewers
+func (r *RepositoryDefaultReviewerService) Effective(ctx context.Context, workspace, repoSlug string) (*model.DefaultReviewerPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Effective(ctx, workspace, repoSlug)
+}
+
+// EffectiveAll iterates over all the effective default reviewers of the specified repository.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/effective-default-reviewers
+func (r *RepositoryDefaultReviewerService) EffectiveAll(ctx context.Context, workspace, repoSlug string, opts ...paginate.Option) iter.Seq2[*model.DefaultReviewerScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.DefaultReviewerScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.DefaultReviewerScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/effective-default-reviewers", workspace, repoSlug)
+	return paginateLinks[*model.DefaultReviewerScheme](ctx, r.c, endpoint, opts)
+}
+
+type internalRepositoryDefaultReviewerServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the default reviewers set on the specified repository.
+func (i *internalRepositoryDefaultReviewerServiceImpl) Gets(ctx context.Context, workspace, repoSlug string) (*model.BitbucketAccountPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/default-reviewers", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.BitbucketAccountPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Get returns the specified default reviewer of the repository.
+func (i *internalRepositoryDefaultReviewerServiceImpl) Get(ctx context.Context, workspace, repoSlug, accountID string) (*model.BitbucketAccountScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if accountID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoAccountID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/default-reviewers/%v", workspace, repoSlug, accountID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reviewer := new(model.BitbucketAccountScheme)
+	response, err := i.c.Call(request, reviewer)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return reviewer, response, nil
+}
+
+// Add adds the specified user to the default reviewers of the repository.
+func (i *internalRepositoryDefaultReviewerServiceImpl) Add(ctx context.Context, workspace, repoSlug, accountID string) (*model.BitbucketAccountScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if accountID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoAccountID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/default-reviewers/%v", workspace, repoSlug, accountID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reviewer := new(model.BitbucketAccountScheme)
+	response, err := i.c.Call(request, reviewer)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return reviewer, response, nil
+}
+
+// Remove removes the specified user from the default reviewers of the repository.
+func (i *internalRepositoryDefaultReviewerServiceImpl) Remove(ctx context.Context, workspace, repoSlug, accountID string) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if accountID == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoAccountID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/default-reviewers/%v", workspace, repoSlug, accountID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+// Effective returns a paginated list of the effective default reviewers of the specified repository.
+func (i *internalRepositoryDefaultReviewerServiceImpl) Effective(ctx context.Context, workspace, repoSlug string) (*model.DefaultReviewerPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/effective-default-reviewers", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.DefaultReviewerPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
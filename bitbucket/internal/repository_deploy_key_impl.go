@@ -0,0 +1,227 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewRepositoryDeployKeyService handles communication with the repository deploy key related methods of the Bitbucket API.
+func NewRepositoryDeployKeyService(client service.Connector) *RepositoryDeployKeyService {
+
+	return &RepositoryDeployKeyService{
+		internalClient: &internalRepositoryDeployKeyServiceImpl{c: client},
+		c:              client,
+	}
+}
+
+// RepositoryDeployKeyService handles communication with the repository deploy key related methods of the Bitbucket API.
+type RepositoryDeployKeyService struct {
+	internalClient bitbucket.RepositoryDeployKeyConnector
+	c              service.Connector
+}
+
+// Gets returns a paginated list of the deploy keys of the specified repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/deploy-keys
+func (r *RepositoryDeployKeyService) Gets(ctx context.Context, workspace, repoSlug string) (*model.DeployKeyPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Gets(ctx, workspace, repoSlug)
+}
+
+// GetsAll iterates over all the deploy keys of the specified repository.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/deploy-keys
+func (r *RepositoryDeployKeyService) GetsAll(ctx context.Context, workspace, repoSlug string, opts ...paginate.Option) iter.Seq2[*model.DeployKeyScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.DeployKeyScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.DeployKeyScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/deploy-keys", workspace, repoSlug)
+	return paginateLinks[*model.DeployKeyScheme](ctx, r.c, endpoint, opts)
+}
+
+// Get returns the specified deploy key.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/deploy-keys/{key_id}
+func (r *RepositoryDeployKeyService) Get(ctx context.Context, workspace, repoSlug string, keyID int) (*model.DeployKeyScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Get(ctx, workspace, repoSlug, keyID)
+}
+
+// Create adds a new deploy key to the specified repository.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/deploy-keys
+func (r *RepositoryDeployKeyService) Create(ctx context.Context, workspace, repoSlug string, payload *model.KeyPayloadScheme) (*model.DeployKeyScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Create(ctx, workspace, repoSlug, payload)
+}
+
+// Update updates the label or the value of the specified deploy key.
+//
+// PUT /2.0/repositories/{workspace}/{repo_slug}/deploy-keys/{key_id}
+func (r *RepositoryDeployKeyService) Update(ctx context.Context, workspace, repoSlug string, keyID int, payload *model.KeyPayloadScheme) (*model.DeployKeyScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Update(ctx, workspace, repoSlug, keyID, payload)
+}
+
+// Delete removes the specified deploy key from the repository.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/deploy-keys/{key_id}
+func (r *RepositoryDeployKeyService) Delete(ctx context.Context, workspace, repoSlug string, keyID int) (*model.ResponseScheme, error) {
+	return r.internalClient.Delete(ctx, workspace, repoSlug, keyID)
+}
+
+type internalRepositoryDeployKeyServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the deploy keys of the specified repository.
+func (i *internalRepositoryDeployKeyServiceImpl) Gets(ctx context.Context, workspace, repoSlug string) (*model.DeployKeyPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/deploy-keys", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.DeployKeyPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Get returns the specified deploy key.
+func (i *internalRepositoryDeployKeyServiceImpl) Get(ctx context.Context, workspace, repoSlug string, keyID int) (*model.DeployKeyScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if keyID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoDeployKeyID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/deploy-keys/%v", workspace, repoSlug, keyID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key := new(model.DeployKeyScheme)
+	response, err := i.c.Call(request, key)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return key, response, nil
+}
+
+// Create adds a new deploy key to the specified repository.
+func (i *internalRepositoryDeployKeyServiceImpl) Create(ctx context.Context, workspace, repoSlug string, payload *model.KeyPayloadScheme) (*model.DeployKeyScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/deploy-keys", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key := new(model.DeployKeyScheme)
+	response, err := i.c.Call(request, key)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return key, response, nil
+}
+
+// Update updates the label or the value of the specified deploy key.
+func (i *internalRepositoryDeployKeyServiceImpl) Update(ctx context.Context, workspace, repoSlug string, keyID int, payload *model.KeyPayloadScheme) (*model.DeployKeyScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if keyID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoDeployKeyID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/deploy-keys/%v", workspace, repoSlug, keyID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key := new(model.DeployKeyScheme)
+	response, err := i.c.Call(request, key)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return key, response, nil
+}
+
+// Delete removes the specified deploy key from the repository.
+func (i *internalRepositoryDeployKeyServiceImpl) Delete(ctx context.Context, workspace, repoSlug string, keyID int) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if keyID == 0 {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoDeployKeyID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/deploy-keys/%v", workspace, repoSlug, keyID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
@@ -0,0 +1,181 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"net/url"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewRepositoryDownloadService handles communication with the repository download related methods of the Bitbucket API.
+func NewRepositoryDownloadService(client service.Connector) *RepositoryDownloadService {
+
+	return &RepositoryDownloadService{
+		internalClient: &internalRepositoryDownloadServiceImpl{c: client},
+		c:              client,
+	}
+}
+
+// RepositoryDownloadService handles communication with the repository download related methods of the Bitbucket API.
+type RepositoryDownloadService struct {
+	internalClient bitbucket.RepositoryDownloadConnector
+	c              service.Connector
+}
+
+// Gets returns a paginated list of the files uploaded to the downloads of the specified repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/downloads
+func (d *RepositoryDownloadService) Gets(ctx context.Context, workspace, repoSlug string) (*model.RepositoryDownloadPageScheme, *model.ResponseScheme, error) {
+	return d.internalClient.Gets(ctx, workspace, repoSlug)
+}
+
+// GetsAll iterates over all the files uploaded to the downloads of the specified repository.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/downloads
+func (d *RepositoryDownloadService) GetsAll(ctx context.Context, workspace, repoSlug string, opts ...paginate.Option) iter.Seq2[*model.RepositoryDownloadScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.RepositoryDownloadScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.RepositoryDownloadScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/downloads", workspace, repoSlug)
+	return paginateLinks[*model.RepositoryDownloadScheme](ctx, d.c, endpoint, opts)
+}
+
+// Upload uploads a file to the downloads of the specified repository, replacing the file with the same name.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/downloads
+func (d *RepositoryDownloadService) Upload(ctx context.Context, workspace, repoSlug, fileName string, file io.Reader) (*model.ResponseScheme, error) {
+	return d.internalClient.Upload(ctx, workspace, repoSlug, fileName, file)
+}
+
+// Download returns the contents of the specified file as a reader, the caller must close it.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/downloads/{filename}
+func (d *RepositoryDownloadService) Download(ctx context.Context, workspace, repoSlug, fileName string) (io.ReadCloser, error) {
+	return d.internalClient.Download(ctx, workspace, repoSlug, fileName)
+}
+
+// Delete deletes the specified file from the downloads of the repository.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/downloads/{filename}
+func (d *RepositoryDownloadService) Delete(ctx context.Context, workspace, repoSlug, fileName string) (*model.ResponseScheme, error) {
+	return d.internalClient.Delete(ctx, workspace, repoSlug, fileName)
+}
+
+type internalRepositoryDownloadServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the files uploaded to the downloads of the specified repository.
+func (i *internalRepositoryDownloadServiceImpl) Gets(ctx context.Context, workspace, repoSlug string) (*model.RepositoryDownloadPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/downloads", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.RepositoryDownloadPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Upload uploads a file to the downloads of the specified repository, replacing the file with the same name.
+func (i *internalRepositoryDownloadServiceImpl) Upload(ctx context.Context, workspace, repoSlug, fileName string, file io.Reader) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if fileName == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoFileName)
+	}
+
+	if file == nil {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoFileReader)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/downloads", workspace, repoSlug)
+
+	request, err := newMultipartRequest(ctx, i.c, http.MethodPost, endpoint, nil, "files", []formFile{{name: fileName, content: file}})
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+// Download returns the contents of the specified file as a reader, the caller must close it.
+func (i *internalRepositoryDownloadServiceImpl) Download(ctx context.Context, workspace, repoSlug, fileName string) (io.ReadCloser, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if fileName == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoFileName)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/downloads/%v", workspace, repoSlug, url.PathEscape(fileName))
+	return download(ctx, i.c, endpoint)
+}
+
+// Delete deletes the specified file from the downloads of the repository.
+func (i *internalRepositoryDownloadServiceImpl) Delete(ctx context.Context, workspace, repoSlug, fileName string) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if fileName == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoFileName)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/downloads/%v", workspace, repoSlug, url.PathEscape(fileName))
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
@@ -0,0 +1,356 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func Test_internalRepositoryDownloadServiceImpl_Gets(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		repoSlug  string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/downloads",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.RepositoryDownloadPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/downloads",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "",
+				repoSlug:  "repository-sample",
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewRepositoryDownloadService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Gets(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalRepositoryDownloadServiceImpl_Delete(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		repoSlug  string
+		fileName  string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				fileName:  "go-atlassian_2.4.0_linux_amd64.tar.gz",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"2.0/repositories/work-space-name-sample/repository-sample/downloads/go-atlassian_2.4.0_linux_amd64.tar.gz",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				fileName:  "go-atlassian_2.4.0_linux_amd64.tar.gz",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"2.0/repositories/work-space-name-sample/repository-sample/downloads/go-atlassian_2.4.0_linux_amd64.tar.gz",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "",
+				repoSlug:  "repository-sample",
+				fileName:  "go-atlassian_2.4.0_linux_amd64.tar.gz",
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository slug is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "",
+				fileName:  "go-atlassian_2.4.0_linux_amd64.tar.gz",
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the file name is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				fileName:  "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoFileName,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewRepositoryDownloadService(testCase.fields.c)
+
+			gotResponse, err := newService.Delete(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.fileName)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalRepositoryDownloadServiceImpl_Upload(t *testing.T) {
+
+	client := mocks.NewConnector(t)
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodPost,
+		"2.0/repositories/work-space-name-sample/repository-sample/downloads",
+		mock.MatchedBy(func(typ string) bool { return strings.HasPrefix(typ, "multipart/form-data; boundary=") }),
+		mock.AnythingOfType("*bytes.Buffer")).
+		Run(func(args mock.Arguments) {
+
+			_, params, err := mime.ParseMediaType(args.String(3))
+			assert.NoError(t, err)
+
+			form, err := multipart.NewReader(args.Get(4).(*bytes.Buffer), params["boundary"]).ReadForm(1 << 20)
+			assert.NoError(t, err)
+
+			files := form.File["files"]
+			if assert.Len(t, files, 1) {
+				assert.Equal(t, "checksums.txt", files[0].Filename)
+
+				file, err := files[0].Open()
+				assert.NoError(t, err)
+				contents, _ := io.ReadAll(file)
+				assert.Equal(t, "e3b0c442  go-atlassian.tar.gz", string(contents))
+			}
+		}).
+		Return(&http.Request{}, nil)
+
+	client.On("Call", &http.Request{}, nil).
+		Return(&model.ResponseScheme{}, nil)
+
+	newService := NewRepositoryDownloadService(client)
+
+	response, err := newService.Upload(context.Background(), "work-space-name-sample", "repository-sample", "checksums.txt", strings.NewReader("e3b0c442  go-atlassian.tar.gz"))
+	assert.NoError(t, err)
+	assert.NotNil(t, response)
+
+	_, err = newService.Upload(context.Background(), "work-space-name-sample", "repository-sample", "", strings.NewReader(""))
+	assert.True(t, errors.Is(err, model.ErrNoFileName))
+}
+
+func Test_internalRepositoryDownloadServiceImpl_Download(t *testing.T) {
+
+	client := mocks.NewConnector(t)
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodGet,
+		"2.0/repositories/work-space-name-sample/repository-sample/downloads/checksums.txt",
+		"", nil).
+		Return(&http.Request{}, nil)
+
+	client.On("Do", &http.Request{}).
+		Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader("e3b0c442  go-atlassian.tar.gz")),
+		}, nil)
+
+	newService := NewRepositoryDownloadService(client)
+
+	reader, err := newService.Download(context.Background(), "work-space-name-sample", "repository-sample", "checksums.txt")
+	assert.NoError(t, err)
+	defer reader.Close()
+
+	contents, err := io.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, "e3b0c442  go-atlassian.tar.gz", string(contents))
+
+	_, err = newService.Download(context.Background(), "", "repository-sample", "checksums.txt")
+	assert.True(t, errors.Is(err, model.ErrNoWorkspace))
+}
@@ -0,0 +1,123 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewRepositoryForkService handles communication with the repository fork related methods of the Bitbucket API.
+func NewRepositoryForkService(client service.Connector) *RepositoryForkService {
+
+	return &RepositoryForkService{
+		internalClient: &internalRepositoryForkServiceImpl{c: client},
+		c:              client,
+	}
+}
+
+// RepositoryForkService handles communication with the repository fork related methods of the Bitbucket API.
+type RepositoryForkService struct {
+	internalClient bitbucket.RepositoryForkConnector
+	c              service.Connector
+}
+
+// Gets returns a paginated list of all the forks of the specified repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/forks
+func (r *RepositoryForkService) Gets(ctx context.Context, workspace, repoSlug string, options *model.RepositoryOptionsScheme) (*model.RepositoryPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Gets(ctx, workspace, repoSlug, options)
+}
+
+// GetsAll iterates over all the forks of the specified repository.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/forks
+func (r *RepositoryForkService) GetsAll(ctx context.Context, workspace, repoSlug string, options *model.RepositoryOptionsScheme, opts ...paginate.Option) iter.Seq2[*model.RepositoryScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.RepositoryScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.RepositoryScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	endpoint := repositoryListEndpoint(fmt.Sprintf("2.0/repositories/%v/%v/forks", workspace, repoSlug), options)
+	return paginateLinks[*model.RepositoryScheme](ctx, r.c, endpoint, opts)
+}
+
+// Execute creates a new fork of the specified repository.
+//
+// By default, the fork is created in the same workspace as the parent, with the same name.
+//
+// Use the workspace of the payload to fork the repository into another workspace.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/forks
+func (r *RepositoryForkService) Execute(ctx context.Context, workspace, repoSlug string, payload *model.RepositoryForkPayloadScheme) (*model.RepositoryScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Execute(ctx, workspace, repoSlug, payload)
+}
+
+type internalRepositoryForkServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of all the forks of the specified repository.
+func (i *internalRepositoryForkServiceImpl) Gets(ctx context.Context, workspace, repoSlug string, options *model.RepositoryOptionsScheme) (*model.RepositoryPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := repositoryListEndpoint(fmt.Sprintf("2.0/repositories/%v/%v/forks", workspace, repoSlug), options)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.RepositoryPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Execute creates a new fork of the specified repository.
+func (i *internalRepositoryForkServiceImpl) Execute(ctx context.Context, workspace, repoSlug string, payload *model.RepositoryForkPayloadScheme) (*model.RepositoryScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/forks", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fork := new(model.RepositoryScheme)
+	response, err := i.c.Call(request, fork)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return fork, response, nil
+}
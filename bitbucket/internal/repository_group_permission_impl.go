@@ -0,0 +1,193 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewRepositoryGroupPermissionService handles communication with the repository group permission related methods of the Bitbucket API.
+func NewRepositoryGroupPermissionService(client service.Connector) *RepositoryGroupPermissionService {
+
+	return &RepositoryGroupPermissionService{
+		internalClient: &internalRepositoryGroupPermissionServiceImpl{c: client},
+		c:              client,
+	}
+}
+
+// RepositoryGroupPermissionService handles communication with the repository group permission related methods of the Bitbucket API.
+type RepositoryGroupPermissionService struct {
+	internalClient bitbucket.RepositoryGroupPermissionConnector
+	c              service.Connector
+}
+
+// Gets returns a paginated list of the explicit group permissions of the specified repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/permissions-config/groups
+func (r *RepositoryGroupPermissionService) Gets(ctx context.Context, workspace, repoSlug string) (*model.RepositoryGroupPermissionPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Gets(ctx, workspace, repoSlug)
+}
+
+// GetsAll iterates over all the explicit group permissions of the specified repository.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/permissions-config/groups
+func (r *RepositoryGroupPermissionService) GetsAll(ctx context.Context, workspace, repoSlug string, opts ...paginate.Option) iter.Seq2[*model.RepositoryGroupPermissionScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.RepositoryGroupPermissionScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.RepositoryGroupPermissionScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/permissions-config/groups", workspace, repoSlug)
+	return paginateLinks[*model.RepositoryGroupPermissionScheme](ctx, r.c, endpoint, opts)
+}
+
+// Get returns the explicit permission of the specified group on the repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/permissions-config/groups/{group_slug}
+func (r *RepositoryGroupPermissionService) Get(ctx context.Context, workspace, repoSlug, groupSlug string) (*model.RepositoryGroupPermissionScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Get(ctx, workspace, repoSlug, groupSlug)
+}
+
+// Update grants, or changes, the explicit permission of the specified group on the repository.
+//
+// PUT /2.0/repositories/{workspace}/{repo_slug}/permissions-config/groups/{group_slug}
+func (r *RepositoryGroupPermissionService) Update(ctx context.Context, workspace, repoSlug, groupSlug string, payload *model.RepositoryPermissionPayloadScheme) (*model.RepositoryGroupPermissionScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Update(ctx, workspace, repoSlug, groupSlug, payload)
+}
+
+// Delete revokes the explicit permission of the specified group on the repository.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/permissions-config/groups/{group_slug}
+func (r *RepositoryGroupPermissionService) Delete(ctx context.Context, workspace, repoSlug, groupSlug string) (*model.ResponseScheme, error) {
+	return r.internalClient.Delete(ctx, workspace, repoSlug, groupSlug)
+}
+
+type internalRepositoryGroupPermissionServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the explicit group permissions of the specified repository.
+func (i *internalRepositoryGroupPermissionServiceImpl) Gets(ctx context.Context, workspace, repoSlug string) (*model.RepositoryGroupPermissionPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/permissions-config/groups", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.RepositoryGroupPermissionPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Get returns the explicit permission of the specified group on the repository.
+func (i *internalRepositoryGroupPermissionServiceImpl) Get(ctx context.Context, workspace, repoSlug, groupSlug string) (*model.RepositoryGroupPermissionScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if groupSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoGroupSlug)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/permissions-config/groups/%v", workspace, repoSlug, groupSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	permission := new(model.RepositoryGroupPermissionScheme)
+	response, err := i.c.Call(request, permission)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return permission, response, nil
+}
+
+// Update grants, or changes, the explicit permission of the specified group on the repository.
+func (i *internalRepositoryGroupPermissionServiceImpl) Update(ctx context.Context, workspace, repoSlug, groupSlug string, payload *model.RepositoryPermissionPayloadScheme) (*model.RepositoryGroupPermissionScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if groupSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoGroupSlug)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/permissions-config/groups/%v", workspace, repoSlug, groupSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	permission := new(model.RepositoryGroupPermissionScheme)
+	response, err := i.c.Call(request, permission)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return permission, response, nil
+}
+
+// Delete revokes the explicit permission of the specified group on the repository.
+func (i *internalRepositoryGroupPermissionServiceImpl) Delete(ctx context.Context, workspace, repoSlug, groupSlug string) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if groupSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoGroupSlug)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/permissions-config/groups/%v", workspace, repoSlug, groupSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
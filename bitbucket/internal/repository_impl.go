@@ -0,0 +1,389 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// RepositoryServices groups the services related to the repositories of the Bitbucket API.
+type RepositoryServices struct {
+	// Fork is the service for managing repository forks.
+	Fork *RepositoryForkService
+	// Setting is the service for managing the settings the repository overrides.
+	Setting *RepositorySettingService
+	// PullRequest is the service for managing pull requests.
+	PullRequest *PullRequestService
+	// Pipeline is the service for managing pipelines.
+	Pipeline *PipelineService
+	// Ref is the service for managing branches and tags.
+	Ref *RefService
+	// Commit is the service for managing commits, their comments, build statuses and Code Insights reports.
+	Commit *CommitService
+	// Source is the service for browsing the source of the repository.
+	Source *SourceService
+	// GroupPermission is the service for managing the explicit group permissions.
+	GroupPermission *RepositoryGroupPermissionService
+	// UserPermission is the service for managing the explicit user permissions.
+	UserPermission *RepositoryUserPermissionService
+	// DeployKey is the service for managing deploy keys.
+	DeployKey *RepositoryDeployKeyService
+	// BranchRestriction is the service for managing branch restrictions.
+	BranchRestriction *BranchRestrictionService
+	// BranchingModel is the service for managing the branching model.
+	BranchingModel *BranchingModelService
+	// Webhook is the service for managing the repository webhooks.
+	Webhook *RepositoryWebhookService
+	// Issue is the service for managing the issue tracker.
+	Issue *IssueService
+	// Download is the service for managing the files uploaded to the downloads.
+	Download *RepositoryDownloadService
+	// Deployment is the service for browsing the deployments.
+	Deployment *DeploymentService
+	// Environment is the service for managing the deployment environments.
+	Environment *EnvironmentService
+	// DefaultReviewer is the service for managing the default reviewers.
+	DefaultReviewer *RepositoryDefaultReviewerService
+}
+
+// NewRepositoryService handles communication with the repository related methods of the Bitbucket API.
+// The sub-services are optional, the services struct can be nil.
+func NewRepositoryService(client service.Connector, services *RepositoryServices) *RepositoryService {
+
+	repositoryService := &RepositoryService{
+		internalClient: &internalRepositoryServiceImpl{c: client},
+		c:              client,
+	}
+
+	if services != nil {
+
+		repositoryService.Fork = services.Fork
+		repositoryService.Setting = services.Setting
+		repositoryService.PullRequest = services.PullRequest
+		repositoryService.Pipeline = services.Pipeline
+		repositoryService.Ref = services.Ref
+		repositoryService.Commit = services.Commit
+		repositoryService.Source = services.Source
+		repositoryService.GroupPermission = services.GroupPermission
+		repositoryService.UserPermission = services.UserPermission
+		repositoryService.DeployKey = services.DeployKey
+		repositoryService.BranchRestriction = services.BranchRestriction
+		repositoryService.BranchingModel = services.BranchingModel
+		repositoryService.Webhook = services.Webhook
+		repositoryService.Issue = services.Issue
+		repositoryService.Download = services.Download
+		repositoryService.Deployment = services.Deployment
+		repositoryService.Environment = services.Environment
+		repositoryService.DefaultReviewer = services.DefaultReviewer
+	}
+
+	return repositoryService
+}
+
+// RepositoryService handles communication with the repository related methods of the Bitbucket API.
+type RepositoryService struct {
+	internalClient    bitbucket.RepositoryConnector
+	c                 service.Connector
+	Fork              *RepositoryForkService
+	Setting           *RepositorySettingService
+	PullRequest       *PullRequestService
+	Pipeline          *PipelineService
+	Ref               *RefService
+	Commit            *CommitService
+	Source            *SourceService
+	GroupPermission   *RepositoryGroupPermissionService
+	UserPermission    *RepositoryUserPermissionService
+	DeployKey         *RepositoryDeployKeyService
+	BranchRestriction *BranchRestrictionService
+	BranchingModel    *BranchingModelService
+	Webhook           *RepositoryWebhookService
+	Issue             *IssueService
+	Download          *RepositoryDownloadService
+	Deployment        *DeploymentService
+	Environment       *EnvironmentService
+	DefaultReviewer   *RepositoryDefaultReviewerService
+}
+
+// Gets returns a paginated list of all repositories owned by the specified workspace.
+//
+// The result can be narrowed down based on the authenticated user's role, filtered and sorted.
+//
+// GET /2.0/repositories/{workspace}
+func (r *RepositoryService) Gets(ctx context.Context, workspace string, options *model.RepositoryOptionsScheme) (*model.RepositoryPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Gets(ctx, workspace, options)
+}
+
+// GetsAll iterates over all the repositories owned by the specified workspace.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}
+func (r *RepositoryService) GetsAll(ctx context.Context, workspace string, options *model.RepositoryOptionsScheme, opts ...paginate.Option) iter.Seq2[*model.RepositoryScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.RepositoryScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	endpoint := repositoryListEndpoint(fmt.Sprintf("2.0/repositories/%v", workspace), options)
+	return paginateLinks[*model.RepositoryScheme](ctx, r.c, endpoint, opts)
+}
+
+// Get returns the object describing this repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}
+func (r *RepositoryService) Get(ctx context.Context, workspace, repoSlug string) (*model.RepositoryScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Get(ctx, workspace, repoSlug)
+}
+
+// Update updates a Bitbucket repository
+//
+// PUT /2.0/repositories/{workspace}/{repo_slug}
+//
+// Note: Changing the name of the repository will cause the location to be changed.
+//
+// This is because the URL of the repo is derived from the name (a process called slugification).
+//
+// In such a scenario, it is possible for the request to fail if the newly created slug conflicts with an existing repository's slug.
+func (r *RepositoryService) Update(ctx context.Context, workspace, repoSlug string, payload *model.RepositoryScheme) (*model.RepositoryScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Update(ctx, workspace, repoSlug, payload)
+}
+
+// Delete deletes the repository. This is an irreversible operation and this does not affect its forks.
+//
+// The redirectTo parameter, when provided, is the URL the repository is moved to, the old URL redirects to it.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}
+func (r *RepositoryService) Delete(ctx context.Context, workspace, repoSlug, redirectTo string) (*model.ResponseScheme, error) {
+	return r.internalClient.Delete(ctx, workspace, repoSlug, redirectTo)
+}
+
+// Create creates a new repository.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}
+func (r *RepositoryService) Create(ctx context.Context, workspace, repoSlug string, payload *model.RepositoryScheme) (*model.RepositoryScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Create(ctx, workspace, repoSlug, payload)
+}
+
+// Watchers returns a paginated list of all the watchers on the specified repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/watchers
+func (r *RepositoryService) Watchers(ctx context.Context, workspace, repoSlug string) (*model.BitbucketAccountPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Watchers(ctx, workspace, repoSlug)
+}
+
+// WatchersAll iterates over all the watchers on the specified repository.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/watchers
+func (r *RepositoryService) WatchersAll(ctx context.Context, workspace, repoSlug string, opts ...paginate.Option) iter.Seq2[*model.BitbucketAccountScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.BitbucketAccountScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.BitbucketAccountScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/watchers", workspace, repoSlug)
+	return paginateLinks[*model.BitbucketAccountScheme](ctx, r.c, endpoint, opts)
+}
+
+type internalRepositoryServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of all repositories owned by the specified workspace.
+func (i *internalRepositoryServiceImpl) Gets(ctx context.Context, workspace string, options *model.RepositoryOptionsScheme) (*model.RepositoryPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	endpoint := repositoryListEndpoint(fmt.Sprintf("2.0/repositories/%v", workspace), options)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.RepositoryPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Get returns the object describing this repository.
+func (i *internalRepositoryServiceImpl) Get(ctx context.Context, workspace, repoSlug string) (*model.RepositoryScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	repository := new(model.RepositoryScheme)
+	response, err := i.c.Call(request, repository)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return repository, response, nil
+}
+
+// Update updates a Bitbucket repository
+func (i *internalRepositoryServiceImpl) Update(ctx context.Context, workspace, repoSlug string, payload *model.RepositoryScheme) (*model.RepositoryScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	repository := new(model.RepositoryScheme)
+	response, err := i.c.Call(request, repository)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return repository, response, nil
+}
+
+// Delete deletes the repository.
+func (i *internalRepositoryServiceImpl) Delete(ctx context.Context, workspace, repoSlug, redirectTo string) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	var endpoint strings.Builder
+	fmt.Fprintf(&endpoint, "2.0/repositories/%v/%v", workspace, repoSlug)
+
+	if redirectTo != "" {
+		params := url.Values{}
+		params.Add("redirect_to", redirectTo)
+		fmt.Fprintf(&endpoint, "?%v", params.Encode())
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint.String(), "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+// Create creates a new repository.
+func (i *internalRepositoryServiceImpl) Create(ctx context.Context, workspace, repoSlug string, payload *model.RepositoryScheme) (*model.RepositoryScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	repository := new(model.RepositoryScheme)
+	response, err := i.c.Call(request, repository)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return repository, response, nil
+}
+
+// Watchers returns a paginated list of all the watchers on the specified repository.
+func (i *internalRepositoryServiceImpl) Watchers(ctx context.Context, workspace, repoSlug string) (*model.BitbucketAccountPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/watchers", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.BitbucketAccountPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// repositoryListEndpoint appends the role, query and sort filters to the endpoint listing repositories.
+func repositoryListEndpoint(base string, options *model.RepositoryOptionsScheme) string {
+
+	if options == nil {
+		return base
+	}
+
+	params := url.Values{}
+	if options.Role != "" {
+		params.Add("role", options.Role)
+	}
+
+	if options.Query != "" {
+		params.Add("q", options.Query)
+	}
+
+	if options.Sort != "" {
+		params.Add("sort", options.Sort)
+	}
+
+	if len(params) == 0 {
+		return base
+	}
+
+	return fmt.Sprintf("%v?%v", base, params.Encode())
+}
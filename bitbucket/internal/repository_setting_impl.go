@@ -0,0 +1,90 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewRepositorySettingService handles communication with the repository setting related methods of the Bitbucket API.
+func NewRepositorySettingService(client service.Connector) *RepositorySettingService {
+
+	return &RepositorySettingService{
+		internalClient: &internalRepositorySettingServiceImpl{c: client},
+	}
+}
+
+// RepositorySettingService handles communication with the repository setting related methods of the Bitbucket API.
+type RepositorySettingService struct {
+	internalClient bitbucket.RepositorySettingConnector
+}
+
+// Gets returns the settings the repository overrides instead of inheriting them from its project.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/override-settings
+func (r *RepositorySettingService) Gets(ctx context.Context, workspace, repoSlug string) (*model.RepositoryInheritanceStateScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Gets(ctx, workspace, repoSlug)
+}
+
+// Set sets the settings the repository overrides instead of inheriting them from its project.
+//
+// PUT /2.0/repositories/{workspace}/{repo_slug}/override-settings
+func (r *RepositorySettingService) Set(ctx context.Context, workspace, repoSlug string, payload *model.RepositoryInheritanceStateScheme) (*model.ResponseScheme, error) {
+	return r.internalClient.Set(ctx, workspace, repoSlug, payload)
+}
+
+type internalRepositorySettingServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns the settings the repository overrides instead of inheriting them from its project.
+func (i *internalRepositorySettingServiceImpl) Gets(ctx context.Context, workspace, repoSlug string) (*model.RepositoryInheritanceStateScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/override-settings", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	state := new(model.RepositoryInheritanceStateScheme)
+	response, err := i.c.Call(request, state)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return state, response, nil
+}
+
+// Set sets the settings the repository overrides instead of inheriting them from its project.
+func (i *internalRepositorySettingServiceImpl) Set(ctx context.Context, workspace, repoSlug string, payload *model.RepositoryInheritanceStateScheme) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/override-settings", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
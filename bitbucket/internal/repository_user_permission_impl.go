@@ -0,0 +1,232 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewRepositoryUserPermissionService handles communication with the repository user permission related methods of the Bitbucket API.
+func NewRepositoryUserPermissionService(client service.Connector) *RepositoryUserPermissionService {
+
+	return &RepositoryUserPermissionService{
+		internalClient: &internalRepositoryUserPermissionServiceImpl{c: client},
+		c:              client,
+	}
+}
+
+// RepositoryUserPermissionService handles communication with the repository user permission related methods of the Bitbucket API.
+type RepositoryUserPermissionService struct {
+	internalClient bitbucket.RepositoryUserPermissionConnector
+	c              service.Connector
+}
+
+// Gets returns a paginated list of the explicit user permissions of the specified repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/permissions-config/users
+func (r *RepositoryUserPermissionService) Gets(ctx context.Context, workspace, repoSlug string) (*model.RepositoryUserPermissionPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Gets(ctx, workspace, repoSlug)
+}
+
+// GetsAll iterates over all the explicit user permissions of the specified repository.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/permissions-config/users
+func (r *RepositoryUserPermissionService) GetsAll(ctx context.Context, workspace, repoSlug string, opts ...paginate.Option) iter.Seq2[*model.RepositoryUserPermissionScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.RepositoryUserPermissionScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.RepositoryUserPermissionScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/permissions-config/users", workspace, repoSlug)
+	return paginateLinks[*model.RepositoryUserPermissionScheme](ctx, r.c, endpoint, opts)
+}
+
+// Get returns the explicit permission of the specified user on the repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/permissions-config/users/{selected_user_id}
+func (r *RepositoryUserPermissionService) Get(ctx context.Context, workspace, repoSlug, accountID string) (*model.RepositoryUserPermissionScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Get(ctx, workspace, repoSlug, accountID)
+}
+
+// Update grants, or changes, the explicit permission of the specified user on the repository.
+//
+// PUT /2.0/repositories/{workspace}/{repo_slug}/permissions-config/users/{selected_user_id}
+func (r *RepositoryUserPermissionService) Update(ctx context.Context, workspace, repoSlug, accountID string, payload *model.RepositoryPermissionPayloadScheme) (*model.RepositoryUserPermissionScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Update(ctx, workspace, repoSlug, accountID, payload)
+}
+
+// Delete revokes the explicit permission of the specified user on the repository.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/permissions-config/users/{selected_user_id}
+func (r *RepositoryUserPermissionService) Delete(ctx context.Context, workspace, repoSlug, accountID string) (*model.ResponseScheme, error) {
+	return r.internalClient.Delete(ctx, workspace, repoSlug, accountID)
+}
+
+// Check returns the effective permission of the authenticated user on the specified repository,
+// the highest permission granted to the user explicitly, through a group or through the workspace.
+//
+// GET /2.0/user/permissions/repositories?q=repository.full_name="{workspace}/{repo_slug}"
+func (r *RepositoryUserPermissionService) Check(ctx context.Context, workspace, repoSlug string) (*model.RepositoryPermissionPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Check(ctx, workspace, repoSlug)
+}
+
+type internalRepositoryUserPermissionServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the explicit user permissions of the specified repository.
+func (i *internalRepositoryUserPermissionServiceImpl) Gets(ctx context.Context, workspace, repoSlug string) (*model.RepositoryUserPermissionPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/permissions-config/users", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.RepositoryUserPermissionPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Get returns the explicit permission of the specified user on the repository.
+func (i *internalRepositoryUserPermissionServiceImpl) Get(ctx context.Context, workspace, repoSlug, accountID string) (*model.RepositoryUserPermissionScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if accountID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoAccountID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/permissions-config/users/%v", workspace, repoSlug, accountID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	permission := new(model.RepositoryUserPermissionScheme)
+	response, err := i.c.Call(request, permission)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return permission, response, nil
+}
+
+// Update grants, or changes, the explicit permission of the specified user on the repository.
+func (i *internalRepositoryUserPermissionServiceImpl) Update(ctx context.Context, workspace, repoSlug, accountID string, payload *model.RepositoryPermissionPayloadScheme) (*model.RepositoryUserPermissionScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if accountID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoAccountID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/permissions-config/users/%v", workspace, repoSlug, accountID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	permission := new(model.RepositoryUserPermissionScheme)
+	response, err := i.c.Call(request, permission)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return permission, response, nil
+}
+
+// Delete revokes the explicit permission of the specified user on the repository.
+func (i *internalRepositoryUserPermissionServiceImpl) Delete(ctx context.Context, workspace, repoSlug, accountID string) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if accountID == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoAccountID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/permissions-config/users/%v", workspace, repoSlug, accountID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+// Check returns the effective permission of the authenticated user on the specified repository,
+func (i *internalRepositoryUserPermissionServiceImpl) Check(ctx context.Context, workspace, repoSlug string) (*model.RepositoryPermissionPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	params := url.Values{}
+	params.Add("q", fmt.Sprintf("repository.full_name=\"%v/%v\"", workspace, repoSlug))
+
+	endpoint := fmt.Sprintf("2.0/user/permissions/repositories?%v", params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.RepositoryPermissionPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
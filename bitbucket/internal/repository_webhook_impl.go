@@ -0,0 +1,229 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewRepositoryWebhookService handles communication with the repository webhook related methods of the Bitbucket API.
+func NewRepositoryWebhookService(client service.Connector) *RepositoryWebhookService {
+
+	return &RepositoryWebhookService{
+		internalClient: &internalRepositoryWebhookServiceImpl{c: client},
+		c:              client,
+	}
+}
+
+// RepositoryWebhookService handles communication with the repository webhook related methods of the Bitbucket API.
+type RepositoryWebhookService struct {
+	internalClient bitbucket.RepositoryWebhookConnector
+	c              service.Connector
+}
+
+// Gets returns a paginated list of the webhooks installed on the specified repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/hooks
+func (r *RepositoryWebhookService) Gets(ctx context.Context, workspace, repoSlug string) (*model.WebhookSubscriptionPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Gets(ctx, workspace, repoSlug)
+}
+
+// GetsAll iterates over all the webhooks installed on the specified repository.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/hooks
+func (r *RepositoryWebhookService) GetsAll(ctx context.Context, workspace, repoSlug string, opts ...paginate.Option) iter.Seq2[*model.WebhookSubscriptionScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.WebhookSubscriptionScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.WebhookSubscriptionScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/hooks", workspace, repoSlug)
+	return paginateLinks[*model.WebhookSubscriptionScheme](ctx, r.c, endpoint, opts)
+}
+
+// Get returns the specified webhook installed on the repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/hooks/{uid}
+func (r *RepositoryWebhookService) Get(ctx context.Context, workspace, repoSlug, webhookID string) (*model.WebhookSubscriptionScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Get(ctx, workspace, repoSlug, webhookID)
+}
+
+// Create creates a new webhook on the specified repository.
+//
+// Set the secret of the payload to have the deliveries signed in the X-Hub-Signature header.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/hooks
+func (r *RepositoryWebhookService) Create(ctx context.Context, workspace, repoSlug string, payload *model.WebhookSubscriptionPayloadScheme) (*model.WebhookSubscriptionScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Create(ctx, workspace, repoSlug, payload)
+}
+
+// Update updates the specified webhook subscription.
+//
+// PUT /2.0/repositories/{workspace}/{repo_slug}/hooks/{uid}
+func (r *RepositoryWebhookService) Update(ctx context.Context, workspace, repoSlug, webhookID string, payload *model.WebhookSubscriptionPayloadScheme) (*model.WebhookSubscriptionScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Update(ctx, workspace, repoSlug, webhookID, payload)
+}
+
+// Delete deletes the specified webhook subscription from the repository.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/hooks/{uid}
+func (r *RepositoryWebhookService) Delete(ctx context.Context, workspace, repoSlug, webhookID string) (*model.ResponseScheme, error) {
+	return r.internalClient.Delete(ctx, workspace, repoSlug, webhookID)
+}
+
+type internalRepositoryWebhookServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the webhooks installed on the specified repository.
+func (i *internalRepositoryWebhookServiceImpl) Gets(ctx context.Context, workspace, repoSlug string) (*model.WebhookSubscriptionPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/hooks", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.WebhookSubscriptionPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Get returns the specified webhook installed on the repository.
+func (i *internalRepositoryWebhookServiceImpl) Get(ctx context.Context, workspace, repoSlug, webhookID string) (*model.WebhookSubscriptionScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if webhookID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWebhookID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/hooks/%v", workspace, repoSlug, webhookID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	webhook := new(model.WebhookSubscriptionScheme)
+	response, err := i.c.Call(request, webhook)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return webhook, response, nil
+}
+
+// Create creates a new webhook on the specified repository.
+func (i *internalRepositoryWebhookServiceImpl) Create(ctx context.Context, workspace, repoSlug string, payload *model.WebhookSubscriptionPayloadScheme) (*model.WebhookSubscriptionScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/hooks", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	webhook := new(model.WebhookSubscriptionScheme)
+	response, err := i.c.Call(request, webhook)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return webhook, response, nil
+}
+
+// Update updates the specified webhook subscription.
+func (i *internalRepositoryWebhookServiceImpl) Update(ctx context.Context, workspace, repoSlug, webhookID string, payload *model.WebhookSubscriptionPayloadScheme) (*model.WebhookSubscriptionScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if webhookID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWebhookID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/hooks/%v", workspace, repoSlug, webhookID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	webhook := new(model.WebhookSubscriptionScheme)
+	response, err := i.c.Call(request, webhook)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return webhook, response, nil
+}
+
+// Delete deletes the specified webhook subscription from the repository.
+func (i *internalRepositoryWebhookServiceImpl) Delete(ctx context.Context, workspace, repoSlug, webhookID string) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if webhookID == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWebhookID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/hooks/%v", workspace, repoSlug, webhookID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
@@ -0,0 +1,227 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewSnippetCommentService handles communication with the snippet comment related methods of the Bitbucket API.
+func NewSnippetCommentService(client service.Connector) *SnippetCommentService {
+
+	return &SnippetCommentService{
+		internalClient: &internalSnippetCommentServiceImpl{c: client},
+		c:              client,
+	}
+}
+
+// SnippetCommentService handles communication with the snippet comment related methods of the Bitbucket API.
+type SnippetCommentService struct {
+	internalClient bitbucket.SnippetCommentConnector
+	c              service.Connector
+}
+
+// Gets returns a paginated list of the comments on the specified snippet.
+//
+// GET /2.0/snippets/{workspace}/{encoded_id}/comments
+func (c *SnippetCommentService) Gets(ctx context.Context, workspace, encodedID string) (*model.SnippetCommentPageScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Gets(ctx, workspace, encodedID)
+}
+
+// GetsAll iterates over all the comments on the specified snippet.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/snippets/{workspace}/{encoded_id}/comments
+func (c *SnippetCommentService) GetsAll(ctx context.Context, workspace, encodedID string, opts ...paginate.Option) iter.Seq2[*model.SnippetCommentScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.SnippetCommentScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if encodedID == "" {
+		return paginateError[*model.SnippetCommentScheme](fmt.Errorf("bitbucket: %w", model.ErrNoSnippetID))
+	}
+
+	endpoint := fmt.Sprintf("2.0/snippets/%v/%v/comments", workspace, encodedID)
+	return paginateLinks[*model.SnippetCommentScheme](ctx, c.c, endpoint, opts)
+}
+
+// Get returns the specified snippet comment.
+//
+// GET /2.0/snippets/{workspace}/{encoded_id}/comments/{comment_id}
+func (c *SnippetCommentService) Get(ctx context.Context, workspace, encodedID string, commentID int) (*model.SnippetCommentScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Get(ctx, workspace, encodedID, commentID)
+}
+
+// Create creates a new snippet comment, a reply when the payload has a parent.
+//
+// POST /2.0/snippets/{workspace}/{encoded_id}/comments
+func (c *SnippetCommentService) Create(ctx context.Context, workspace, encodedID string, payload *model.SnippetCommentPayloadScheme) (*model.SnippetCommentScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Create(ctx, workspace, encodedID, payload)
+}
+
+// Update updates the content of the specified snippet comment.
+//
+// PUT /2.0/snippets/{workspace}/{encoded_id}/comments/{comment_id}
+func (c *SnippetCommentService) Update(ctx context.Context, workspace, encodedID string, commentID int, payload *model.SnippetCommentPayloadScheme) (*model.SnippetCommentScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Update(ctx, workspace, encodedID, commentID, payload)
+}
+
+// Delete deletes the specified snippet comment.
+//
+// DELETE /2.0/snippets/{workspace}/{encoded_id}/comments/{comment_id}
+func (c *SnippetCommentService) Delete(ctx context.Context, workspace, encodedID string, commentID int) (*model.ResponseScheme, error) {
+	return c.internalClient.Delete(ctx, workspace, encodedID, commentID)
+}
+
+type internalSnippetCommentServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the comments on the specified snippet.
+func (i *internalSnippetCommentServiceImpl) Gets(ctx context.Context, workspace, encodedID string) (*model.SnippetCommentPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if encodedID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoSnippetID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/snippets/%v/%v/comments", workspace, encodedID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.SnippetCommentPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Get returns the specified snippet comment.
+func (i *internalSnippetCommentServiceImpl) Get(ctx context.Context, workspace, encodedID string, commentID int) (*model.SnippetCommentScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if encodedID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoSnippetID)
+	}
+
+	if commentID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommentID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/snippets/%v/%v/comments/%v", workspace, encodedID, commentID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comment := new(model.SnippetCommentScheme)
+	response, err := i.c.Call(request, comment)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return comment, response, nil
+}
+
+// Create creates a new snippet comment.
+func (i *internalSnippetCommentServiceImpl) Create(ctx context.Context, workspace, encodedID string, payload *model.SnippetCommentPayloadScheme) (*model.SnippetCommentScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if encodedID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoSnippetID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/snippets/%v/%v/comments", workspace, encodedID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comment := new(model.SnippetCommentScheme)
+	response, err := i.c.Call(request, comment)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return comment, response, nil
+}
+
+// Update updates the content of the specified snippet comment.
+func (i *internalSnippetCommentServiceImpl) Update(ctx context.Context, workspace, encodedID string, commentID int, payload *model.SnippetCommentPayloadScheme) (*model.SnippetCommentScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if encodedID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoSnippetID)
+	}
+
+	if commentID == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommentID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/snippets/%v/%v/comments/%v", workspace, encodedID, commentID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comment := new(model.SnippetCommentScheme)
+	response, err := i.c.Call(request, comment)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return comment, response, nil
+}
+
+// Delete deletes the specified snippet comment.
+func (i *internalSnippetCommentServiceImpl) Delete(ctx context.Context, workspace, encodedID string, commentID int) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if encodedID == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoSnippetID)
+	}
+
+	if commentID == 0 {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommentID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/snippets/%v/%v/comments/%v", workspace, encodedID, commentID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
@@ -0,0 +1,310 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewSnippetService handles communication with the snippet related methods of the Bitbucket API.
+func NewSnippetService(client service.Connector, comment *SnippetCommentService, revision *SnippetRevisionService) *SnippetService {
+
+	return &SnippetService{
+		internalClient: &internalSnippetServiceImpl{c: client},
+		c:              client,
+		Comment:        comment,
+		Revision:       revision,
+	}
+}
+
+// SnippetService handles communication with the snippet related methods of the Bitbucket API.
+type SnippetService struct {
+	internalClient bitbucket.SnippetConnector
+	c              service.Connector
+	Comment        *SnippetCommentService
+	Revision       *SnippetRevisionService
+}
+
+// Gets returns a paginated list of the snippets of the specified workspace.
+//
+// GET /2.0/snippets/{workspace}
+func (s *SnippetService) Gets(ctx context.Context, workspace string, options *model.SnippetOptionsScheme) (*model.SnippetPageScheme, *model.ResponseScheme, error) {
+	return s.internalClient.Gets(ctx, workspace, options)
+}
+
+// GetsAll iterates over all the snippets of the specified workspace matching the options.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/snippets/{workspace}
+func (s *SnippetService) GetsAll(ctx context.Context, workspace string, options *model.SnippetOptionsScheme, opts ...paginate.Option) iter.Seq2[*model.SnippetScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.SnippetScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	endpoint := snippetListEndpoint(fmt.Sprintf("2.0/snippets/%v", workspace), options)
+	return paginateLinks[*model.SnippetScheme](ctx, s.c, endpoint, opts)
+}
+
+// Get returns the specified snippet.
+//
+// GET /2.0/snippets/{workspace}/{encoded_id}
+func (s *SnippetService) Get(ctx context.Context, workspace, encodedID string) (*model.SnippetScheme, *model.ResponseScheme, error) {
+	return s.internalClient.Get(ctx, workspace, encodedID)
+}
+
+// Create creates a new snippet with the files of the payload.
+//
+// POST /2.0/snippets/{workspace}
+func (s *SnippetService) Create(ctx context.Context, workspace string, payload *model.SnippetPayloadScheme) (*model.SnippetScheme, *model.ResponseScheme, error) {
+	return s.internalClient.Create(ctx, workspace, payload)
+}
+
+// Update updates the title and the files of the specified snippet, creating a new revision.
+//
+// PUT /2.0/snippets/{workspace}/{encoded_id}
+func (s *SnippetService) Update(ctx context.Context, workspace, encodedID string, payload *model.SnippetPayloadScheme) (*model.SnippetScheme, *model.ResponseScheme, error) {
+	return s.internalClient.Update(ctx, workspace, encodedID, payload)
+}
+
+// Delete deletes the specified snippet.
+//
+// DELETE /2.0/snippets/{workspace}/{encoded_id}
+func (s *SnippetService) Delete(ctx context.Context, workspace, encodedID string) (*model.ResponseScheme, error) {
+	return s.internalClient.Delete(ctx, workspace, encodedID)
+}
+
+// File returns the contents of a file of the specified snippet as a reader, the caller must close it.
+//
+// The file is read at the revision, at the latest one when the revision is empty.
+//
+// GET /2.0/snippets/{workspace}/{encoded_id}/{node_id}/files/{path}
+func (s *SnippetService) File(ctx context.Context, workspace, encodedID, revision, path string) (io.ReadCloser, error) {
+	return s.internalClient.File(ctx, workspace, encodedID, revision, path)
+}
+
+type internalSnippetServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the snippets of the specified workspace.
+func (i *internalSnippetServiceImpl) Gets(ctx context.Context, workspace string, options *model.SnippetOptionsScheme) (*model.SnippetPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	endpoint := snippetListEndpoint(fmt.Sprintf("2.0/snippets/%v", workspace), options)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.SnippetPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Get returns the specified snippet.
+func (i *internalSnippetServiceImpl) Get(ctx context.Context, workspace, encodedID string) (*model.SnippetScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if encodedID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoSnippetID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/snippets/%v/%v", workspace, encodedID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	snippet := new(model.SnippetScheme)
+	response, err := i.c.Call(request, snippet)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return snippet, response, nil
+}
+
+// Create creates a new snippet with the files of the payload.
+func (i *internalSnippetServiceImpl) Create(ctx context.Context, workspace string, payload *model.SnippetPayloadScheme) (*model.SnippetScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if payload == nil || len(payload.Files) == 0 {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoSnippetFiles)
+	}
+
+	files, err := snippetForm(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	endpoint := fmt.Sprintf("2.0/snippets/%v", workspace)
+
+	request, err := newMultipartRequest(ctx, i.c, http.MethodPost, endpoint, snippetFields(payload), "file", files)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	snippet := new(model.SnippetScheme)
+	response, err := i.c.Call(request, snippet)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return snippet, response, nil
+}
+
+// Update updates the title and the files of the specified snippet, creating a new revision.
+func (i *internalSnippetServiceImpl) Update(ctx context.Context, workspace, encodedID string, payload *model.SnippetPayloadScheme) (*model.SnippetScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if encodedID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoSnippetID)
+	}
+
+	files, err := snippetForm(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	endpoint := fmt.Sprintf("2.0/snippets/%v/%v", workspace, encodedID)
+
+	request, err := newMultipartRequest(ctx, i.c, http.MethodPut, endpoint, snippetFields(payload), "file", files)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	snippet := new(model.SnippetScheme)
+	response, err := i.c.Call(request, snippet)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return snippet, response, nil
+}
+
+// Delete deletes the specified snippet.
+func (i *internalSnippetServiceImpl) Delete(ctx context.Context, workspace, encodedID string) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if encodedID == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoSnippetID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/snippets/%v/%v", workspace, encodedID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+// File returns the contents of a file of the specified snippet as a reader, the caller must close it.
+func (i *internalSnippetServiceImpl) File(ctx context.Context, workspace, encodedID, revision, path string) (io.ReadCloser, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if encodedID == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoSnippetID)
+	}
+
+	if path == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoFileName)
+	}
+
+	endpoint := fmt.Sprintf("2.0/snippets/%v/%v/files/%v", workspace, encodedID, escapePath(path))
+	if revision != "" {
+		endpoint = fmt.Sprintf("2.0/snippets/%v/%v/%v/files/%v", workspace, encodedID, url.PathEscape(revision), escapePath(path))
+	}
+
+	return download(ctx, i.c, endpoint)
+}
+
+// snippetListEndpoint appends the role filter to the endpoint listing snippets.
+func snippetListEndpoint(base string, options *model.SnippetOptionsScheme) string {
+
+	if options == nil || options.Role == "" {
+		return base
+	}
+
+	params := url.Values{}
+	params.Add("role", options.Role)
+
+	return fmt.Sprintf("%v?%v", base, params.Encode())
+}
+
+// snippetFields returns the form fields of the attributes of a snippet.
+func snippetFields(payload *model.SnippetPayloadScheme) url.Values {
+
+	fields := url.Values{}
+	if payload == nil {
+		return fields
+	}
+
+	if payload.Title != "" {
+		fields.Set("title", payload.Title)
+	}
+
+	fields.Set("is_private", strconv.FormatBool(payload.IsPrivate))
+
+	return fields
+}
+
+// snippetForm returns the form files of the files of a snippet.
+func snippetForm(payload *model.SnippetPayloadScheme) ([]formFile, error) {
+
+	if payload == nil {
+		return nil, nil
+	}
+
+	files := make([]formFile, 0, len(payload.Files))
+	for _, file := range payload.Files {
+
+		if file.Name == "" {
+			return nil, fmt.Errorf("bitbucket: %w", model.ErrNoFileName)
+		}
+
+		if file.Content == nil {
+			return nil, fmt.Errorf("bitbucket: %w", model.ErrNoFileReader)
+		}
+
+		files = append(files, formFile{name: file.Name, content: file.Content})
+	}
+
+	return files, nil
+}
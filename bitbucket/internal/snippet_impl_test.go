@@ -0,0 +1,492 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func Test_internalSnippetServiceImpl_Gets(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		options   *model.SnippetOptionsScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				options:   &model.SnippetOptionsScheme{Role: "owner"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/snippets/work-space-name-sample?role=owner",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.SnippetPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				options:   &model.SnippetOptionsScheme{Role: "owner"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/snippets/work-space-name-sample?role=owner",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "",
+				options:   &model.SnippetOptionsScheme{Role: "owner"},
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewSnippetService(testCase.fields.c, nil, nil)
+
+			gotResult, gotResponse, err := newService.Gets(testCase.args.ctx, testCase.args.workspace, testCase.args.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalSnippetServiceImpl_Get(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		encodedID string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				encodedID: "kypj4",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/snippets/work-space-name-sample/kypj4",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.SnippetScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				encodedID: "kypj4",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/snippets/work-space-name-sample/kypj4",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "",
+				encodedID: "kypj4",
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the snippet id is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				encodedID: "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoSnippetID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewSnippetService(testCase.fields.c, nil, nil)
+
+			gotResult, gotResponse, err := newService.Get(testCase.args.ctx, testCase.args.workspace, testCase.args.encodedID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalSnippetServiceImpl_Delete(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		encodedID string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				encodedID: "kypj4",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"2.0/snippets/work-space-name-sample/kypj4",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				encodedID: "kypj4",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"2.0/snippets/work-space-name-sample/kypj4",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "",
+				encodedID: "kypj4",
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the snippet id is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				encodedID: "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoSnippetID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewSnippetService(testCase.fields.c, nil, nil)
+
+			gotResponse, err := newService.Delete(testCase.args.ctx, testCase.args.workspace, testCase.args.encodedID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalSnippetServiceImpl_Create(t *testing.T) {
+
+	client := mocks.NewConnector(t)
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodPost,
+		"2.0/snippets/work-space-name-sample",
+		mock.MatchedBy(func(typ string) bool { return strings.HasPrefix(typ, "multipart/form-data; boundary=") }),
+		mock.AnythingOfType("*bytes.Buffer")).
+		Run(func(args mock.Arguments) {
+
+			_, params, err := mime.ParseMediaType(args.String(3))
+			assert.NoError(t, err)
+
+			form, err := multipart.NewReader(args.Get(4).(*bytes.Buffer), params["boundary"]).ReadForm(1 << 20)
+			assert.NoError(t, err)
+
+			assert.Equal(t, []string{"Deployment scripts"}, form.Value["title"])
+			assert.Equal(t, []string{"true"}, form.Value["is_private"])
+
+			var names []string
+			for _, file := range form.File["file"] {
+				names = append(names, file.Filename)
+			}
+
+			assert.Equal(t, []string{"deploy.sh", "rollback.sh"}, names)
+		}).
+		Return(&http.Request{}, nil)
+
+	client.On("Call", &http.Request{}, &model.SnippetScheme{}).
+		Return(&model.ResponseScheme{}, nil)
+
+	newService := NewSnippetService(client, nil, nil)
+
+	payload := &model.SnippetPayloadScheme{
+		Title:     "Deployment scripts",
+		IsPrivate: true,
+		Files: []*model.SnippetFilePayloadScheme{
+			{Name: "deploy.sh", Content: strings.NewReader("#!/bin/sh\nmake deploy")},
+			{Name: "rollback.sh", Content: strings.NewReader("#!/bin/sh\nmake rollback")},
+		},
+	}
+
+	snippet, response, err := newService.Create(context.Background(), "work-space-name-sample", payload)
+	assert.NoError(t, err)
+	assert.NotNil(t, response)
+	assert.NotNil(t, snippet)
+
+	_, _, err = newService.Create(context.Background(), "work-space-name-sample", &model.SnippetPayloadScheme{Title: "Empty"})
+	assert.True(t, errors.Is(err, model.ErrNoSnippetFiles))
+
+	_, _, err = newService.Create(context.Background(), "work-space-name-sample", &model.SnippetPayloadScheme{
+		Files: []*model.SnippetFilePayloadScheme{{Name: "deploy.sh"}},
+	})
+	assert.True(t, errors.Is(err, model.ErrNoFileReader))
+}
+
+func Test_internalSnippetServiceImpl_Update(t *testing.T) {
+
+	client := mocks.NewConnector(t)
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodPut,
+		"2.0/snippets/work-space-name-sample/kypj4",
+		mock.MatchedBy(func(typ string) bool { return strings.HasPrefix(typ, "multipart/form-data; boundary=") }),
+		mock.AnythingOfType("*bytes.Buffer")).
+		Return(&http.Request{}, nil)
+
+	client.On("Call", &http.Request{}, &model.SnippetScheme{}).
+		Return(&model.ResponseScheme{}, nil)
+
+	newService := NewSnippetService(client, nil, nil)
+
+	snippet, response, err := newService.Update(context.Background(), "work-space-name-sample", "kypj4", &model.SnippetPayloadScheme{Title: "Deployment and rollback scripts"})
+	assert.NoError(t, err)
+	assert.NotNil(t, response)
+	assert.NotNil(t, snippet)
+
+	_, _, err = newService.Update(context.Background(), "work-space-name-sample", "", nil)
+	assert.True(t, errors.Is(err, model.ErrNoSnippetID))
+}
+
+func Test_internalSnippetServiceImpl_File(t *testing.T) {
+
+	client := mocks.NewConnector(t)
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodGet,
+		"2.0/snippets/work-space-name-sample/kypj4/a4b4c8e1f0d9/files/scripts/deploy.sh",
+		"", nil).
+		Return(&http.Request{}, nil)
+
+	client.On("Do", &http.Request{}).
+		Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader("make deploy")),
+		}, nil)
+
+	newService := NewSnippetService(client, nil, nil)
+
+	reader, err := newService.File(context.Background(), "work-space-name-sample", "kypj4", "a4b4c8e1f0d9", "scripts/deploy.sh")
+	assert.NoError(t, err)
+	defer reader.Close()
+
+	contents, err := io.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, "make deploy", string(contents))
+
+	_, err = newService.File(context.Background(), "work-space-name-sample", "kypj4", "", "")
+	assert.True(t, errors.Is(err, model.ErrNoFileName))
+}
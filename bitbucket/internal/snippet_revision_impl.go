@@ -0,0 +1,162 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewSnippetRevisionService handles communication with the snippet revision related methods of the Bitbucket API.
+func NewSnippetRevisionService(client service.Connector) *SnippetRevisionService {
+
+	return &SnippetRevisionService{
+		internalClient: &internalSnippetRevisionServiceImpl{c: client},
+		c:              client,
+	}
+}
+
+// SnippetRevisionService handles communication with the snippet revision related methods of the Bitbucket API.
+type SnippetRevisionService struct {
+	internalClient bitbucket.SnippetRevisionConnector
+	c              service.Connector
+}
+
+// Gets returns a paginated list of the revisions of the specified snippet, the newest first.
+//
+// GET /2.0/snippets/{workspace}/{encoded_id}/commits
+func (r *SnippetRevisionService) Gets(ctx context.Context, workspace, encodedID string) (*model.SnippetCommitPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Gets(ctx, workspace, encodedID)
+}
+
+// GetsAll iterates over all the revisions of the specified snippet.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/snippets/{workspace}/{encoded_id}/commits
+func (r *SnippetRevisionService) GetsAll(ctx context.Context, workspace, encodedID string, opts ...paginate.Option) iter.Seq2[*model.SnippetCommitScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.SnippetCommitScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if encodedID == "" {
+		return paginateError[*model.SnippetCommitScheme](fmt.Errorf("bitbucket: %w", model.ErrNoSnippetID))
+	}
+
+	endpoint := fmt.Sprintf("2.0/snippets/%v/%v/commits", workspace, encodedID)
+	return paginateLinks[*model.SnippetCommitScheme](ctx, r.c, endpoint, opts)
+}
+
+// Get returns the specified snippet revision.
+//
+// GET /2.0/snippets/{workspace}/{encoded_id}/commits/{revision}
+func (r *SnippetRevisionService) Get(ctx context.Context, workspace, encodedID, revision string) (*model.SnippetCommitScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Get(ctx, workspace, encodedID, revision)
+}
+
+// Snippet returns the specified snippet as it was at the revision.
+//
+// GET /2.0/snippets/{workspace}/{encoded_id}/{node_id}
+func (r *SnippetRevisionService) Snippet(ctx context.Context, workspace, encodedID, revision string) (*model.SnippetScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Snippet(ctx, workspace, encodedID, revision)
+}
+
+type internalSnippetRevisionServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the revisions of the specified snippet, the newest first.
+func (i *internalSnippetRevisionServiceImpl) Gets(ctx context.Context, workspace, encodedID string) (*model.SnippetCommitPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if encodedID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoSnippetID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/snippets/%v/%v/commits", workspace, encodedID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.SnippetCommitPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Get returns the specified snippet revision.
+func (i *internalSnippetRevisionServiceImpl) Get(ctx context.Context, workspace, encodedID, revision string) (*model.SnippetCommitScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if encodedID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoSnippetID)
+	}
+
+	if revision == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoSnippetRevision)
+	}
+
+	endpoint := fmt.Sprintf("2.0/snippets/%v/%v/commits/%v", workspace, encodedID, url.PathEscape(revision))
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	commit := new(model.SnippetCommitScheme)
+	response, err := i.c.Call(request, commit)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return commit, response, nil
+}
+
+// Snippet returns the specified snippet as it was at the revision.
+func (i *internalSnippetRevisionServiceImpl) Snippet(ctx context.Context, workspace, encodedID, revision string) (*model.SnippetScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if encodedID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoSnippetID)
+	}
+
+	if revision == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoSnippetRevision)
+	}
+
+	endpoint := fmt.Sprintf("2.0/snippets/%v/%v/%v", workspace, encodedID, url.PathEscape(revision))
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	snippet := new(model.SnippetScheme)
+	response, err := i.c.Call(request, snippet)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return snippet, response, nil
+}
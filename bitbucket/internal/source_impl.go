@@ -0,0 +1,201 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewSourceService handles communication with the source related methods of the Bitbucket API.
+func NewSourceService(client service.Connector) *SourceService {
+
+	return &SourceService{
+		internalClient: &internalSourceServiceImpl{c: client},
+		c:              client,
+	}
+}
+
+// SourceService handles communication with the source related methods of the Bitbucket API.
+type SourceService struct {
+	internalClient bitbucket.SourceConnector
+	c              service.Connector
+}
+
+// Gets returns a paginated list of the entries of the specified directory at a revision.
+//
+// An empty path is the root directory of the repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/src/{commit}/{path}
+func (s *SourceService) Gets(ctx context.Context, workspace, repoSlug, commit, path string, options *model.SourceOptionsScheme) (*model.SourcePageScheme, *model.ResponseScheme, error) {
+	return s.internalClient.Gets(ctx, workspace, repoSlug, commit, path, options)
+}
+
+// GetsAll iterates over all the entries of the specified directory at a revision.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/src/{commit}/{path}
+func (s *SourceService) GetsAll(ctx context.Context, workspace, repoSlug, commit, path string, options *model.SourceOptionsScheme, opts ...paginate.Option) iter.Seq2[*model.SourceScheme, error] {
+
+	if workspace == "" {
+		return paginateError[*model.SourceScheme](fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace))
+	}
+
+	if repoSlug == "" {
+		return paginateError[*model.SourceScheme](fmt.Errorf("bitbucket: %w", model.ErrNoRepository))
+	}
+
+	if commit == "" {
+		return paginateError[*model.SourceScheme](fmt.Errorf("bitbucket: %w", model.ErrNoCommit))
+	}
+
+	endpoint := sourceListEndpoint(fmt.Sprintf("2.0/repositories/%v/%v/src/%v/%v", workspace, repoSlug, url.PathEscape(commit), escapePath(path)), options)
+	return paginateLinks[*model.SourceScheme](ctx, s.c, endpoint, opts)
+}
+
+// Get returns the metadata of the specified file or directory at a revision.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/src/{commit}/{path}?format=meta
+func (s *SourceService) Get(ctx context.Context, workspace, repoSlug, commit, path string) (*model.SourceScheme, *model.ResponseScheme, error) {
+	return s.internalClient.Get(ctx, workspace, repoSlug, commit, path)
+}
+
+// Download returns the contents of the specified file at a revision as a reader, the caller must close it.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/src/{commit}/{path}
+func (s *SourceService) Download(ctx context.Context, workspace, repoSlug, commit, path string) (io.ReadCloser, error) {
+	return s.internalClient.Download(ctx, workspace, repoSlug, commit, path)
+}
+
+type internalSourceServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the entries of the specified directory at a revision.
+func (i *internalSourceServiceImpl) Gets(ctx context.Context, workspace, repoSlug, commit, path string, options *model.SourceOptionsScheme) (*model.SourcePageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if commit == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommit)
+	}
+
+	endpoint := sourceListEndpoint(fmt.Sprintf("2.0/repositories/%v/%v/src/%v/%v", workspace, repoSlug, url.PathEscape(commit), escapePath(path)), options)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.SourcePageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Get returns the metadata of the specified file or directory at a revision.
+func (i *internalSourceServiceImpl) Get(ctx context.Context, workspace, repoSlug, commit, path string) (*model.SourceScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if commit == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommit)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/src/%v/%v?format=meta", workspace, repoSlug, url.PathEscape(commit), escapePath(path))
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	source := new(model.SourceScheme)
+	response, err := i.c.Call(request, source)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return source, response, nil
+}
+
+// Download returns the contents of the specified file at a revision as a reader, the caller must close it.
+func (i *internalSourceServiceImpl) Download(ctx context.Context, workspace, repoSlug, commit, path string) (io.ReadCloser, error) {
+
+	if workspace == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoWorkspace)
+	}
+
+	if repoSlug == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoRepository)
+	}
+
+	if commit == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoCommit)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/src/%v/%v", workspace, repoSlug, url.PathEscape(commit), escapePath(path))
+	return download(ctx, i.c, endpoint)
+}
+
+// sourceListEndpoint appends the depth, query and sort filters to the endpoint listing a directory.
+func sourceListEndpoint(base string, options *model.SourceOptionsScheme) string {
+
+	if options == nil {
+		return base
+	}
+
+	params := url.Values{}
+	if options.MaxDepth > 0 {
+		params.Add("max_depth", strconv.Itoa(options.MaxDepth))
+	}
+
+	if options.Query != "" {
+		params.Add("q", options.Query)
+	}
+
+	if options.Sort != "" {
+		params.Add("sort", options.Sort)
+	}
+
+	if len(params) == 0 {
+		return base
+	}
+
+	return fmt.Sprintf("%v?%v", base, params.Encode())
+}
+
+// escapePath escapes every segment of a repository path, keeping its separators.
+func escapePath(path string) string {
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for index, segment := range segments {
+		segments[index] = url.PathEscape(segment)
+	}
+
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,86 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewUserService handles communication with the user related methods of the Bitbucket API.
+func NewUserService(client service.Connector, sshKey *UserSSHKeyService) *UserService {
+
+	return &UserService{
+		internalClient: &internalUserServiceImpl{c: client},
+		SSHKey:         sshKey,
+	}
+}
+
+// UserService handles communication with the user related methods of the Bitbucket API.
+type UserService struct {
+	internalClient bitbucket.UserConnector
+	SSHKey         *UserSSHKeyService
+}
+
+// Current returns the currently logged-in user.
+//
+// GET /2.0/user
+func (u *UserService) Current(ctx context.Context) (*model.BitbucketAccountScheme, *model.ResponseScheme, error) {
+	return u.internalClient.Current(ctx)
+}
+
+// Get returns the specified user, identified by its account ID or its UUID.
+//
+// GET /2.0/users/{selected_user}
+func (u *UserService) Get(ctx context.Context, accountID string) (*model.BitbucketAccountScheme, *model.ResponseScheme, error) {
+	return u.internalClient.Get(ctx, accountID)
+}
+
+type internalUserServiceImpl struct {
+	c service.Connector
+}
+
+// Current returns the currently logged-in user.
+func (i *internalUserServiceImpl) Current(ctx context.Context) (*model.BitbucketAccountScheme, *model.ResponseScheme, error) {
+
+	endpoint := "2.0/user"
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user := new(model.BitbucketAccountScheme)
+	response, err := i.c.Call(request, user)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return user, response, nil
+}
+
+// Get returns the specified user, identified by its account ID or its UUID.
+func (i *internalUserServiceImpl) Get(ctx context.Context, accountID string) (*model.BitbucketAccountScheme, *model.ResponseScheme, error) {
+
+	if accountID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoAccountID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/users/%v", accountID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user := new(model.BitbucketAccountScheme)
+	response, err := i.c.Call(request, user)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return user, response, nil
+}
@@ -0,0 +1,203 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewUserSSHKeyService handles communication with the user SSH key related methods of the Bitbucket API.
+func NewUserSSHKeyService(client service.Connector) *UserSSHKeyService {
+
+	return &UserSSHKeyService{
+		internalClient: &internalUserSSHKeyServiceImpl{c: client},
+		c:              client,
+	}
+}
+
+// UserSSHKeyService handles communication with the user SSH key related methods of the Bitbucket API.
+type UserSSHKeyService struct {
+	internalClient bitbucket.UserSSHKeyConnector
+	c              service.Connector
+}
+
+// Gets returns a paginated list of the SSH keys of the specified user.
+//
+// GET /2.0/users/{selected_user}/ssh-keys
+func (u *UserSSHKeyService) Gets(ctx context.Context, accountID string) (*model.SSHKeyPageScheme, *model.ResponseScheme, error) {
+	return u.internalClient.Gets(ctx, accountID)
+}
+
+// GetsAll iterates over all the SSH keys of the specified user.
+//
+// The pages are fetched lazily following the next links, the iteration stops on the first error or when the context is done.
+//
+// GET /2.0/users/{selected_user}/ssh-keys
+func (u *UserSSHKeyService) GetsAll(ctx context.Context, accountID string, opts ...paginate.Option) iter.Seq2[*model.SSHKeyScheme, error] {
+
+	if accountID == "" {
+		return paginateError[*model.SSHKeyScheme](fmt.Errorf("bitbucket: %w", model.ErrNoAccountID))
+	}
+
+	endpoint := fmt.Sprintf("2.0/users/%v/ssh-keys", accountID)
+	return paginateLinks[*model.SSHKeyScheme](ctx, u.c, endpoint, opts)
+}
+
+// Get returns the specified SSH key of a user.
+//
+// GET /2.0/users/{selected_user}/ssh-keys/{key_id}
+func (u *UserSSHKeyService) Get(ctx context.Context, accountID, keyUUID string) (*model.SSHKeyScheme, *model.ResponseScheme, error) {
+	return u.internalClient.Get(ctx, accountID, keyUUID)
+}
+
+// Create adds a new SSH key to the specified user.
+//
+// POST /2.0/users/{selected_user}/ssh-keys
+func (u *UserSSHKeyService) Create(ctx context.Context, accountID string, payload *model.KeyPayloadScheme) (*model.SSHKeyScheme, *model.ResponseScheme, error) {
+	return u.internalClient.Create(ctx, accountID, payload)
+}
+
+// Update updates the label of the specified SSH key of a user.
+//
+// PUT /2.0/users/{selected_user}/ssh-keys/{key_id}
+func (u *UserSSHKeyService) Update(ctx context.Context, accountID, keyUUID string, payload *model.KeyPayloadScheme) (*model.SSHKeyScheme, *model.ResponseScheme, error) {
+	return u.internalClient.Update(ctx, accountID, keyUUID, payload)
+}
+
+// Delete removes the specified SSH key of a user.
+//
+// DELETE /2.0/users/{selected_user}/ssh-keys/{key_id}
+func (u *UserSSHKeyService) Delete(ctx context.Context, accountID, keyUUID string) (*model.ResponseScheme, error) {
+	return u.internalClient.Delete(ctx, accountID, keyUUID)
+}
+
+type internalUserSSHKeyServiceImpl struct {
+	c service.Connector
+}
+
+// Gets returns a paginated list of the SSH keys of the specified user.
+func (i *internalUserSSHKeyServiceImpl) Gets(ctx context.Context, accountID string) (*model.SSHKeyPageScheme, *model.ResponseScheme, error) {
+
+	if accountID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoAccountID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/users/%v/ssh-keys", accountID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.SSHKeyPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// Get returns the specified SSH key of a user.
+func (i *internalUserSSHKeyServiceImpl) Get(ctx context.Context, accountID, keyUUID string) (*model.SSHKeyScheme, *model.ResponseScheme, error) {
+
+	if accountID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoAccountID)
+	}
+
+	if keyUUID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoSSHKeyUUID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/users/%v/ssh-keys/%v", accountID, keyUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key := new(model.SSHKeyScheme)
+	response, err := i.c.Call(request, key)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return key, response, nil
+}
+
+// Create adds a new SSH key to the specified user.
+func (i *internalUserSSHKeyServiceImpl) Create(ctx context.Context, accountID string, payload *model.KeyPayloadScheme) (*model.SSHKeyScheme, *model.ResponseScheme, error) {
+
+	if accountID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoAccountID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/users/%v/ssh-keys", accountID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key := new(model.SSHKeyScheme)
+	response, err := i.c.Call(request, key)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return key, response, nil
+}
+
+// Update updates the label of the specified SSH key of a user.
+func (i *internalUserSSHKeyServiceImpl) Update(ctx context.Context, accountID, keyUUID string, payload *model.KeyPayloadScheme) (*model.SSHKeyScheme, *model.ResponseScheme, error) {
+
+	if accountID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoAccountID)
+	}
+
+	if keyUUID == "" {
+		return nil, nil, fmt.Errorf("bitbucket: %w", model.ErrNoSSHKeyUUID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/users/%v/ssh-keys/%v", accountID, keyUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key := new(model.SSHKeyScheme)
+	response, err := i.c.Call(request, key)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return key, response, nil
+}
+
+// Delete removes the specified SSH key of a user.
+func (i *internalUserSSHKeyServiceImpl) Delete(ctx context.Context, accountID, keyUUID string) (*model.ResponseScheme, error) {
+
+	if accountID == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoAccountID)
+	}
+
+	if keyUUID == "" {
+		return nil, fmt.Errorf("bitbucket: %w", model.ErrNoSSHKeyUUID)
+	}
+
+	endpoint := fmt.Sprintf("2.0/users/%v/ssh-keys/%v", accountID, keyUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
@@ -0,0 +1,617 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func Test_internalUserSSHKeyServiceImpl_Gets(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		accountID string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				accountID: "{d301aafa-d676-4ee0-88be-962be7417567}",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/users/{d301aafa-d676-4ee0-88be-962be7417567}/ssh-keys",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.SSHKeyPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				accountID: "{d301aafa-d676-4ee0-88be-962be7417567}",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/users/{d301aafa-d676-4ee0-88be-962be7417567}/ssh-keys",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the account id is not provided",
+			args: args{
+				ctx:       context.Background(),
+				accountID: "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoAccountID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewUserSSHKeyService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Gets(testCase.args.ctx, testCase.args.accountID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalUserSSHKeyServiceImpl_Get(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		accountID string
+		keyUUID   string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				accountID: "{d301aafa-d676-4ee0-88be-962be7417567}",
+				keyUUID:   "{b15b7a5c-8e4f-4a3d-9c2e-1f0a6b7c8d9e}",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/users/{d301aafa-d676-4ee0-88be-962be7417567}/ssh-keys/{b15b7a5c-8e4f-4a3d-9c2e-1f0a6b7c8d9e}",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.SSHKeyScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				accountID: "{d301aafa-d676-4ee0-88be-962be7417567}",
+				keyUUID:   "{b15b7a5c-8e4f-4a3d-9c2e-1f0a6b7c8d9e}",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/users/{d301aafa-d676-4ee0-88be-962be7417567}/ssh-keys/{b15b7a5c-8e4f-4a3d-9c2e-1f0a6b7c8d9e}",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the account id is not provided",
+			args: args{
+				ctx:       context.Background(),
+				accountID: "",
+				keyUUID:   "{b15b7a5c-8e4f-4a3d-9c2e-1f0a6b7c8d9e}",
+			},
+			wantErr: true,
+			Err:     model.ErrNoAccountID,
+		},
+
+		{
+			name: "when the key uuid is not provided",
+			args: args{
+				ctx:       context.Background(),
+				accountID: "{d301aafa-d676-4ee0-88be-962be7417567}",
+				keyUUID:   "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoSSHKeyUUID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewUserSSHKeyService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Get(testCase.args.ctx, testCase.args.accountID, testCase.args.keyUUID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalUserSSHKeyServiceImpl_Create(t *testing.T) {
+
+	payloadMocked := &model.KeyPayloadScheme{
+		Key:   "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIKqP3bYh8mZk1wM0Fv4oA2n9C5y3k7i0r2X1Vq8Yb6Tn ci@example.com",
+		Label: "ci-runner",
+	}
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		accountID string
+		payload   *model.KeyPayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				accountID: "{d301aafa-d676-4ee0-88be-962be7417567}",
+				payload:   payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"2.0/users/{d301aafa-d676-4ee0-88be-962be7417567}/ssh-keys",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.SSHKeyScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				accountID: "{d301aafa-d676-4ee0-88be-962be7417567}",
+				payload:   payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"2.0/users/{d301aafa-d676-4ee0-88be-962be7417567}/ssh-keys",
+					"", payloadMocked).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the account id is not provided",
+			args: args{
+				ctx:       context.Background(),
+				accountID: "",
+				payload:   payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoAccountID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewUserSSHKeyService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Create(testCase.args.ctx, testCase.args.accountID, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalUserSSHKeyServiceImpl_Update(t *testing.T) {
+
+	payloadMocked := &model.KeyPayloadScheme{
+		Key:   "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIKqP3bYh8mZk1wM0Fv4oA2n9C5y3k7i0r2X1Vq8Yb6Tn ci@example.com",
+		Label: "ci-runner",
+	}
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		accountID string
+		keyUUID   string
+		payload   *model.KeyPayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				accountID: "{d301aafa-d676-4ee0-88be-962be7417567}",
+				keyUUID:   "{b15b7a5c-8e4f-4a3d-9c2e-1f0a6b7c8d9e}",
+				payload:   payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"2.0/users/{d301aafa-d676-4ee0-88be-962be7417567}/ssh-keys/{b15b7a5c-8e4f-4a3d-9c2e-1f0a6b7c8d9e}",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.SSHKeyScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				accountID: "{d301aafa-d676-4ee0-88be-962be7417567}",
+				keyUUID:   "{b15b7a5c-8e4f-4a3d-9c2e-1f0a6b7c8d9e}",
+				payload:   payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"2.0/users/{d301aafa-d676-4ee0-88be-962be7417567}/ssh-keys/{b15b7a5c-8e4f-4a3d-9c2e-1f0a6b7c8d9e}",
+					"", payloadMocked).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the account id is not provided",
+			args: args{
+				ctx:       context.Background(),
+				accountID: "",
+				keyUUID:   "{b15b7a5c-8e4f-4a3d-9c2e-1f0a6b7c8d9e}",
+				payload:   payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoAccountID,
+		},
+
+		{
+			name: "when the key uuid is not provided",
+			args: args{
+				ctx:       context.Background(),
+				accountID: "{d301aafa-d676-4ee0-88be-962be7417567}",
+				keyUUID:   "",
+				payload:   payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoSSHKeyUUID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewUserSSHKeyService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Update(testCase.args.ctx, testCase.args.accountID, testCase.args.keyUUID, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalUserSSHKeyServiceImpl_Delete(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		accountID string
+		keyUUID   string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				accountID: "{d301aafa-d676-4ee0-88be-962be7417567}",
+				keyUUID:   "{b15b7a5c-8e4f-4a3d-9c2e-1f0a6b7c8d9e}",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"2.0/users/{d301aafa-d676-4ee0-88be-962be7417567}/ssh-keys/{b15b7a5c-8e4f-4a3d-9c2e-1f0a6b7c8d9e}",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				accountID: "{d301aafa-d676-4ee0-88be-962be7417567}",
+				keyUUID:   "{b15b7a5c-8e4f-4a3d-9c2e-1f0a6b7c8d9e}",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"2.0/users/{d301aafa-d676-4ee0-88be-962be7417567}/ssh-keys/{b15b7a5c-8e4f-4a3d-9c2e-1f0a6b7c8d9e}",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the account id is not provided",
+			args: args{
+				ctx:       context.Background(),
+				accountID: "",
+				keyUUID:   "{b15b7a5c-8e4f-4a3d-9c2e-1f0a6b7c8d9e}",
+			},
+			wantErr: true,
+			Err:     model.ErrNoAccountID,
+		},
+
+		{
+			name: "when the key uuid is not provided",
+			args: args{
+				ctx:       context.Background(),
+				accountID: "{d301aafa-d676-4ee0-88be-962be7417567}",
+				keyUUID:   "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoSSHKeyUUID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewUserSSHKeyService(testCase.fields.c)
+
+			gotResponse, err := newService.Delete(testCase.args.ctx, testCase.args.accountID, testCase.args.keyUUID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
@@ -11,12 +11,13 @@ import (
 )
 
 // NewWorkspaceService handles communication with the workspace related methods of the Bitbucket API.
-func NewWorkspaceService(client service.Connector, webhook *WorkspaceHookService, permission *WorkspacePermissionService) *WorkspaceService {
+func NewWorkspaceService(client service.Connector, webhook *WorkspaceHookService, permission *WorkspacePermissionService, project *ProjectService) *WorkspaceService {
 
 	return &WorkspaceService{
 		internalClient: &internalWorkspaceServiceImpl{c: client},
 		Hook:           webhook,
 		Permission:     permission,
+		Project:        project,
 	}
 }
 
@@ -25,6 +26,7 @@ type WorkspaceService struct {
 	internalClient bitbucket.WorkspaceConnector
 	Hook           *WorkspaceHookService
 	Permission     *WorkspacePermissionService
+	Project        *ProjectService
 }
 
 // Get returns the requested workspace.
@@ -102,7 +102,7 @@ func Test_internalWorkspaceServiceImpl_Get(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			newService := NewWorkspaceService(testCase.fields.c, nil, nil)
+			newService := NewWorkspaceService(testCase.fields.c, nil, nil, nil)
 
 			gotResult, gotResponse, err := newService.Get(testCase.args.ctx, testCase.args.workspace)
 
@@ -218,7 +218,7 @@ func Test_internalWorkspaceServiceImpl_Members(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			newService := NewWorkspaceService(testCase.fields.c, nil, nil)
+			newService := NewWorkspaceService(testCase.fields.c, nil, nil, nil)
 
 			gotResult, gotResponse, err := newService.Members(testCase.args.ctx, testCase.args.workspace)
 
@@ -334,7 +334,7 @@ func Test_internalWorkspaceServiceImpl_Projects(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			newService := NewWorkspaceService(testCase.fields.c, nil, nil)
+			newService := NewWorkspaceService(testCase.fields.c, nil, nil, nil)
 
 			gotResult, gotResponse, err := newService.Projects(testCase.args.ctx, testCase.args.workspace)
 
@@ -463,7 +463,7 @@ func Test_internalWorkspaceServiceImpl_Membership(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			newService := NewWorkspaceService(testCase.fields.c, nil, nil)
+			newService := NewWorkspaceService(testCase.fields.c, nil, nil, nil)
 
 			gotResult, gotResponse, err := newService.Membership(testCase.args.ctx, testCase.args.workspace, testCase.args.memberID)
 
@@ -0,0 +1,146 @@
+package webhook
+
+import (
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// The keys of the events sent by Bitbucket Cloud in the X-Event-Key header.
+const (
+	EventRepoPush                         = "repo:push"
+	EventRepoFork                         = "repo:fork"
+	EventRepoUpdated                      = "repo:updated"
+	EventRepoCommitCommentCreated         = "repo:commit_comment_created"
+	EventRepoCommitStatusCreated          = "repo:commit_status_created"
+	EventRepoCommitStatusUpdated          = "repo:commit_status_updated"
+	EventPullRequestCreated               = "pullrequest:created"
+	EventPullRequestUpdated               = "pullrequest:updated"
+	EventPullRequestApproved              = "pullrequest:approved"
+	EventPullRequestUnapproved            = "pullrequest:unapproved"
+	EventPullRequestChangesRequestCreated = "pullrequest:changes_request_created"
+	EventPullRequestChangesRequestRemoved = "pullrequest:changes_request_removed"
+	EventPullRequestFulfilled             = "pullrequest:fulfilled"
+	EventPullRequestRejected              = "pullrequest:rejected"
+	EventPullRequestCommentCreated        = "pullrequest:comment_created"
+	EventPullRequestCommentUpdated        = "pullrequest:comment_updated"
+	EventPullRequestCommentDeleted        = "pullrequest:comment_deleted"
+	EventPullRequestCommentResolved       = "pullrequest:comment_resolved"
+	EventPullRequestCommentReopened       = "pullrequest:comment_reopened"
+)
+
+// pullRequestEvents are the keys of the events decoded as a PullRequestEvent.
+var pullRequestEvents = []string{
+	EventPullRequestCreated,
+	EventPullRequestUpdated,
+	EventPullRequestApproved,
+	EventPullRequestUnapproved,
+	EventPullRequestChangesRequestCreated,
+	EventPullRequestChangesRequestRemoved,
+	EventPullRequestFulfilled,
+	EventPullRequestRejected,
+}
+
+// pullRequestCommentEvents are the keys of the events decoded as a PullRequestCommentEvent.
+var pullRequestCommentEvents = []string{
+	EventPullRequestCommentCreated,
+	EventPullRequestCommentUpdated,
+	EventPullRequestCommentDeleted,
+	EventPullRequestCommentResolved,
+	EventPullRequestCommentReopened,
+}
+
+// commitStatusEvents are the keys of the events decoded as a CommitStatusEvent.
+var commitStatusEvents = []string{
+	EventRepoCommitStatusCreated,
+	EventRepoCommitStatusUpdated,
+}
+
+// repositoryEvents are the keys of the events decoded as a RepositoryEvent.
+var repositoryEvents = []string{
+	EventRepoFork,
+	EventRepoUpdated,
+}
+
+// Delivery represents a webhook delivery, as received by the handler.
+type Delivery struct {
+	Key         string // The key of the event, from the X-Event-Key header.
+	HookUUID    string // The UUID of the webhook, from the X-Hook-UUID header.
+	RequestUUID string // The UUID of the delivery, the same for its retries, from the X-Request-UUID header.
+	Attempt     int    // The delivery attempt, starting at 1, from the X-Attempt-Number header.
+	Payload     []byte // The raw payload of the delivery.
+}
+
+// PushEvent represents a repo:push event.
+type PushEvent struct {
+	Delivery   *Delivery                     `json:"-"`                    // The delivery of the event.
+	Actor      *model.BitbucketAccountScheme `json:"actor,omitempty"`      // The user who pushed.
+	Repository *model.RepositoryScheme       `json:"repository,omitempty"` // The repository pushed to.
+	Push       *PushScheme                   `json:"push,omitempty"`       // The details of the push.
+}
+
+// PushScheme represents the references updated by a push.
+type PushScheme struct {
+	Changes []*PushChangeScheme `json:"changes,omitempty"` // The changes, one per updated branch or tag.
+}
+
+// PushChangeScheme represents a branch or a tag updated by a push.
+type PushChangeScheme struct {
+	Old       *model.RefScheme      `json:"old,omitempty"`       // The reference before the push, nil when it was created.
+	New       *model.RefScheme      `json:"new,omitempty"`       // The reference after the push, nil when it was deleted.
+	Created   bool                  `json:"created,omitempty"`   // Indicates if the reference was created.
+	Closed    bool                  `json:"closed,omitempty"`    // Indicates if the reference was deleted.
+	Forced    bool                  `json:"forced,omitempty"`    // Indicates if the push was forced.
+	Truncated bool                  `json:"truncated,omitempty"` // Indicates if the list of the commits is truncated.
+	Commits   []*model.CommitScheme `json:"commits,omitempty"`   // The commits pushed, the newest first.
+}
+
+// PullRequestEvent represents a pullrequest event: created, updated, approved, unapproved,
+// changes requested or removed, fulfilled or rejected.
+type PullRequestEvent struct {
+	Delivery       *Delivery                      `json:"-"`                         // The delivery of the event.
+	Actor          *model.BitbucketAccountScheme  `json:"actor,omitempty"`           // The user who triggered the event.
+	Repository     *model.RepositoryScheme        `json:"repository,omitempty"`      // The destination repository of the pull request.
+	PullRequest    *model.PullRequestScheme       `json:"pullrequest,omitempty"`     // The pull request.
+	Approval       *model.PullRequestReviewScheme `json:"approval,omitempty"`        // The approval, for the approved and unapproved events.
+	ChangesRequest *model.PullRequestReviewScheme `json:"changes_request,omitempty"` // The change request, for the changes request events.
+}
+
+// PullRequestCommentEvent represents a pullrequest comment event: created, updated, deleted, resolved or reopened.
+type PullRequestCommentEvent struct {
+	Delivery    *Delivery                       `json:"-"`                     // The delivery of the event.
+	Actor       *model.BitbucketAccountScheme   `json:"actor,omitempty"`       // The user who triggered the event.
+	Repository  *model.RepositoryScheme         `json:"repository,omitempty"`  // The destination repository of the pull request.
+	PullRequest *model.PullRequestScheme        `json:"pullrequest,omitempty"` // The pull request commented.
+	Comment     *model.PullRequestCommentScheme `json:"comment,omitempty"`     // The comment.
+}
+
+// CommitCommentEvent represents a repo:commit_comment_created event.
+type CommitCommentEvent struct {
+	Delivery   *Delivery                     `json:"-"`                    // The delivery of the event.
+	Actor      *model.BitbucketAccountScheme `json:"actor,omitempty"`      // The user who commented.
+	Repository *model.RepositoryScheme       `json:"repository,omitempty"` // The repository of the commit.
+	Comment    *model.CommitCommentScheme    `json:"comment,omitempty"`    // The comment.
+	Commit     *model.CommitScheme           `json:"commit,omitempty"`     // The commit commented.
+}
+
+// CommitStatusEvent represents a repo:commit_status_created or a repo:commit_status_updated event.
+type CommitStatusEvent struct {
+	Delivery     *Delivery                     `json:"-"`                       // The delivery of the event.
+	Actor        *model.BitbucketAccountScheme `json:"actor,omitempty"`         // The user who reported the status.
+	Repository   *model.RepositoryScheme       `json:"repository,omitempty"`    // The repository of the commit.
+	CommitStatus *model.CommitStatusScheme     `json:"commit_status,omitempty"` // The build status.
+}
+
+// RepositoryEvent represents a repo:fork or a repo:updated event.
+type RepositoryEvent struct {
+	Delivery   *Delivery                          `json:"-"`                    // The delivery of the event.
+	Actor      *model.BitbucketAccountScheme      `json:"actor,omitempty"`      // The user who triggered the event.
+	Repository *model.RepositoryScheme            `json:"repository,omitempty"` // The repository, the parent for a fork.
+	Fork       *model.RepositoryScheme            `json:"fork,omitempty"`       // The fork created, for the fork events.
+	Changes    map[string]*RepositoryChangeScheme `json:"changes,omitempty"`    // The attributes changed, keyed by name, for the update events.
+}
+
+// RepositoryChangeScheme represents the old and the new value of an attribute of a repository.
+type RepositoryChangeScheme struct {
+	Old any `json:"old,omitempty"` // The value before the update.
+	New any `json:"new,omitempty"` // The value after the update.
+}
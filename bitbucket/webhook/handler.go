@@ -0,0 +1,181 @@
+// Package webhook receives the Bitbucket Cloud webhook deliveries.
+//
+// The Handler is an http.Handler validating the X-Hub-Signature of the deliveries, decoding their payload
+// based on the X-Event-Key header and dispatching them to the handlers registered for the event.
+//
+//	handler, err := webhook.NewHandler(secret)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//
+//	handler.OnPush(func(ctx context.Context, event *webhook.PushEvent) error {
+//		log.Println(event.Repository.FullName, len(event.Push.Changes))
+//		return nil
+//	})
+//
+//	handler.OnPullRequest(func(ctx context.Context, event *webhook.PullRequestEvent) error {
+//		log.Println(event.Delivery.Key, event.PullRequest.Title)
+//		return nil
+//	}, webhook.EventPullRequestCreated, webhook.EventPullRequestFulfilled)
+//
+//	http.Handle("/bitbucket/events", handler)
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	infra "github.com/ctreminiom/go-atlassian/v2/pkg/infra/webhook"
+)
+
+// Option configures a Handler.
+type Option = infra.Option
+
+// WithErrorHandler sets the function called with the errors the handler responds with,
+// to log the invalid deliveries and the failures of the registered handlers.
+func WithErrorHandler(fn func(request *http.Request, err error)) Option {
+	return infra.WithErrorHandler(fn)
+}
+
+// WithoutSignatureVerification disables the validation of the X-Hub-Signature of the deliveries,
+// for the webhooks created without a secret. Any client reaching the handler can then forge a delivery,
+// only use it behind another authentication, e.g. a private network or a verifying proxy.
+func WithoutSignatureVerification() Option {
+	return infra.WithoutSignatureVerification()
+}
+
+// Handler receives the webhook deliveries and dispatches them to the registered handlers.
+//
+// The handlers are called sequentially in their registration order, until one of them returns an error.
+// The handler responds with 204 once the delivery is handled, even when no handler is registered for its event,
+// with 500 when a registered handler fails and with a 4xx status when the delivery is invalid.
+type Handler struct {
+	handler *infra.Handler[*Delivery]
+}
+
+// NewHandler returns a Handler validating the deliveries signed with the secret of the webhook.
+//
+// The secret is required, unless the WithoutSignatureVerification option is provided.
+func NewHandler(secret string, opts ...Option) (*Handler, error) {
+
+	handler, err := infra.NewHandler("bitbucket", secret, parse, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Handler{handler: handler}, nil
+}
+
+// OnEvent registers a handler receiving the raw deliveries of the events of the keys,
+// of every event when no key is provided.
+func (h *Handler) OnEvent(fn func(ctx context.Context, delivery *Delivery) error, keys ...string) {
+	h.handler.OnEvent(fn, keys...)
+}
+
+// OnPush registers a handler receiving the repo:push events.
+func (h *Handler) OnPush(fn func(ctx context.Context, event *PushEvent) error) {
+	on(h, fn, []string{EventRepoPush})
+}
+
+// OnPullRequest registers a handler receiving the pull request events of the keys,
+// of every pull request event but the comment ones when no key is provided.
+func (h *Handler) OnPullRequest(fn func(ctx context.Context, event *PullRequestEvent) error, keys ...string) {
+	on(h, fn, keysOrDefault(keys, pullRequestEvents))
+}
+
+// OnPullRequestComment registers a handler receiving the pull request comment events of the keys,
+// of every pull request comment event when no key is provided.
+func (h *Handler) OnPullRequestComment(fn func(ctx context.Context, event *PullRequestCommentEvent) error, keys ...string) {
+	on(h, fn, keysOrDefault(keys, pullRequestCommentEvents))
+}
+
+// OnCommitComment registers a handler receiving the repo:commit_comment_created events.
+func (h *Handler) OnCommitComment(fn func(ctx context.Context, event *CommitCommentEvent) error) {
+	on(h, fn, []string{EventRepoCommitCommentCreated})
+}
+
+// OnCommitStatus registers a handler receiving the commit status events of the keys,
+// of both the created and the updated events when no key is provided.
+func (h *Handler) OnCommitStatus(fn func(ctx context.Context, event *CommitStatusEvent) error, keys ...string) {
+	on(h, fn, keysOrDefault(keys, commitStatusEvents))
+}
+
+// OnRepository registers a handler receiving the repository events of the keys,
+// of both the fork and the updated events when no key is provided.
+func (h *Handler) OnRepository(fn func(ctx context.Context, event *RepositoryEvent) error, keys ...string) {
+	on(h, fn, keysOrDefault(keys, repositoryEvents))
+}
+
+// ServeHTTP validates the delivery of the request and dispatches it to the registered handlers.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.handler.ServeHTTP(w, r)
+}
+
+// Dispatch calls the handlers registered for the event of the delivery,
+// use it to process the deliveries received by another transport, e.g. replayed from a queue.
+func (h *Handler) Dispatch(ctx context.Context, delivery *Delivery) error {
+	return h.handler.Dispatch(ctx, delivery.Key, delivery)
+}
+
+// Signature returns the X-Hub-Signature header value of a payload signed with the secret,
+// use it to test the handlers with signed deliveries.
+func Signature(secret string, payload []byte) string {
+	return infra.Signature(secret, payload)
+}
+
+// parse builds the delivery of a request from its headers, the event key is sent in the X-Event-Key header.
+func parse(r *http.Request, payload []byte) (*Delivery, string, error) {
+
+	attempt, _ := strconv.Atoi(r.Header.Get("X-Attempt-Number"))
+	delivery := &Delivery{
+		Key:         r.Header.Get("X-Event-Key"),
+		HookUUID:    r.Header.Get("X-Hook-UUID"),
+		RequestUUID: r.Header.Get("X-Request-UUID"),
+		Attempt:     attempt,
+		Payload:     payload,
+	}
+
+	return delivery, delivery.Key, nil
+}
+
+// event is implemented by the typed events, to attach the delivery they are decoded from.
+type event[T any] interface {
+	*T
+	setDelivery(delivery *Delivery)
+}
+
+// on registers a handler receiving the events of the keys decoded as a T.
+func on[T any, P event[T]](h *Handler, fn func(context.Context, P) error, keys []string) {
+
+	h.OnEvent(func(ctx context.Context, delivery *Delivery) error {
+
+		payload := P(new(T))
+		if err := json.Unmarshal(delivery.Payload, payload); err != nil {
+			return fmt.Errorf("bitbucket: webhook: %w: %v", model.ErrInvalidWebhookPayload, err)
+		}
+
+		payload.setDelivery(delivery)
+		return fn(ctx, payload)
+	}, keys...)
+}
+
+// keysOrDefault returns the keys, the default ones when none is provided.
+func keysOrDefault(keys, defaults []string) []string {
+
+	if len(keys) == 0 {
+		return defaults
+	}
+
+	return keys
+}
+
+func (e *PushEvent) setDelivery(delivery *Delivery)               { e.Delivery = delivery }
+func (e *PullRequestEvent) setDelivery(delivery *Delivery)        { e.Delivery = delivery }
+func (e *PullRequestCommentEvent) setDelivery(delivery *Delivery) { e.Delivery = delivery }
+func (e *CommitCommentEvent) setDelivery(delivery *Delivery)      { e.Delivery = delivery }
+func (e *CommitStatusEvent) setDelivery(delivery *Delivery)       { e.Delivery = delivery }
+func (e *RepositoryEvent) setDelivery(delivery *Delivery)         { e.Delivery = delivery }
@@ -0,0 +1,218 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+const (
+	pushPayload = `{
+		"actor": {"display_name": "Carlos Treminio", "account_id": "5b10ac8d82e05b22cc7d4ef5"},
+		"repository": {"full_name": "work-space-name-sample/repository-sample"},
+		"push": {"changes": [{
+			"new": {"type": "branch", "name": "main", "target": {"hash": "a4b4c8e1f0d9"}},
+			"old": {"type": "branch", "name": "main", "target": {"hash": "0c9d8e7f6a5b"}},
+			"forced": false,
+			"commits": [{"hash": "a4b4c8e1f0d9", "message": "Fix the build"}]
+		}]}
+	}`
+
+	pullRequestPayload = `{
+		"actor": {"display_name": "Carlos Treminio"},
+		"repository": {"full_name": "work-space-name-sample/repository-sample"},
+		"pullrequest": {"id": 42, "title": "Add the webhook handler", "state": "MERGED"}
+	}`
+)
+
+func newDelivery(t *testing.T, secret, key, payload string) *http.Request {
+	t.Helper()
+
+	request := httptest.NewRequest(http.MethodPost, "/bitbucket/events", strings.NewReader(payload))
+	request.Header.Set("X-Event-Key", key)
+	request.Header.Set("X-Hook-UUID", "{9f9a0b1c-2d3e-4f50-8a6b-7c8d9e0f1a2b}")
+	request.Header.Set("X-Request-UUID", "{1c2d3e4f-5a6b-4c7d-8e9f-0a1b2c3d4e5f}")
+	request.Header.Set("X-Attempt-Number", "2")
+
+	if secret != "" {
+		request.Header.Set("X-Hub-Signature", Signature(secret, []byte(payload)))
+	}
+
+	return request
+}
+
+func newHandler(t *testing.T, secret string, opts ...Option) *Handler {
+	t.Helper()
+
+	handler, err := NewHandler(secret, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return handler
+}
+
+func TestNewHandler(t *testing.T) {
+
+	t.Run("when the secret is not provided", func(t *testing.T) {
+
+		handler, err := NewHandler("")
+
+		assert.Nil(t, handler)
+		assert.True(t, errors.Is(err, model.ErrNoWebhookSecret))
+	})
+
+	t.Run("when the signature verification is disabled", func(t *testing.T) {
+
+		handler, err := NewHandler("", WithoutSignatureVerification())
+		assert.NoError(t, err)
+
+		handler.OnPush(func(ctx context.Context, event *PushEvent) error { return nil })
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, newDelivery(t, "", EventRepoPush, pushPayload))
+
+		assert.Equal(t, http.StatusNoContent, recorder.Code)
+	})
+}
+
+func TestHandler_ServeHTTP(t *testing.T) {
+
+	t.Run("when the push event is dispatched", func(t *testing.T) {
+
+		handler := newHandler(t, "s3cr3t")
+
+		var received *PushEvent
+		handler.OnPush(func(ctx context.Context, event *PushEvent) error {
+			received = event
+			return nil
+		})
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, newDelivery(t, "s3cr3t", EventRepoPush, pushPayload))
+
+		assert.Equal(t, http.StatusNoContent, recorder.Code)
+		if assert.NotNil(t, received) {
+			assert.Equal(t, "work-space-name-sample/repository-sample", received.Repository.FullName)
+			assert.Equal(t, "main", received.Push.Changes[0].New.Name)
+			assert.Equal(t, "a4b4c8e1f0d9", received.Push.Changes[0].Commits[0].Hash)
+			assert.Equal(t, EventRepoPush, received.Delivery.Key)
+			assert.Equal(t, "{1c2d3e4f-5a6b-4c7d-8e9f-0a1b2c3d4e5f}", received.Delivery.RequestUUID)
+			assert.Equal(t, 2, received.Delivery.Attempt)
+		}
+	})
+
+	t.Run("when the pull request events are filtered by key", func(t *testing.T) {
+
+		handler := newHandler(t, "s3cr3t")
+
+		var keys []string
+		handler.OnPullRequest(func(ctx context.Context, event *PullRequestEvent) error {
+			keys = append(keys, event.Delivery.Key)
+			assert.Equal(t, 42, event.PullRequest.ID)
+			return nil
+		}, EventPullRequestFulfilled)
+
+		for _, key := range []string{EventPullRequestCreated, EventPullRequestFulfilled} {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, newDelivery(t, "s3cr3t", key, pullRequestPayload))
+			assert.Equal(t, http.StatusNoContent, recorder.Code)
+		}
+
+		assert.Equal(t, []string{EventPullRequestFulfilled}, keys)
+	})
+
+	t.Run("when the raw handlers receive every event", func(t *testing.T) {
+
+		handler := newHandler(t, "", WithoutSignatureVerification())
+
+		var keys []string
+		handler.OnEvent(func(ctx context.Context, delivery *Delivery) error {
+			keys = append(keys, delivery.Key)
+			return nil
+		})
+
+		for _, key := range []string{EventRepoPush, "issue:created"} {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, newDelivery(t, "", key, pushPayload))
+			assert.Equal(t, http.StatusNoContent, recorder.Code)
+		}
+
+		assert.Equal(t, []string{EventRepoPush, "issue:created"}, keys)
+	})
+
+	t.Run("when the signature does not match", func(t *testing.T) {
+
+		var reported error
+		handler := newHandler(t, "s3cr3t", WithErrorHandler(func(request *http.Request, err error) {
+			reported = err
+		}))
+
+		handler.OnPush(func(ctx context.Context, event *PushEvent) error {
+			t.Error("the handler must not be called")
+			return nil
+		})
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, newDelivery(t, "another-secret", EventRepoPush, pushPayload))
+
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+		assert.True(t, errors.Is(reported, model.ErrInvalidWebhookSignature))
+	})
+
+	t.Run("when the signature is not provided", func(t *testing.T) {
+
+		recorder := httptest.NewRecorder()
+		newHandler(t, "s3cr3t").ServeHTTP(recorder, newDelivery(t, "", EventRepoPush, pushPayload))
+
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	})
+
+	t.Run("when the event key is not provided", func(t *testing.T) {
+
+		recorder := httptest.NewRecorder()
+		newHandler(t, "s3cr3t").ServeHTTP(recorder, newDelivery(t, "s3cr3t", "", pushPayload))
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("when the payload cannot be decoded", func(t *testing.T) {
+
+		handler := newHandler(t, "s3cr3t")
+		handler.OnPush(func(ctx context.Context, event *PushEvent) error { return nil })
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, newDelivery(t, "s3cr3t", EventRepoPush, `{"push": [`))
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("when a handler fails", func(t *testing.T) {
+
+		handler := newHandler(t, "s3cr3t")
+		handler.OnPush(func(ctx context.Context, event *PushEvent) error {
+			return errors.New("queue unavailable")
+		})
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, newDelivery(t, "s3cr3t", EventRepoPush, pushPayload))
+
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	})
+
+	t.Run("when the method is not allowed", func(t *testing.T) {
+
+		recorder := httptest.NewRecorder()
+		newHandler(t, "s3cr3t").ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/bitbucket/events", nil))
+
+		assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+		assert.Equal(t, http.MethodPost, recorder.Header().Get("Allow"))
+	})
+}
@@ -3,7 +3,9 @@ package internal
 import (
 	"context"
 	"fmt"
+	"iter"
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/confluence"
 	"net/http"
@@ -42,6 +44,29 @@ func (p *PageService) Gets(ctx context.Context, options *model.PageOptionsScheme
 	return p.internalClient.Gets(ctx, options, cursor, limit)
 }
 
+// GetsAll iterates over all the pages matching the options.
+//
+// The pages are fetched lazily using Gets and the cursor of the next link,
+// the iteration stops on the first error or when the context is done.
+//
+// GET /wiki/api/v2/pages
+func (p *PageService) GetsAll(ctx context.Context, options *model.PageOptionsScheme, opts ...paginate.Option) iter.Seq2[*model.PageScheme, error] {
+	return paginate.Cursor(ctx, func(ctx context.Context, cursor string, limit int) (*paginate.Page[*model.PageScheme], error) {
+
+		chunk, _, err := p.Gets(ctx, options, cursor, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		page := &paginate.Page[*model.PageScheme]{Items: chunk.Results}
+		if chunk.Links != nil {
+			page.Next = paginate.CursorFromLink(chunk.Links.Next)
+		}
+
+		return page, nil
+	}, opts...)
+}
+
 // Bulk returns all pages.
 //
 // Deprecated. Please use Page.Gets() instead.
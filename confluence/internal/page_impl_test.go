@@ -10,8 +10,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
 )
@@ -1186,3 +1188,87 @@ func Test_internalPageImpl_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestPageService_GetsAll(t *testing.T) {
+
+	// expectPage mocks the Gets request of the page identified by the query.
+	expectPage := func(client *mocks.Connector, query string, page *model.PageChunkScheme, err error) {
+
+		request := &http.Request{Method: http.MethodGet, Header: http.Header{"Page": []string{query}}}
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"wiki/api/v2/pages?"+query,
+			"", nil).
+			Return(request, nil).Once()
+
+		client.On("Call",
+			request,
+			&model.PageChunkScheme{}).
+			Run(func(args mock.Arguments) {
+				if page != nil {
+					*args.Get(1).(*model.PageChunkScheme) = *page
+				}
+			}).
+			Return(&model.ResponseScheme{}, err).Once()
+	}
+
+	options := &model.PageOptionsScheme{Title: "Roadmap"}
+	first := &model.PageChunkScheme{
+		Results: []*model.PageScheme{{ID: "65538"}, {ID: "65539"}},
+		Links:   &model.PageChunkLinksScheme{Next: "/wiki/api/v2/pages?cursor=eyJpZCI6IjY1NTM5In0&limit=2&title=Roadmap"},
+	}
+
+	t.Run("when the pages span several chunks", func(t *testing.T) {
+
+		client := mocks.NewConnector(t)
+		expectPage(client, "limit=2&title=Roadmap", first, nil)
+		expectPage(client, "cursor=eyJpZCI6IjY1NTM5In0&limit=2&title=Roadmap", &model.PageChunkScheme{Results: []*model.PageScheme{{ID: "65540"}}}, nil)
+
+		var ids []string
+		for page, err := range NewPageService(client).GetsAll(context.Background(), options, paginate.WithPageSize(2)) {
+			assert.NoError(t, err)
+			ids = append(ids, page.ID)
+		}
+
+		assert.Equal(t, []string{"65538", "65539", "65540"}, ids)
+	})
+
+	t.Run("when the iteration stops early", func(t *testing.T) {
+
+		client := mocks.NewConnector(t)
+		expectPage(client, "limit=2&title=Roadmap", first, nil)
+
+		var ids []string
+		for page, err := range NewPageService(client).GetsAll(context.Background(), options, paginate.WithPageSize(2)) {
+			assert.NoError(t, err)
+			ids = append(ids, page.ID)
+			break
+		}
+
+		assert.Equal(t, []string{"65538"}, ids)
+	})
+
+	t.Run("when a chunk cannot be fetched", func(t *testing.T) {
+
+		client := mocks.NewConnector(t)
+		expectPage(client, "limit=2&title=Roadmap", first, nil)
+		expectPage(client, "cursor=eyJpZCI6IjY1NTM5In0&limit=2&title=Roadmap", nil, model.ErrNotFound)
+
+		var ids []string
+		var errs []error
+		for page, err := range NewPageService(client).GetsAll(context.Background(), options, paginate.WithPageSize(2)) {
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+
+			ids = append(ids, page.ID)
+		}
+
+		assert.Equal(t, []string{"65538", "65539"}, ids)
+		assert.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], model.ErrNotFound)
+	})
+}
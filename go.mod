@@ -1,6 +1,6 @@
 module github.com/ctreminiom/go-atlassian/v2
 
-go 1.23
+go 1.23.0
 
 require (
 	dario.cat/mergo v1.0.2
@@ -8,11 +8,20 @@ require (
 	github.com/google/uuid v1.6.0
 	github.com/stretchr/testify v1.12.0
 	github.com/tidwall/gjson v1.19.0
+	go.opentelemetry.io/otel v1.37.0
+	go.opentelemetry.io/otel/metric v1.37.0
+	go.opentelemetry.io/otel/sdk v1.37.0
+	go.opentelemetry.io/otel/sdk/metric v1.37.0
+	go.opentelemetry.io/otel/trace v1.37.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
+	github.com/go-logr/logr v1.4.3 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
 	github.com/stretchr/objx v0.5.3 // indirect
 	github.com/tidwall/match v1.1.1 // indirect
 	github.com/tidwall/pretty v1.2.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
+	go.opentelemetry.io/auto/sdk v1.1.0 // indirect
+	golang.org/x/sys v0.33.0 // indirect
 )
@@ -3,12 +3,14 @@ package internal
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/agile"
 )
@@ -155,6 +157,23 @@ func (b *BoardService) Issues(ctx context.Context, boardID int, opts *model.Issu
 	return b.internalClient.Issues(ctx, boardID, opts, startAt, maxResults)
 }
 
+// IssuesAll iterates over all the issues of a board.
+//
+// The pages are fetched lazily using Issues, the iteration stops on the first error or when the context is done.
+//
+// GET /rest/agile/1.0/board/{boardID}/issue
+func (b *BoardService) IssuesAll(ctx context.Context, boardID int, opts *model.IssueOptionScheme, options ...paginate.Option) iter.Seq2[*model.IssueSchemeV2, error] {
+	return paginate.Offset(ctx, func(ctx context.Context, startAt, maxResults int) (*paginate.Page[*model.IssueSchemeV2], error) {
+
+		result, _, err := b.Issues(ctx, boardID, opts, startAt, maxResults)
+		if err != nil {
+			return nil, err
+		}
+
+		return &paginate.Page[*model.IssueSchemeV2]{Items: result.Issues, Total: result.Total}, nil
+	}, options...)
+}
+
 // Move issues from the backlog to the board (if they are already in the backlog of that board).
 //
 // This operation either moves an issue(s) onto a board from the backlog (by adding it to the issueList for the board)
@@ -10,8 +10,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
 )
@@ -2760,3 +2762,83 @@ func Test_BoardService_Versions(t *testing.T) {
 		})
 	}
 }
+
+func TestBoardService_IssuesAll(t *testing.T) {
+
+	// expectPage mocks the Issues request of the page starting at the index.
+	expectPage := func(client *mocks.Connector, startAt string, page *model.BoardIssuePageScheme, err error) {
+
+		request := &http.Request{Method: http.MethodGet, Header: http.Header{"Page": []string{startAt}}}
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/agile/1.0/board/4/issue?jql=status+%3D+Done&maxResults=2&startAt="+startAt,
+			"", nil).
+			Return(request, nil).Once()
+
+		client.On("Call",
+			request,
+			&model.BoardIssuePageScheme{}).
+			Run(func(args mock.Arguments) {
+				if page != nil {
+					*args.Get(1).(*model.BoardIssuePageScheme) = *page
+				}
+			}).
+			Return(&model.ResponseScheme{}, err).Once()
+	}
+
+	options := &model.IssueOptionScheme{JQL: "status = Done", ValidateQuery: true}
+
+	t.Run("when the issues span several pages", func(t *testing.T) {
+
+		client := mocks.NewConnector(t)
+		expectPage(client, "0", &model.BoardIssuePageScheme{Issues: []*model.IssueSchemeV2{{Key: "KP-1"}, {Key: "KP-2"}}, Total: 3}, nil)
+		expectPage(client, "2", &model.BoardIssuePageScheme{Issues: []*model.IssueSchemeV2{{Key: "KP-3"}}, Total: 3}, nil)
+
+		var keys []string
+		for issue, err := range NewBoardService(client, "1.0").IssuesAll(context.Background(), 4, options, paginate.WithPageSize(2)) {
+			assert.NoError(t, err)
+			keys = append(keys, issue.Key)
+		}
+
+		assert.Equal(t, []string{"KP-1", "KP-2", "KP-3"}, keys)
+	})
+
+	t.Run("when the iteration stops early", func(t *testing.T) {
+
+		client := mocks.NewConnector(t)
+		expectPage(client, "0", &model.BoardIssuePageScheme{Issues: []*model.IssueSchemeV2{{Key: "KP-1"}, {Key: "KP-2"}}, Total: 3}, nil)
+
+		var keys []string
+		for issue, err := range NewBoardService(client, "1.0").IssuesAll(context.Background(), 4, options, paginate.WithPageSize(2)) {
+			assert.NoError(t, err)
+			keys = append(keys, issue.Key)
+			break
+		}
+
+		assert.Equal(t, []string{"KP-1"}, keys)
+	})
+
+	t.Run("when a page cannot be fetched", func(t *testing.T) {
+
+		client := mocks.NewConnector(t)
+		expectPage(client, "0", &model.BoardIssuePageScheme{Issues: []*model.IssueSchemeV2{{Key: "KP-1"}, {Key: "KP-2"}}, Total: 3}, nil)
+		expectPage(client, "2", nil, model.ErrNotFound)
+
+		var keys []string
+		var errs []error
+		for issue, err := range NewBoardService(client, "1.0").IssuesAll(context.Background(), 4, options, paginate.WithPageSize(2)) {
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+
+			keys = append(keys, issue.Key)
+		}
+
+		assert.Equal(t, []string{"KP-1", "KP-2"}, keys)
+		assert.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], model.ErrNotFound)
+	})
+}
@@ -0,0 +1,111 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// NewExpressionService creates a new instance of ExpressionService.
+func NewExpressionService(client service.Connector, version string) (*ExpressionService, error) {
+
+	if version == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoVersionProvided)
+	}
+
+	return &ExpressionService{
+		internalClient: &internalExpressionImpl{c: client, version: version},
+	}, nil
+}
+
+// ExpressionService provides methods to evaluate and analyse Jira expressions.
+type ExpressionService struct {
+	// internalClient is the connector interface for Jira expression operations.
+	internalClient jira.ExpressionConnector
+}
+
+// Evaluate evaluates a Jira expression in the context, the complexity of the evaluation is returned in the metadata.
+//
+// The result is returned as raw JSON, decode it into the type the expression evaluates to.
+//
+// POST /rest/api/{2-3}/expression/eval
+func (e *ExpressionService) Evaluate(ctx context.Context, expression string, expressionContext *model.ExpressionEvalContextScheme) (*model.ExpressionEvalScheme, *model.ResponseScheme, error) {
+	return e.internalClient.Evaluate(ctx, expression, expressionContext)
+}
+
+// Analyse checks the syntax, the types or the complexity of Jira expressions without evaluating them.
+//
+// The check is one of model.ExpressionCheckSyntax, model.ExpressionCheckType or model.ExpressionCheckComplexity,
+// the types are checked when it's empty.
+//
+// POST /rest/api/{2-3}/expression/analyse
+func (e *ExpressionService) Analyse(ctx context.Context, check string, payload *model.ExpressionAnalysePayloadScheme) (*model.ExpressionAnalysisScheme, *model.ResponseScheme, error) {
+	return e.internalClient.Analyse(ctx, check, payload)
+}
+
+type internalExpressionImpl struct {
+	c       service.Connector
+	version string
+}
+
+func (i *internalExpressionImpl) Evaluate(ctx context.Context, expression string, expressionContext *model.ExpressionEvalContextScheme) (*model.ExpressionEvalScheme, *model.ResponseScheme, error) {
+
+	if expression == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoExpression)
+	}
+
+	payload := &model.ExpressionEvalPayloadScheme{
+		Expression: expression,
+		Context:    expressionContext,
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/expression/eval?expand=meta.complexity", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(model.ExpressionEvalScheme)
+	response, err := i.c.Call(request, result)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return result, response, nil
+}
+
+func (i *internalExpressionImpl) Analyse(ctx context.Context, check string, payload *model.ExpressionAnalysePayloadScheme) (*model.ExpressionAnalysisScheme, *model.ResponseScheme, error) {
+
+	if payload == nil || len(payload.Expressions) == 0 {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoExpressions)
+	}
+
+	var endpoint strings.Builder
+	endpoint.WriteString(fmt.Sprintf("rest/api/%v/expression/analyse", i.version))
+
+	if check != "" {
+		params := url.Values{}
+		params.Add("check", check)
+		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint.String(), "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	analysis := new(model.ExpressionAnalysisScheme)
+	response, err := i.c.Call(request, analysis)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return analysis, response, nil
+}
@@ -0,0 +1,327 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func Test_internalExpressionImpl_Evaluate(t *testing.T) {
+
+	contextMocked := &model.ExpressionEvalContextScheme{
+		Issue: &model.ExpressionEvalIssueScheme{Key: "KP-1"},
+		Issues: &model.ExpressionEvalIssuesScheme{
+			JQL: &model.ExpressionEvalJQLScheme{Query: "project = KP", MaxResults: 50, Validation: "strict"},
+		},
+		Sprint: 10001,
+	}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx               context.Context
+		expression        string
+		expressionContext *model.ExpressionEvalContextScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:               context.Background(),
+				expression:        "issue.status.name",
+				expressionContext: contextMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/expression/eval?expand=meta.complexity",
+					"", &model.ExpressionEvalPayloadScheme{Expression: "issue.status.name", Context: contextMocked}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ExpressionEvalScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:               context.Background(),
+				expression:        "issue.status.name",
+				expressionContext: contextMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/expression/eval?expand=meta.complexity",
+					"", &model.ExpressionEvalPayloadScheme{Expression: "issue.status.name", Context: contextMocked}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ExpressionEvalScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the expression is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:               context.Background(),
+				expressionContext: contextMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoExpression,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:               context.Background(),
+				expression:        "issue.status.name",
+				expressionContext: contextMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/expression/eval?expand=meta.complexity",
+					"", &model.ExpressionEvalPayloadScheme{Expression: "issue.status.name", Context: contextMocked}).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewExpressionService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := newService.Evaluate(testCase.args.ctx, testCase.args.expression, testCase.args.expressionContext)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalExpressionImpl_Analyse(t *testing.T) {
+
+	payloadMocked := &model.ExpressionAnalysePayloadScheme{
+		Expressions:      []string{"issues.map(issue => issue.properties['property_key'])", "value.length"},
+		ContextVariables: map[string]string{"value": "List<Number>"},
+	}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx     context.Context
+		check   string
+		payload *model.ExpressionAnalysePayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				check:   model.ExpressionCheckComplexity,
+				payload: payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/expression/analyse?check=complexity",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ExpressionAnalysisScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:     context.Background(),
+				check:   model.ExpressionCheckComplexity,
+				payload: payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/expression/analyse?check=complexity",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ExpressionAnalysisScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the payload is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:   context.Background(),
+				check: model.ExpressionCheckComplexity,
+			},
+			wantErr: true,
+			Err:     model.ErrNoExpressions,
+		},
+
+		{
+			name:   "when the expressions are not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				check:   model.ExpressionCheckComplexity,
+				payload: &model.ExpressionAnalysePayloadScheme{ContextVariables: payloadMocked.ContextVariables},
+			},
+			wantErr: true,
+			Err:     model.ErrNoExpressions,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				check:   model.ExpressionCheckComplexity,
+				payload: payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/expression/analyse?check=complexity",
+					"", payloadMocked).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewExpressionService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := newService.Analyse(testCase.args.ctx, testCase.args.check, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// NewIssueChangelogService creates a new instance of IssueChangelogService.
+func NewIssueChangelogService(client service.Connector, version string) (*IssueChangelogService, error) {
+
+	if version == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoVersionProvided)
+	}
+
+	return &IssueChangelogService{
+		internalClient: &internalIssueChangelogImpl{c: client, version: version},
+	}, nil
+}
+
+// IssueChangelogService provides methods to read the change histories of the issues.
+//
+// Unlike the changelog expanded on the issues, limited to their last 100 histories, the histories are paginated.
+// Use model.IssueFieldValuesAt to reconstruct the values of the fields of an issue at a point in time.
+type IssueChangelogService struct {
+	// internalClient is the connector interface for issue changelog operations.
+	internalClient jira.IssueChangelogConnector
+}
+
+// Gets returns a paginated list of the change histories of an issue, the oldest first.
+//
+// GET /rest/api/{2-3}/issue/{issueKeyOrID}/changelog
+func (i *IssueChangelogService) Gets(ctx context.Context, issueKeyOrID string, startAt, maxResults int) (*model.IssueChangelogPageScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Gets(ctx, issueKeyOrID, startAt, maxResults)
+}
+
+// GetsAll iterates over the full history of an issue, the oldest change first.
+//
+// The pages are fetched lazily using Gets, the iteration stops on the first error or when the context is done.
+//
+// GET /rest/api/{2-3}/issue/{issueKeyOrID}/changelog
+func (i *IssueChangelogService) GetsAll(ctx context.Context, issueKeyOrID string, opts ...paginate.Option) iter.Seq2[*model.IssueChangelogHistoryScheme, error] {
+	return paginate.Offset(ctx, func(ctx context.Context, startAt, maxResults int) (*paginate.Page[*model.IssueChangelogHistoryScheme], error) {
+
+		result, _, err := i.Gets(ctx, issueKeyOrID, startAt, maxResults)
+		if err != nil {
+			return nil, err
+		}
+
+		return &paginate.Page[*model.IssueChangelogHistoryScheme]{Items: result.Values, Total: result.Total, IsLast: result.IsLast}, nil
+	}, opts...)
+}
+
+// GetsByIDs returns the change histories of an issue with the IDs.
+//
+// POST /rest/api/{2-3}/issue/{issueKeyOrID}/changelog/list
+func (i *IssueChangelogService) GetsByIDs(ctx context.Context, issueKeyOrID string, changelogIDs []int) (*model.IssueChangelogScheme, *model.ResponseScheme, error) {
+	return i.internalClient.GetsByIDs(ctx, issueKeyOrID, changelogIDs)
+}
+
+// BulkFetch returns a page of the change histories of several issues, optionally filtered by field.
+//
+// POST /rest/api/{2-3}/changelog/bulkfetch
+func (i *IssueChangelogService) BulkFetch(ctx context.Context, payload *model.IssueChangelogBulkFetchPayloadScheme) (*model.IssueChangelogBulkFetchPageScheme, *model.ResponseScheme, error) {
+	return i.internalClient.BulkFetch(ctx, payload)
+}
+
+// BulkFetchAll iterates over the change histories of several issues, optionally filtered by field.
+//
+// The pages are fetched lazily using BulkFetch, the token and the page size of the payload are managed by the iterator.
+// The histories of an issue can be split across several yielded items.
+//
+// POST /rest/api/{2-3}/changelog/bulkfetch
+func (i *IssueChangelogService) BulkFetchAll(ctx context.Context, issueIDsOrKeys, fieldIDs []string, opts ...paginate.Option) iter.Seq2[*model.IssueChangelogBulkFetchScheme, error] {
+	return paginate.Token(ctx, func(ctx context.Context, token string, limit int) (*paginate.Page[*model.IssueChangelogBulkFetchScheme], error) {
+
+		payload := &model.IssueChangelogBulkFetchPayloadScheme{
+			IssueIDsOrKeys: issueIDsOrKeys,
+			FieldIDs:       fieldIDs,
+			MaxResults:     limit,
+			NextPageToken:  token,
+		}
+
+		result, _, err := i.BulkFetch(ctx, payload)
+		if err != nil {
+			return nil, err
+		}
+
+		return &paginate.Page[*model.IssueChangelogBulkFetchScheme]{Items: result.IssueChangeLogs, Next: result.NextPageToken}, nil
+	}, opts...)
+}
+
+type internalIssueChangelogImpl struct {
+	c       service.Connector
+	version string
+}
+
+func (i *internalIssueChangelogImpl) Gets(ctx context.Context, issueKeyOrID string, startAt, maxResults int) (*model.IssueChangelogPageScheme, *model.ResponseScheme, error) {
+
+	if issueKeyOrID == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoIssueKeyOrID)
+	}
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/changelog?%v", i.version, issueKeyOrID, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.IssueChangelogPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalIssueChangelogImpl) GetsByIDs(ctx context.Context, issueKeyOrID string, changelogIDs []int) (*model.IssueChangelogScheme, *model.ResponseScheme, error) {
+
+	if issueKeyOrID == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoIssueKeyOrID)
+	}
+
+	if len(changelogIDs) == 0 {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoChangelogIDs)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/changelog/list", i.version, issueKeyOrID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", &model.IssueChangelogIDsPayloadScheme{ChangelogIDs: changelogIDs})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	changelog := new(model.IssueChangelogScheme)
+	response, err := i.c.Call(request, changelog)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return changelog, response, nil
+}
+
+func (i *internalIssueChangelogImpl) BulkFetch(ctx context.Context, payload *model.IssueChangelogBulkFetchPayloadScheme) (*model.IssueChangelogBulkFetchPageScheme, *model.ResponseScheme, error) {
+
+	if payload == nil || len(payload.IssueIDsOrKeys) == 0 {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoIssueKeyOrID)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/changelog/bulkfetch", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.IssueChangelogBulkFetchPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
@@ -48,6 +48,8 @@ type IssueServices struct {
 	WorklogRichText *WorklogRichTextService
 	// Property is the service for managing issue properties.
 	Property *IssuePropertyService
+	// Changelog is the service for reading the issue change histories.
+	Changelog *IssueChangelogService
 }
 
 // NewIssueService creates new instances of IssueRichTextService and IssueADFService.
@@ -89,6 +91,7 @@ func NewIssueService(client service.Connector, version string, services *IssueSe
 		adfService.Watcher = services.Watcher
 		adfService.Worklog = services.WorklogAdf
 		adfService.Property = services.Property
+		adfService.Changelog = services.Changelog
 
 		richTextService.Comment = services.CommentRT
 		richTextService.Attachment = services.Attachment
@@ -104,6 +107,7 @@ func NewIssueService(client service.Connector, version string, services *IssueSe
 		richTextService.Watcher = services.Watcher
 		richTextService.Worklog = services.WorklogRichText
 		richTextService.Property = services.Property
+		richTextService.Changelog = services.Changelog
 
 	}
 
@@ -46,6 +46,8 @@ type IssueRichTextService struct {
 	Worklog *WorklogRichTextService
 	// Property is the service for managing issue properties.
 	Property *IssuePropertyService
+	// Changelog is the service for reading the issue change histories.
+	Changelog *IssueChangelogService
 }
 
 // Delete deletes an issue.
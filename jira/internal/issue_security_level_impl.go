@@ -0,0 +1,196 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// NewIssueSecurityLevelService creates a new instance of IssueSecurityLevelService.
+func NewIssueSecurityLevelService(client service.Connector, version string, member *IssueSecurityLevelMemberService) (*IssueSecurityLevelService, error) {
+
+	if version == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoVersionProvided)
+	}
+
+	return &IssueSecurityLevelService{
+		internalClient: &internalIssueSecurityLevelImpl{c: client, version: version},
+		Member:         member,
+	}, nil
+}
+
+// IssueSecurityLevelService provides methods to manage the security levels of the issue security schemes.
+type IssueSecurityLevelService struct {
+	// internalClient is the connector interface for security level operations.
+	internalClient jira.IssueSecurityLevelConnector
+	// Member is the service for managing the members of the security levels.
+	Member *IssueSecurityLevelMemberService
+}
+
+// Gets returns a paginated list of the security levels matching the options.
+//
+// GET /rest/api/{2-3}/issuesecurityschemes/level
+func (i *IssueSecurityLevelService) Gets(ctx context.Context, options *model.IssueSecurityLevelSearchOptions, startAt, maxResults int) (*model.IssueSecurityLevelPageScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Gets(ctx, options, startAt, maxResults)
+}
+
+// Add adds security levels, and optionally their members, to an issue security scheme.
+//
+// PUT /rest/api/{2-3}/issuesecurityschemes/{schemeID}/level
+func (i *IssueSecurityLevelService) Add(ctx context.Context, schemeID string, payload *model.IssueSecurityLevelsPayloadScheme) (*model.ResponseScheme, error) {
+	return i.internalClient.Add(ctx, schemeID, payload)
+}
+
+// Update updates the name or the description of a security level.
+//
+// PUT /rest/api/{2-3}/issuesecurityschemes/{schemeID}/level/{levelID}
+func (i *IssueSecurityLevelService) Update(ctx context.Context, schemeID, levelID string, payload *model.IssueSecurityLevelUpdatePayloadScheme) (*model.ResponseScheme, error) {
+	return i.internalClient.Update(ctx, schemeID, levelID, payload)
+}
+
+// Delete deletes a security level, the issues with the level are moved to the replacement level when set.
+//
+// The deletion runs asynchronously, use the ID of the task returned to follow it with the task service,
+// e.g. with its WaitForCompletion method.
+//
+// DELETE /rest/api/{2-3}/issuesecurityschemes/{schemeID}/level/{levelID}
+func (i *IssueSecurityLevelService) Delete(ctx context.Context, schemeID, levelID, replaceWith string) (*model.TaskScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Delete(ctx, schemeID, levelID, replaceWith)
+}
+
+// SetDefault sets the default security levels of issue security schemes.
+//
+// PUT /rest/api/{2-3}/issuesecurityschemes/level/default
+func (i *IssueSecurityLevelService) SetDefault(ctx context.Context, payload *model.IssueSecurityLevelDefaultsPayloadScheme) (*model.ResponseScheme, error) {
+	return i.internalClient.SetDefault(ctx, payload)
+}
+
+type internalIssueSecurityLevelImpl struct {
+	c       service.Connector
+	version string
+}
+
+func (i *internalIssueSecurityLevelImpl) Gets(ctx context.Context, options *model.IssueSecurityLevelSearchOptions, startAt, maxResults int) (*model.IssueSecurityLevelPageScheme, *model.ResponseScheme, error) {
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	if options != nil {
+
+		for _, id := range options.IDs {
+			params.Add("id", id)
+		}
+
+		for _, id := range options.SchemeIDs {
+			params.Add("schemeId", id)
+		}
+
+		if options.OnlyDefault {
+			params.Add("onlyDefault", "true")
+		}
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuesecurityschemes/level?%v", i.version, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.IssueSecurityLevelPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalIssueSecurityLevelImpl) Add(ctx context.Context, schemeID string, payload *model.IssueSecurityLevelsPayloadScheme) (*model.ResponseScheme, error) {
+
+	if schemeID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoIssueSecuritySchemeID)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuesecurityschemes/%v/level", i.version, schemeID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalIssueSecurityLevelImpl) Update(ctx context.Context, schemeID, levelID string, payload *model.IssueSecurityLevelUpdatePayloadScheme) (*model.ResponseScheme, error) {
+
+	if schemeID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoIssueSecuritySchemeID)
+	}
+
+	if levelID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoIssueSecurityLevelID)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuesecurityschemes/%v/level/%v", i.version, schemeID, levelID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalIssueSecurityLevelImpl) Delete(ctx context.Context, schemeID, levelID, replaceWith string) (*model.TaskScheme, *model.ResponseScheme, error) {
+
+	if schemeID == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoIssueSecuritySchemeID)
+	}
+
+	if levelID == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoIssueSecurityLevelID)
+	}
+
+	var endpoint strings.Builder
+	endpoint.WriteString(fmt.Sprintf("rest/api/%v/issuesecurityschemes/%v/level/%v", i.version, schemeID, levelID))
+
+	if replaceWith != "" {
+		params := url.Values{}
+		params.Add("replaceWith", replaceWith)
+		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint.String(), "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	task := new(model.TaskScheme)
+	response, err := i.c.Call(request, task)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return task, response, nil
+}
+
+func (i *internalIssueSecurityLevelImpl) SetDefault(ctx context.Context, payload *model.IssueSecurityLevelDefaultsPayloadScheme) (*model.ResponseScheme, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuesecurityschemes/level/default", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
@@ -0,0 +1,755 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func Test_internalIssueSecurityLevelImpl_Gets(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx        context.Context
+		options    *model.IssueSecurityLevelSearchOptions
+		startAt    int
+		maxResults int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				options:    &model.IssueSecurityLevelSearchOptions{IDs: []string{"10021"}, SchemeIDs: []string{"10000"}, OnlyDefault: true},
+				startAt:    0,
+				maxResults: 50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issuesecurityschemes/level?id=10021&maxResults=50&onlyDefault=true&schemeId=10000&startAt=0",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueSecurityLevelPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:        context.Background(),
+				options:    &model.IssueSecurityLevelSearchOptions{IDs: []string{"10021"}, SchemeIDs: []string{"10000"}, OnlyDefault: true},
+				startAt:    0,
+				maxResults: 50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issuesecurityschemes/level?id=10021&maxResults=50&onlyDefault=true&schemeId=10000&startAt=0",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueSecurityLevelPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				options:    &model.IssueSecurityLevelSearchOptions{IDs: []string{"10021"}, SchemeIDs: []string{"10000"}, OnlyDefault: true},
+				startAt:    0,
+				maxResults: 50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issuesecurityschemes/level?id=10021&maxResults=50&onlyDefault=true&schemeId=10000&startAt=0",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewIssueSecurityLevelService(testCase.fields.c, testCase.fields.version, nil)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := newService.Gets(testCase.args.ctx, testCase.args.options, testCase.args.startAt, testCase.args.maxResults)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalIssueSecurityLevelImpl_Add(t *testing.T) {
+
+	payloadMocked := &model.IssueSecurityLevelsPayloadScheme{
+		Levels: []*model.IssueSecurityLevelPayloadScheme{
+			{Name: "Managers", Description: "The managers only", Members: []*model.IssueSecurityLevelMemberPayloadScheme{{Type: "projectRole", Parameter: "10002"}}},
+		},
+	}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx      context.Context
+		schemeID string
+		payload  *model.IssueSecurityLevelsPayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:      context.Background(),
+				schemeID: "10000",
+				payload:  payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/3/issuesecurityschemes/10000/level",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:      context.Background(),
+				schemeID: "10000",
+				payload:  payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/2/issuesecurityschemes/10000/level",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the issue security scheme id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				payload: payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueSecuritySchemeID,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:      context.Background(),
+				schemeID: "10000",
+				payload:  payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/3/issuesecurityschemes/10000/level",
+					"", payloadMocked).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewIssueSecurityLevelService(testCase.fields.c, testCase.fields.version, nil)
+			assert.NoError(t, err)
+
+			gotResponse, err := newService.Add(testCase.args.ctx, testCase.args.schemeID, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalIssueSecurityLevelImpl_Update(t *testing.T) {
+
+	payloadMocked := &model.IssueSecurityLevelUpdatePayloadScheme{Name: "Managers", Description: "The managers only"}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx      context.Context
+		schemeID string
+		levelID  string
+		payload  *model.IssueSecurityLevelUpdatePayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:      context.Background(),
+				schemeID: "10000",
+				levelID:  "10021",
+				payload:  payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/3/issuesecurityschemes/10000/level/10021",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:      context.Background(),
+				schemeID: "10000",
+				levelID:  "10021",
+				payload:  payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/2/issuesecurityschemes/10000/level/10021",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the issue security scheme id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				levelID: "10021",
+				payload: payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueSecuritySchemeID,
+		},
+
+		{
+			name:   "when the issue security level id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:      context.Background(),
+				schemeID: "10000",
+				payload:  payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueSecurityLevelID,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:      context.Background(),
+				schemeID: "10000",
+				levelID:  "10021",
+				payload:  payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/3/issuesecurityschemes/10000/level/10021",
+					"", payloadMocked).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewIssueSecurityLevelService(testCase.fields.c, testCase.fields.version, nil)
+			assert.NoError(t, err)
+
+			gotResponse, err := newService.Update(testCase.args.ctx, testCase.args.schemeID, testCase.args.levelID, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalIssueSecurityLevelImpl_Delete(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx         context.Context
+		schemeID    string
+		levelID     string
+		replaceWith string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				schemeID:    "10000",
+				levelID:     "10021",
+				replaceWith: "10022",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"rest/api/3/issuesecurityschemes/10000/level/10021?replaceWith=10022",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.TaskScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:         context.Background(),
+				schemeID:    "10000",
+				levelID:     "10021",
+				replaceWith: "10022",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"rest/api/2/issuesecurityschemes/10000/level/10021?replaceWith=10022",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.TaskScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the issue security scheme id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				levelID:     "10021",
+				replaceWith: "10022",
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueSecuritySchemeID,
+		},
+
+		{
+			name:   "when the issue security level id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				schemeID:    "10000",
+				replaceWith: "10022",
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueSecurityLevelID,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				schemeID:    "10000",
+				levelID:     "10021",
+				replaceWith: "10022",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"rest/api/3/issuesecurityschemes/10000/level/10021?replaceWith=10022",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewIssueSecurityLevelService(testCase.fields.c, testCase.fields.version, nil)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := newService.Delete(testCase.args.ctx, testCase.args.schemeID, testCase.args.levelID, testCase.args.replaceWith)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalIssueSecurityLevelImpl_SetDefault(t *testing.T) {
+
+	payloadMocked := &model.IssueSecurityLevelDefaultsPayloadScheme{
+		DefaultValues: []*model.IssueSecurityLevelDefaultScheme{{IssueSecuritySchemeID: "10000", DefaultLevelID: "10021"}},
+	}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx     context.Context
+		payload *model.IssueSecurityLevelDefaultsPayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				payload: payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/3/issuesecurityschemes/level/default",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:     context.Background(),
+				payload: payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/2/issuesecurityschemes/level/default",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				payload: payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/3/issuesecurityschemes/level/default",
+					"", payloadMocked).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewIssueSecurityLevelService(testCase.fields.c, testCase.fields.version, nil)
+			assert.NoError(t, err)
+
+			gotResponse, err := newService.SetDefault(testCase.args.ctx, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// NewIssueSecurityLevelMemberService creates a new instance of IssueSecurityLevelMemberService.
+func NewIssueSecurityLevelMemberService(client service.Connector, version string) (*IssueSecurityLevelMemberService, error) {
+
+	if version == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoVersionProvided)
+	}
+
+	return &IssueSecurityLevelMemberService{
+		internalClient: &internalIssueSecurityLevelMemberImpl{c: client, version: version},
+	}, nil
+}
+
+// IssueSecurityLevelMemberService provides methods to manage the members of the security levels,
+// the users, groups or project roles allowed to see the issues with the levels.
+type IssueSecurityLevelMemberService struct {
+	// internalClient is the connector interface for security level member operations.
+	internalClient jira.IssueSecurityLevelMemberConnector
+}
+
+// Gets returns a paginated list of the members of the security levels matching the options.
+//
+// GET /rest/api/{2-3}/issuesecurityschemes/level/member
+func (i *IssueSecurityLevelMemberService) Gets(ctx context.Context, options *model.IssueSecurityLevelMemberSearchOptions, startAt, maxResults int) (*model.IssueSecurityLevelMemberPageScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Gets(ctx, options, startAt, maxResults)
+}
+
+// Add adds members, e.g. users, groups or project roles, to a security level.
+//
+// PUT /rest/api/{2-3}/issuesecurityschemes/{schemeID}/level/{levelID}/member
+func (i *IssueSecurityLevelMemberService) Add(ctx context.Context, schemeID, levelID string, payload *model.IssueSecurityLevelMembersPayloadScheme) (*model.ResponseScheme, error) {
+	return i.internalClient.Add(ctx, schemeID, levelID, payload)
+}
+
+// Remove removes a member from a security level.
+//
+// DELETE /rest/api/{2-3}/issuesecurityschemes/{schemeID}/level/{levelID}/member/{memberID}
+func (i *IssueSecurityLevelMemberService) Remove(ctx context.Context, schemeID, levelID, memberID string) (*model.ResponseScheme, error) {
+	return i.internalClient.Remove(ctx, schemeID, levelID, memberID)
+}
+
+type internalIssueSecurityLevelMemberImpl struct {
+	c       service.Connector
+	version string
+}
+
+func (i *internalIssueSecurityLevelMemberImpl) Gets(ctx context.Context, options *model.IssueSecurityLevelMemberSearchOptions, startAt, maxResults int) (*model.IssueSecurityLevelMemberPageScheme, *model.ResponseScheme, error) {
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	if options != nil {
+
+		for _, id := range options.IDs {
+			params.Add("id", id)
+		}
+
+		for _, id := range options.SchemeIDs {
+			params.Add("schemeId", id)
+		}
+
+		for _, id := range options.LevelIDs {
+			params.Add("levelId", id)
+		}
+
+		if len(options.Expand) != 0 {
+			params.Add("expand", strings.Join(options.Expand, ","))
+		}
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuesecurityschemes/level/member?%v", i.version, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.IssueSecurityLevelMemberPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalIssueSecurityLevelMemberImpl) Add(ctx context.Context, schemeID, levelID string, payload *model.IssueSecurityLevelMembersPayloadScheme) (*model.ResponseScheme, error) {
+
+	if schemeID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoIssueSecuritySchemeID)
+	}
+
+	if levelID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoIssueSecurityLevelID)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuesecurityschemes/%v/level/%v/member", i.version, schemeID, levelID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalIssueSecurityLevelMemberImpl) Remove(ctx context.Context, schemeID, levelID, memberID string) (*model.ResponseScheme, error) {
+
+	if schemeID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoIssueSecuritySchemeID)
+	}
+
+	if levelID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoIssueSecurityLevelID)
+	}
+
+	if memberID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoMemberID)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuesecurityschemes/%v/level/%v/member/%v", i.version, schemeID, levelID, memberID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
@@ -0,0 +1,491 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func Test_internalIssueSecurityLevelMemberImpl_Gets(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx        context.Context
+		options    *model.IssueSecurityLevelMemberSearchOptions
+		startAt    int
+		maxResults int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				options:    &model.IssueSecurityLevelMemberSearchOptions{SchemeIDs: []string{"10000"}, LevelIDs: []string{"10021"}, Expand: []string{"user", "group"}},
+				startAt:    0,
+				maxResults: 50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issuesecurityschemes/level/member?expand=user%2Cgroup&levelId=10021&maxResults=50&schemeId=10000&startAt=0",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueSecurityLevelMemberPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:        context.Background(),
+				options:    &model.IssueSecurityLevelMemberSearchOptions{SchemeIDs: []string{"10000"}, LevelIDs: []string{"10021"}, Expand: []string{"user", "group"}},
+				startAt:    0,
+				maxResults: 50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issuesecurityschemes/level/member?expand=user%2Cgroup&levelId=10021&maxResults=50&schemeId=10000&startAt=0",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueSecurityLevelMemberPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				options:    &model.IssueSecurityLevelMemberSearchOptions{SchemeIDs: []string{"10000"}, LevelIDs: []string{"10021"}, Expand: []string{"user", "group"}},
+				startAt:    0,
+				maxResults: 50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issuesecurityschemes/level/member?expand=user%2Cgroup&levelId=10021&maxResults=50&schemeId=10000&startAt=0",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewIssueSecurityLevelMemberService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := newService.Gets(testCase.args.ctx, testCase.args.options, testCase.args.startAt, testCase.args.maxResults)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalIssueSecurityLevelMemberImpl_Add(t *testing.T) {
+
+	payloadMocked := &model.IssueSecurityLevelMembersPayloadScheme{
+		Members: []*model.IssueSecurityLevelMemberPayloadScheme{
+			{Type: "user", Parameter: "5b10a2844c20165700ede21g"},
+			{Type: "group", Parameter: "security"},
+			{Type: "projectRole", Parameter: "10002"},
+		},
+	}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx      context.Context
+		schemeID string
+		levelID  string
+		payload  *model.IssueSecurityLevelMembersPayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:      context.Background(),
+				schemeID: "10000",
+				levelID:  "10021",
+				payload:  payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/3/issuesecurityschemes/10000/level/10021/member",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:      context.Background(),
+				schemeID: "10000",
+				levelID:  "10021",
+				payload:  payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/2/issuesecurityschemes/10000/level/10021/member",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the issue security scheme id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				levelID: "10021",
+				payload: payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueSecuritySchemeID,
+		},
+
+		{
+			name:   "when the issue security level id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:      context.Background(),
+				schemeID: "10000",
+				payload:  payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueSecurityLevelID,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:      context.Background(),
+				schemeID: "10000",
+				levelID:  "10021",
+				payload:  payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/3/issuesecurityschemes/10000/level/10021/member",
+					"", payloadMocked).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewIssueSecurityLevelMemberService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResponse, err := newService.Add(testCase.args.ctx, testCase.args.schemeID, testCase.args.levelID, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalIssueSecurityLevelMemberImpl_Remove(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx      context.Context
+		schemeID string
+		levelID  string
+		memberID string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:      context.Background(),
+				schemeID: "10000",
+				levelID:  "10021",
+				memberID: "10050",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"rest/api/3/issuesecurityschemes/10000/level/10021/member/10050",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:      context.Background(),
+				schemeID: "10000",
+				levelID:  "10021",
+				memberID: "10050",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"rest/api/2/issuesecurityschemes/10000/level/10021/member/10050",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the issue security scheme id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:      context.Background(),
+				levelID:  "10021",
+				memberID: "10050",
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueSecuritySchemeID,
+		},
+
+		{
+			name:   "when the issue security level id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:      context.Background(),
+				schemeID: "10000",
+				memberID: "10050",
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueSecurityLevelID,
+		},
+
+		{
+			name:   "when the member id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:      context.Background(),
+				schemeID: "10000",
+				levelID:  "10021",
+			},
+			wantErr: true,
+			Err:     model.ErrNoMemberID,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:      context.Background(),
+				schemeID: "10000",
+				levelID:  "10021",
+				memberID: "10050",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"rest/api/3/issuesecurityschemes/10000/level/10021/member/10050",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewIssueSecurityLevelMemberService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResponse, err := newService.Remove(testCase.args.ctx, testCase.args.schemeID, testCase.args.levelID, testCase.args.memberID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
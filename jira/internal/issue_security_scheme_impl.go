@@ -0,0 +1,277 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// NewIssueSecuritySchemeService creates a new instance of IssueSecuritySchemeService.
+func NewIssueSecuritySchemeService(client service.Connector, version string, level *IssueSecurityLevelService) (*IssueSecuritySchemeService, error) {
+
+	if version == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoVersionProvided)
+	}
+
+	return &IssueSecuritySchemeService{
+		internalClient: &internalIssueSecuritySchemeImpl{c: client, version: version},
+		Level:          level,
+	}, nil
+}
+
+// IssueSecuritySchemeService provides methods to manage the issue security schemes and their association with projects.
+type IssueSecuritySchemeService struct {
+	// internalClient is the connector interface for issue security scheme operations.
+	internalClient jira.IssueSecuritySchemeConnector
+	// Level is the service for managing the security levels of the schemes.
+	Level *IssueSecurityLevelService
+}
+
+// Gets returns all the issue security schemes.
+//
+// GET /rest/api/{2-3}/issuesecurityschemes
+func (i *IssueSecuritySchemeService) Gets(ctx context.Context) (*model.IssueSecuritySchemesScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Gets(ctx)
+}
+
+// Get returns an issue security scheme along with its security levels.
+//
+// GET /rest/api/{2-3}/issuesecurityschemes/{schemeID}
+func (i *IssueSecuritySchemeService) Get(ctx context.Context, schemeID string) (*model.IssueSecuritySchemeScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Get(ctx, schemeID)
+}
+
+// Search returns a paginated list of the issue security schemes matching the options, ordered by name.
+//
+// GET /rest/api/{2-3}/issuesecurityschemes/search
+func (i *IssueSecuritySchemeService) Search(ctx context.Context, options *model.IssueSecuritySchemeSearchOptions, startAt, maxResults int) (*model.IssueSecuritySchemeSearchPageScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Search(ctx, options, startAt, maxResults)
+}
+
+// Create creates an issue security scheme, optionally with its security levels and their members.
+//
+// POST /rest/api/{2-3}/issuesecurityschemes
+func (i *IssueSecuritySchemeService) Create(ctx context.Context, payload *model.IssueSecuritySchemePayloadScheme) (*model.IssueSecuritySchemeCreatedScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Create(ctx, payload)
+}
+
+// Update updates the name or the description of an issue security scheme.
+//
+// PUT /rest/api/{2-3}/issuesecurityschemes/{schemeID}
+func (i *IssueSecuritySchemeService) Update(ctx context.Context, schemeID string, payload *model.IssueSecuritySchemeUpdatePayloadScheme) (*model.ResponseScheme, error) {
+	return i.internalClient.Update(ctx, schemeID, payload)
+}
+
+// Delete deletes an issue security scheme, it can't be associated with any project.
+//
+// DELETE /rest/api/{2-3}/issuesecurityschemes/{schemeID}
+func (i *IssueSecuritySchemeService) Delete(ctx context.Context, schemeID string) (*model.ResponseScheme, error) {
+	return i.internalClient.Delete(ctx, schemeID)
+}
+
+// Projects returns a paginated list of the associations of the issue security schemes with projects.
+//
+// GET /rest/api/{2-3}/issuesecurityschemes/project
+func (i *IssueSecuritySchemeService) Projects(ctx context.Context, schemeIDs, projectIDs []string, startAt, maxResults int) (*model.IssueSecuritySchemeProjectPageScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Projects(ctx, schemeIDs, projectIDs, startAt, maxResults)
+}
+
+// Associate associates an issue security scheme with a project, remapping the security levels of its issues.
+//
+// The remapping runs asynchronously, use the ID of the task returned to follow it with the task service,
+// e.g. with its WaitForCompletion method.
+//
+// PUT /rest/api/{2-3}/issuesecurityschemes/project
+func (i *IssueSecuritySchemeService) Associate(ctx context.Context, payload *model.IssueSecuritySchemeAssociatePayloadScheme) (*model.TaskScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Associate(ctx, payload)
+}
+
+type internalIssueSecuritySchemeImpl struct {
+	c       service.Connector
+	version string
+}
+
+func (i *internalIssueSecuritySchemeImpl) Gets(ctx context.Context) (*model.IssueSecuritySchemesScheme, *model.ResponseScheme, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuesecurityschemes", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	schemes := new(model.IssueSecuritySchemesScheme)
+	response, err := i.c.Call(request, schemes)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return schemes, response, nil
+}
+
+func (i *internalIssueSecuritySchemeImpl) Get(ctx context.Context, schemeID string) (*model.IssueSecuritySchemeScheme, *model.ResponseScheme, error) {
+
+	if schemeID == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoIssueSecuritySchemeID)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuesecurityschemes/%v", i.version, schemeID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(model.IssueSecuritySchemeScheme)
+	response, err := i.c.Call(request, scheme)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return scheme, response, nil
+}
+
+func (i *internalIssueSecuritySchemeImpl) Search(ctx context.Context, options *model.IssueSecuritySchemeSearchOptions, startAt, maxResults int) (*model.IssueSecuritySchemeSearchPageScheme, *model.ResponseScheme, error) {
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	if options != nil {
+
+		for _, id := range options.IDs {
+			params.Add("id", id)
+		}
+
+		for _, id := range options.ProjectIDs {
+			params.Add("projectId", id)
+		}
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuesecurityschemes/search?%v", i.version, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.IssueSecuritySchemeSearchPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalIssueSecuritySchemeImpl) Create(ctx context.Context, payload *model.IssueSecuritySchemePayloadScheme) (*model.IssueSecuritySchemeCreatedScheme, *model.ResponseScheme, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuesecurityschemes", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(model.IssueSecuritySchemeCreatedScheme)
+	response, err := i.c.Call(request, scheme)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return scheme, response, nil
+}
+
+func (i *internalIssueSecuritySchemeImpl) Update(ctx context.Context, schemeID string, payload *model.IssueSecuritySchemeUpdatePayloadScheme) (*model.ResponseScheme, error) {
+
+	if schemeID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoIssueSecuritySchemeID)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuesecurityschemes/%v", i.version, schemeID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalIssueSecuritySchemeImpl) Delete(ctx context.Context, schemeID string) (*model.ResponseScheme, error) {
+
+	if schemeID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoIssueSecuritySchemeID)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuesecurityschemes/%v", i.version, schemeID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalIssueSecuritySchemeImpl) Projects(ctx context.Context, schemeIDs, projectIDs []string, startAt, maxResults int) (*model.IssueSecuritySchemeProjectPageScheme, *model.ResponseScheme, error) {
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	for _, id := range schemeIDs {
+		params.Add("issueSecuritySchemeId", id)
+	}
+
+	for _, id := range projectIDs {
+		params.Add("projectId", id)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuesecurityschemes/project?%v", i.version, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.IssueSecuritySchemeProjectPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalIssueSecuritySchemeImpl) Associate(ctx context.Context, payload *model.IssueSecuritySchemeAssociatePayloadScheme) (*model.TaskScheme, *model.ResponseScheme, error) {
+
+	if payload == nil || payload.SchemeID == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoIssueSecuritySchemeID)
+	}
+
+	if payload.ProjectID == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoProjectID)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuesecurityschemes/project", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	task := new(model.TaskScheme)
+	response, err := i.c.Call(request, task)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return task, response, nil
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -11,7 +14,7 @@ import (
 )
 
 // NewPriorityService creates a new instance of PriorityService.
-func NewPriorityService(client service.Connector, version string) (*PriorityService, error) {
+func NewPriorityService(client service.Connector, version string, scheme *PrioritySchemeService) (*PriorityService, error) {
 
 	if version == "" {
 		return nil, fmt.Errorf("jira: %w", model.ErrNoVersionProvided)
@@ -19,6 +22,7 @@ func NewPriorityService(client service.Connector, version string) (*PriorityServ
 
 	return &PriorityService{
 		internalClient: &internalPriorityImpl{c: client, version: version},
+		Scheme:         scheme,
 	}, nil
 }
 
@@ -26,6 +30,8 @@ func NewPriorityService(client service.Connector, version string) (*PriorityServ
 type PriorityService struct {
 	// internalClient is the connector interface for priority operations.
 	internalClient jira.PriorityConnector
+	// Scheme is the service for managing priority schemes.
+	Scheme *PrioritySchemeService
 }
 
 // Gets returns the list of all issue priorities.
@@ -50,6 +56,50 @@ func (p *PriorityService) Get(ctx context.Context, priorityID string) (*model.Pr
 	return p.internalClient.Get(ctx, priorityID)
 }
 
+// Search returns a paginated list of the priorities matching the options, in their display order.
+//
+// GET /rest/api/{2-3}/priority/search
+func (p *PriorityService) Search(ctx context.Context, options *model.PrioritySearchOptions, startAt, maxResults int) (*model.PriorityPageScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Search(ctx, options, startAt, maxResults)
+}
+
+// Create creates a priority, the name and the status color are required.
+//
+// POST /rest/api/{2-3}/priority
+func (p *PriorityService) Create(ctx context.Context, payload *model.PriorityPayloadScheme) (*model.PriorityCreatedScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Create(ctx, payload)
+}
+
+// Update updates a priority, only the attributes set are changed.
+//
+// PUT /rest/api/{2-3}/priority/{priorityID}
+func (p *PriorityService) Update(ctx context.Context, priorityID string, payload *model.PriorityPayloadScheme) (*model.ResponseScheme, error) {
+	return p.internalClient.Update(ctx, priorityID, payload)
+}
+
+// Delete deletes a priority, it can't be used by any priority scheme.
+//
+// The deletion runs asynchronously, use the ID of the task returned to follow it with the task service.
+//
+// DELETE /rest/api/{2-3}/priority/{priorityID}
+func (p *PriorityService) Delete(ctx context.Context, priorityID string) (*model.TaskScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Delete(ctx, priorityID)
+}
+
+// SetDefault sets the default priority, an empty ID unsets it.
+//
+// PUT /rest/api/{2-3}/priority/default
+func (p *PriorityService) SetDefault(ctx context.Context, priorityID string) (*model.ResponseScheme, error) {
+	return p.internalClient.SetDefault(ctx, priorityID)
+}
+
+// Move changes the display order of priorities.
+//
+// PUT /rest/api/{2-3}/priority/move
+func (p *PriorityService) Move(ctx context.Context, payload *model.PriorityMovePayloadScheme) (*model.ResponseScheme, error) {
+	return p.internalClient.Move(ctx, payload)
+}
+
 type internalPriorityImpl struct {
 	c       service.Connector
 	version string
@@ -94,3 +144,138 @@ func (i *internalPriorityImpl) Get(ctx context.Context, priorityID string) (*mod
 
 	return priority, response, nil
 }
+
+func (i *internalPriorityImpl) Search(ctx context.Context, options *model.PrioritySearchOptions, startAt, maxResults int) (*model.PriorityPageScheme, *model.ResponseScheme, error) {
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	if options != nil {
+
+		for _, id := range options.IDs {
+			params.Add("id", id)
+		}
+
+		for _, id := range options.ProjectIDs {
+			params.Add("projectId", id)
+		}
+
+		if options.PriorityName != "" {
+			params.Add("priorityName", options.PriorityName)
+		}
+
+		if options.OnlyDefault {
+			params.Add("onlyDefault", "true")
+		}
+
+		if len(options.Expand) != 0 {
+			params.Add("expand", strings.Join(options.Expand, ","))
+		}
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/priority/search?%v", i.version, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.PriorityPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalPriorityImpl) Create(ctx context.Context, payload *model.PriorityPayloadScheme) (*model.PriorityCreatedScheme, *model.ResponseScheme, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%v/priority", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	priority := new(model.PriorityCreatedScheme)
+	response, err := i.c.Call(request, priority)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return priority, response, nil
+}
+
+func (i *internalPriorityImpl) Update(ctx context.Context, priorityID string, payload *model.PriorityPayloadScheme) (*model.ResponseScheme, error) {
+
+	if priorityID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoPriorityID)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/priority/%v", i.version, priorityID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalPriorityImpl) Delete(ctx context.Context, priorityID string) (*model.TaskScheme, *model.ResponseScheme, error) {
+
+	if priorityID == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoPriorityID)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/priority/%v", i.version, priorityID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	task := new(model.TaskScheme)
+	response, err := i.c.Call(request, task)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return task, response, nil
+}
+
+func (i *internalPriorityImpl) SetDefault(ctx context.Context, priorityID string) (*model.ResponseScheme, error) {
+
+	// A null ID unsets the default priority.
+	payload := map[string]interface{}{"id": nil}
+	if priorityID != "" {
+		payload["id"] = priorityID
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/priority/default", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalPriorityImpl) Move(ctx context.Context, payload *model.PriorityMovePayloadScheme) (*model.ResponseScheme, error) {
+
+	if payload == nil || len(payload.IDs) == 0 {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoPriorityID)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/priority/move", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
@@ -0,0 +1,391 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// NewPrioritySchemeService creates a new instance of PrioritySchemeService.
+func NewPrioritySchemeService(client service.Connector, version string) (*PrioritySchemeService, error) {
+
+	if version == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoVersionProvided)
+	}
+
+	return &PrioritySchemeService{
+		internalClient: &internalPrioritySchemeImpl{c: client, version: version},
+	}, nil
+}
+
+// PrioritySchemeService provides methods to manage the priority schemes and their assignment to projects.
+type PrioritySchemeService struct {
+	// internalClient is the connector interface for priority scheme operations.
+	internalClient jira.PrioritySchemeConnector
+}
+
+// Gets returns a paginated list of the priority schemes matching the options.
+//
+// GET /rest/api/{2-3}/priorityscheme
+func (p *PrioritySchemeService) Gets(ctx context.Context, options *model.PrioritySchemeSearchOptions, startAt, maxResults int) (*model.PrioritySchemePageScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Gets(ctx, options, startAt, maxResults)
+}
+
+// Create creates a priority scheme, optionally assigned to projects.
+//
+// The issues of the projects using priorities missing from the scheme must be remapped,
+// the remapping runs asynchronously, use the ID of the task returned to follow it with the task service.
+//
+// POST /rest/api/{2-3}/priorityscheme
+func (p *PrioritySchemeService) Create(ctx context.Context, payload *model.PrioritySchemePayloadScheme) (*model.PrioritySchemeCreatedScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Create(ctx, payload)
+}
+
+// Update updates a priority scheme, its priorities and its projects.
+//
+// The issues using the priorities removed, or of the projects added, must be remapped,
+// the remapping runs asynchronously, use the ID of the task returned to follow it with the task service.
+//
+// PUT /rest/api/{2-3}/priorityscheme/{schemeID}
+func (p *PrioritySchemeService) Update(ctx context.Context, schemeID string, payload *model.PrioritySchemeUpdatePayloadScheme) (*model.PrioritySchemeUpdatedScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Update(ctx, schemeID, payload)
+}
+
+// Delete deletes a priority scheme, it can't be assigned to any project.
+//
+// DELETE /rest/api/{2-3}/priorityscheme/{schemeID}
+func (p *PrioritySchemeService) Delete(ctx context.Context, schemeID string) (*model.ResponseScheme, error) {
+	return p.internalClient.Delete(ctx, schemeID)
+}
+
+// Priorities returns a paginated list of the priorities of a priority scheme.
+//
+// GET /rest/api/{2-3}/priorityscheme/{schemeID}/priorities
+func (p *PrioritySchemeService) Priorities(ctx context.Context, schemeID string, startAt, maxResults int) (*model.PriorityPageScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Priorities(ctx, schemeID, startAt, maxResults)
+}
+
+// Available returns a paginated list of the priorities which can be added to a priority scheme.
+//
+// The query filters the priorities by name, the excluded priorities are not returned.
+//
+// GET /rest/api/{2-3}/priorityscheme/priorities/available
+func (p *PrioritySchemeService) Available(ctx context.Context, schemeID, query string, exclude []string, startAt, maxResults int) (*model.PriorityPageScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Available(ctx, schemeID, query, exclude, startAt, maxResults)
+}
+
+// Projects returns a paginated list of the projects a priority scheme is assigned to.
+//
+// GET /rest/api/{2-3}/priorityscheme/{schemeID}/projects
+func (p *PrioritySchemeService) Projects(ctx context.Context, schemeID string, startAt, maxResults int) (*model.PrioritySchemeProjectPageScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Projects(ctx, schemeID, startAt, maxResults)
+}
+
+// SuggestedProjects returns a paginated list of the projects a priority scheme can be assigned to.
+//
+// The query filters the projects by name or key, the excluded projects are not returned.
+//
+// Use it to pick the projects passed to Assign.
+//
+// GET /rest/api/{2-3}/priorityscheme/projects/available
+func (p *PrioritySchemeService) SuggestedProjects(ctx context.Context, schemeID, query string, exclude []string, startAt, maxResults int) (*model.PrioritySchemeSuggestedProjectPageScheme, *model.ResponseScheme, error) {
+	return p.internalClient.SuggestedProjects(ctx, schemeID, query, exclude, startAt, maxResults)
+}
+
+// Mappings returns a paginated list of the priorities requiring a mapping to apply changes to a priority scheme,
+// the changes are not applied.
+//
+// Use it before Update or Assign to build the mappings of the priorities removed or of the projects added.
+//
+// POST /rest/api/{2-3}/priorityscheme/mappings
+func (p *PrioritySchemeService) Mappings(ctx context.Context, payload *model.PrioritySchemeMappingsPayloadScheme) (*model.PriorityPageScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Mappings(ctx, payload)
+}
+
+// Assign assigns a priority scheme to projects, remapping the priorities of their issues.
+//
+// The remapping runs asynchronously, use the ID of the task returned to follow it with the task service.
+//
+// PUT /rest/api/{2-3}/priorityscheme/{schemeID}
+func (p *PrioritySchemeService) Assign(ctx context.Context, schemeID string, projectIDs []int, mappings *model.PrioritySchemeMappingsScheme) (*model.PrioritySchemeUpdatedScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Assign(ctx, schemeID, projectIDs, mappings)
+}
+
+type internalPrioritySchemeImpl struct {
+	c       service.Connector
+	version string
+}
+
+func (i *internalPrioritySchemeImpl) Gets(ctx context.Context, options *model.PrioritySchemeSearchOptions, startAt, maxResults int) (*model.PrioritySchemePageScheme, *model.ResponseScheme, error) {
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	if options != nil {
+
+		for _, id := range options.IDs {
+			params.Add("schemeId", id)
+		}
+
+		for _, id := range options.PriorityIDs {
+			params.Add("priorityId", id)
+		}
+
+		if options.SchemeName != "" {
+			params.Add("schemeName", options.SchemeName)
+		}
+
+		if options.OnlyDefault {
+			params.Add("onlyDefault", "true")
+		}
+
+		if options.OrderBy != "" {
+			params.Add("orderBy", options.OrderBy)
+		}
+
+		if len(options.Expand) != 0 {
+			params.Add("expand", strings.Join(options.Expand, ","))
+		}
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/priorityscheme?%v", i.version, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.PrioritySchemePageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalPrioritySchemeImpl) Create(ctx context.Context, payload *model.PrioritySchemePayloadScheme) (*model.PrioritySchemeCreatedScheme, *model.ResponseScheme, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%v/priorityscheme", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(model.PrioritySchemeCreatedScheme)
+	response, err := i.c.Call(request, scheme)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return scheme, response, nil
+}
+
+func (i *internalPrioritySchemeImpl) Update(ctx context.Context, schemeID string, payload *model.PrioritySchemeUpdatePayloadScheme) (*model.PrioritySchemeUpdatedScheme, *model.ResponseScheme, error) {
+
+	if schemeID == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoPrioritySchemeID)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/priorityscheme/%v", i.version, schemeID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(model.PrioritySchemeUpdatedScheme)
+	response, err := i.c.Call(request, scheme)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return scheme, response, nil
+}
+
+func (i *internalPrioritySchemeImpl) Delete(ctx context.Context, schemeID string) (*model.ResponseScheme, error) {
+
+	if schemeID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoPrioritySchemeID)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/priorityscheme/%v", i.version, schemeID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalPrioritySchemeImpl) Priorities(ctx context.Context, schemeID string, startAt, maxResults int) (*model.PriorityPageScheme, *model.ResponseScheme, error) {
+
+	if schemeID == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoPrioritySchemeID)
+	}
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	endpoint := fmt.Sprintf("rest/api/%v/priorityscheme/%v/priorities?%v", i.version, schemeID, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.PriorityPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalPrioritySchemeImpl) Available(ctx context.Context, schemeID, query string, exclude []string, startAt, maxResults int) (*model.PriorityPageScheme, *model.ResponseScheme, error) {
+
+	if schemeID == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoPrioritySchemeID)
+	}
+
+	params := url.Values{}
+	params.Add("schemeId", schemeID)
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	if query != "" {
+		params.Add("query", query)
+	}
+
+	for _, id := range exclude {
+		params.Add("exclude", id)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/priorityscheme/priorities/available?%v", i.version, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.PriorityPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalPrioritySchemeImpl) Projects(ctx context.Context, schemeID string, startAt, maxResults int) (*model.PrioritySchemeProjectPageScheme, *model.ResponseScheme, error) {
+
+	if schemeID == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoPrioritySchemeID)
+	}
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	endpoint := fmt.Sprintf("rest/api/%v/priorityscheme/%v/projects?%v", i.version, schemeID, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.PrioritySchemeProjectPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalPrioritySchemeImpl) SuggestedProjects(ctx context.Context, schemeID, query string, exclude []string, startAt, maxResults int) (*model.PrioritySchemeSuggestedProjectPageScheme, *model.ResponseScheme, error) {
+
+	if schemeID == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoPrioritySchemeID)
+	}
+
+	params := url.Values{}
+	params.Add("schemeId", schemeID)
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	if query != "" {
+		params.Add("query", query)
+	}
+
+	for _, id := range exclude {
+		params.Add("exclude", id)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/priorityscheme/projects/available?%v", i.version, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.PrioritySchemeSuggestedProjectPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalPrioritySchemeImpl) Mappings(ctx context.Context, payload *model.PrioritySchemeMappingsPayloadScheme) (*model.PriorityPageScheme, *model.ResponseScheme, error) {
+
+	if payload == nil || payload.SchemeID == 0 {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoPrioritySchemeID)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/priorityscheme/mappings", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.PriorityPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalPrioritySchemeImpl) Assign(ctx context.Context, schemeID string, projectIDs []int, mappings *model.PrioritySchemeMappingsScheme) (*model.PrioritySchemeUpdatedScheme, *model.ResponseScheme, error) {
+
+	if schemeID == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoPrioritySchemeID)
+	}
+
+	if len(projectIDs) == 0 {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoProjectID)
+	}
+
+	payload := &model.PrioritySchemeUpdatePayloadScheme{
+		Projects: &model.PrioritySchemeChangesScheme{Add: &model.PrioritySchemeIDsScheme{IDs: projectIDs}},
+		Mappings: mappings,
+	}
+
+	return i.Update(ctx, schemeID, payload)
+}
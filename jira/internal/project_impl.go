@@ -3,12 +3,14 @@ package internal
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/jira"
 )
@@ -98,6 +100,23 @@ func (p *ProjectService) Search(ctx context.Context, options *model.ProjectSearc
 	return p.internalClient.Search(ctx, options, startAt, maxResults)
 }
 
+// SearchAll iterates over all the projects matching the search options.
+//
+// The pages are fetched lazily using Search, the iteration stops on the first error or when the context is done.
+//
+// GET /rest/api/{2-3}/project/search
+func (p *ProjectService) SearchAll(ctx context.Context, options *model.ProjectSearchOptionsScheme, opts ...paginate.Option) iter.Seq2[*model.ProjectScheme, error] {
+	return paginate.Offset(ctx, func(ctx context.Context, startAt, maxResults int) (*paginate.Page[*model.ProjectScheme], error) {
+
+		result, _, err := p.Search(ctx, options, startAt, maxResults)
+		if err != nil {
+			return nil, err
+		}
+
+		return &paginate.Page[*model.ProjectScheme]{Items: result.Values, Total: result.Total, IsLast: result.IsLast}, nil
+	}, opts...)
+}
+
 // Get returns the project details for a project.
 //
 // GET /rest/api/{2-3}/project/{projectKeyOrID}
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
 )
@@ -1601,3 +1602,92 @@ func Test_NewProjectService(t *testing.T) {
 		})
 	}
 }
+
+func TestProjectService_SearchAll(t *testing.T) {
+
+	// expectPage mocks the Search request of the page starting at the index.
+	expectPage := func(client *mocks.Connector, startAt string, page *model.ProjectSearchScheme, err error) {
+
+		request := &http.Request{Method: http.MethodGet, Header: http.Header{"Page": []string{startAt}}}
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/project/search?maxResults=2&query=K&startAt="+startAt,
+			"", nil).
+			Return(request, nil).Once()
+
+		client.On("Call",
+			request,
+			&model.ProjectSearchScheme{}).
+			Run(func(args mock.Arguments) {
+				if page != nil {
+					*args.Get(1).(*model.ProjectSearchScheme) = *page
+				}
+			}).
+			Return(&model.ResponseScheme{}, err).Once()
+	}
+
+	options := &model.ProjectSearchOptionsScheme{Query: "K"}
+
+	t.Run("when the projects span several pages", func(t *testing.T) {
+
+		client := mocks.NewConnector(t)
+		expectPage(client, "0", &model.ProjectSearchScheme{Values: []*model.ProjectScheme{{Key: "KP"}, {Key: "KS"}}, Total: 3}, nil)
+		expectPage(client, "2", &model.ProjectSearchScheme{Values: []*model.ProjectScheme{{Key: "KT"}}, Total: 3, IsLast: true}, nil)
+
+		projectService, err := NewProjectService(client, "3", &ProjectChildServices{})
+		assert.NoError(t, err)
+
+		var keys []string
+		for project, err := range projectService.SearchAll(context.Background(), options, paginate.WithPageSize(2)) {
+			assert.NoError(t, err)
+			keys = append(keys, project.Key)
+		}
+
+		assert.Equal(t, []string{"KP", "KS", "KT"}, keys)
+	})
+
+	t.Run("when the iteration stops early", func(t *testing.T) {
+
+		client := mocks.NewConnector(t)
+		expectPage(client, "0", &model.ProjectSearchScheme{Values: []*model.ProjectScheme{{Key: "KP"}, {Key: "KS"}}, Total: 3}, nil)
+
+		projectService, err := NewProjectService(client, "3", &ProjectChildServices{})
+		assert.NoError(t, err)
+
+		var keys []string
+		for project, err := range projectService.SearchAll(context.Background(), options, paginate.WithPageSize(2)) {
+			assert.NoError(t, err)
+			keys = append(keys, project.Key)
+			break
+		}
+
+		assert.Equal(t, []string{"KP"}, keys)
+	})
+
+	t.Run("when a page cannot be fetched", func(t *testing.T) {
+
+		client := mocks.NewConnector(t)
+		expectPage(client, "0", &model.ProjectSearchScheme{Values: []*model.ProjectScheme{{Key: "KP"}, {Key: "KS"}}, Total: 3}, nil)
+		expectPage(client, "2", nil, model.ErrNotFound)
+
+		projectService, err := NewProjectService(client, "3", &ProjectChildServices{})
+		assert.NoError(t, err)
+
+		var keys []string
+		var errs []error
+		for project, err := range projectService.SearchAll(context.Background(), options, paginate.WithPageSize(2)) {
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+
+			keys = append(keys, project.Key)
+		}
+
+		assert.Equal(t, []string{"KP", "KS"}, keys)
+		assert.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], model.ErrNotFound)
+	})
+}
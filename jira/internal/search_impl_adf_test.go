@@ -9,8 +9,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
 )
@@ -914,3 +916,63 @@ func Test_internalSearchADFImpl_BulkFetch(t *testing.T) {
 		})
 	}
 }
+
+func TestSearchADFService_All(t *testing.T) {
+
+	client := mocks.NewConnector(t)
+
+	pages := []*model.IssueSearchJQLScheme{
+		{Issues: []*model.IssueScheme{{Key: "KP-1"}, {Key: "KP-2"}}, NextPageToken: "CAEaAggD"},
+		{Issues: []*model.IssueScheme{{Key: "KP-3"}}},
+	}
+
+	for index, token := range []string{"", "CAEaAggD"} {
+
+		payload := struct {
+			Jql           string   `json:"jql,omitempty"`
+			MaxResults    int      `json:"maxResults,omitempty"`
+			Fields        []string `json:"fields,omitempty"`
+			Expand        string   `json:"expand,omitempty"`
+			NextPageToken string   `json:"nextPageToken,omitempty"`
+		}{
+			Jql:           "project = KP",
+			MaxResults:    2,
+			Fields:        []string{"summary"},
+			NextPageToken: token,
+		}
+
+		request := &http.Request{Method: http.MethodPost, Header: http.Header{"Page": []string{token}}}
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"rest/api/3/search/jql",
+			"", payload).
+			Return(request, nil).Once()
+
+		page := pages[index]
+		client.On("Call",
+			request,
+			&model.IssueSearchJQLScheme{}).
+			Run(func(args mock.Arguments) {
+				*args.Get(1).(*model.IssueSearchJQLScheme) = *page
+			}).
+			Return(&model.ResponseScheme{}, nil).Once()
+	}
+
+	searchService, _, err := NewSearchService(client, "3")
+	assert.NoError(t, err)
+
+	var keys []string
+	var progress []paginate.Progress
+	for issue, err := range searchService.All(context.Background(), "project = KP", []string{"summary"},
+		paginate.WithPageSize(2),
+		paginate.WithProgress(func(p paginate.Progress) { progress = append(progress, p) })) {
+
+		assert.NoError(t, err)
+		keys = append(keys, issue.Key)
+	}
+
+	assert.Equal(t, []string{"KP-1", "KP-2", "KP-3"}, keys)
+	assert.Equal(t, []paginate.Progress{{Page: 1, Fetched: 2}, {Page: 2, Fetched: 3}}, progress)
+}
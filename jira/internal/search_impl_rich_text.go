@@ -3,12 +3,14 @@ package internal
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/jira"
 )
@@ -59,6 +61,24 @@ func (s *SearchRichTextService) SearchJQL(ctx context.Context, jql string, field
 	return s.internalClient.SearchJQL(ctx, jql, fields, expands, maxResults, nextPageToken)
 }
 
+// All iterates over all the issues matching the JQL query.
+//
+// The pages are fetched lazily using SearchJQL and its nextPageToken,
+// the iteration stops on the first error or when the context is done.
+//
+// POST /rest/api/2/search/jql
+func (s *SearchRichTextService) All(ctx context.Context, jql string, fields []string, options ...paginate.Option) iter.Seq2[*model.IssueSchemeV2, error] {
+	return paginate.Token(ctx, func(ctx context.Context, token string, limit int) (*paginate.Page[*model.IssueSchemeV2], error) {
+
+		result, _, err := s.SearchJQL(ctx, jql, fields, nil, limit, token)
+		if err != nil {
+			return nil, err
+		}
+
+		return &paginate.Page[*model.IssueSchemeV2]{Items: result.Issues, Next: result.NextPageToken}, nil
+	}, options...)
+}
+
 // ApproximateCount gets an approximate count of issues matching a JQL query
 //
 // POST /rest/api/2/search/approximate-count
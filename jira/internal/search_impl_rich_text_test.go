@@ -9,8 +9,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
 )
@@ -914,3 +916,103 @@ func Test_internalSearchRichTextImpl_BulkFetch(t *testing.T) {
 		})
 	}
 }
+
+func TestSearchRichTextService_All(t *testing.T) {
+
+	// expectPage mocks the SearchJQL request of the page identified by the token.
+	expectPage := func(client *mocks.Connector, token string, page *model.IssueSearchJQLSchemeV2, err error) {
+
+		payload := struct {
+			Jql           string   `json:"jql,omitempty"`
+			MaxResults    int      `json:"maxResults,omitempty"`
+			Fields        []string `json:"fields,omitempty"`
+			Expand        string   `json:"expand,omitempty"`
+			NextPageToken string   `json:"nextPageToken,omitempty"`
+		}{
+			Jql:           "project = KP",
+			MaxResults:    2,
+			Fields:        []string{"summary"},
+			NextPageToken: token,
+		}
+
+		request := &http.Request{Method: http.MethodPost, Header: http.Header{"Page": []string{token}}}
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"rest/api/2/search/jql",
+			"", payload).
+			Return(request, nil).Once()
+
+		client.On("Call",
+			request,
+			&model.IssueSearchJQLSchemeV2{}).
+			Run(func(args mock.Arguments) {
+				if page != nil {
+					*args.Get(1).(*model.IssueSearchJQLSchemeV2) = *page
+				}
+			}).
+			Return(&model.ResponseScheme{}, err).Once()
+	}
+
+	t.Run("when the issues span several pages", func(t *testing.T) {
+
+		client := mocks.NewConnector(t)
+		expectPage(client, "", &model.IssueSearchJQLSchemeV2{Issues: []*model.IssueSchemeV2{{Key: "KP-1"}, {Key: "KP-2"}}, NextPageToken: "CAEaAggD"}, nil)
+		expectPage(client, "CAEaAggD", &model.IssueSearchJQLSchemeV2{Issues: []*model.IssueSchemeV2{{Key: "KP-3"}}}, nil)
+
+		_, searchService, err := NewSearchService(client, "2")
+		assert.NoError(t, err)
+
+		var keys []string
+		for issue, err := range searchService.All(context.Background(), "project = KP", []string{"summary"}, paginate.WithPageSize(2)) {
+			assert.NoError(t, err)
+			keys = append(keys, issue.Key)
+		}
+
+		assert.Equal(t, []string{"KP-1", "KP-2", "KP-3"}, keys)
+	})
+
+	t.Run("when the iteration stops early", func(t *testing.T) {
+
+		client := mocks.NewConnector(t)
+		expectPage(client, "", &model.IssueSearchJQLSchemeV2{Issues: []*model.IssueSchemeV2{{Key: "KP-1"}, {Key: "KP-2"}}, NextPageToken: "CAEaAggD"}, nil)
+
+		_, searchService, err := NewSearchService(client, "2")
+		assert.NoError(t, err)
+
+		var keys []string
+		for issue, err := range searchService.All(context.Background(), "project = KP", []string{"summary"}, paginate.WithPageSize(2)) {
+			assert.NoError(t, err)
+			keys = append(keys, issue.Key)
+			break
+		}
+
+		assert.Equal(t, []string{"KP-1"}, keys)
+	})
+
+	t.Run("when a page cannot be fetched", func(t *testing.T) {
+
+		client := mocks.NewConnector(t)
+		expectPage(client, "", &model.IssueSearchJQLSchemeV2{Issues: []*model.IssueSchemeV2{{Key: "KP-1"}, {Key: "KP-2"}}, NextPageToken: "CAEaAggD"}, nil)
+		expectPage(client, "CAEaAggD", nil, model.ErrNotFound)
+
+		_, searchService, err := NewSearchService(client, "2")
+		assert.NoError(t, err)
+
+		var keys []string
+		var errs []error
+		for issue, err := range searchService.All(context.Background(), "project = KP", []string{"summary"}, paginate.WithPageSize(2)) {
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+
+			keys = append(keys, issue.Key)
+		}
+
+		assert.Equal(t, []string{"KP-1", "KP-2"}, keys)
+		assert.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], model.ErrNotFound)
+	})
+}
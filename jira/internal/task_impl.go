@@ -4,12 +4,25 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/poll"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/jira"
 )
 
+// defaultTaskPollInterval is the interval WaitForCompletion polls a task at when none is provided.
+const defaultTaskPollInterval = 5 * time.Second
+
+// taskFinishedStatuses are the statuses of the tasks which won't change anymore.
+var taskFinishedStatuses = map[string]bool{
+	"COMPLETE":  true,
+	"FAILED":    true,
+	"CANCELLED": true,
+	"DEAD":      true,
+}
+
 // NewTaskService creates a new instance of TaskService.
 func NewTaskService(client service.Connector, version string) (*TaskService, error) {
 
@@ -43,6 +56,27 @@ func (t *TaskService) Get(ctx context.Context, taskID string) (*model.TaskScheme
 	return t.internalClient.Get(ctx, taskID)
 }
 
+// WaitForCompletion polls the specified task until it is finished and returns it,
+// its status being COMPLETE, FAILED, CANCELLED or DEAD.
+//
+// The task is fetched every poll interval, every 5 seconds when the interval isn't positive.
+// When a request fails or the context is done, it returns the error along with the last fetched task.
+//
+// GET /rest/api/{2-3}/task/{taskID}
+func (t *TaskService) WaitForCompletion(ctx context.Context, taskID string, pollInterval time.Duration) (*model.TaskScheme, error) {
+
+	if pollInterval <= 0 {
+		pollInterval = defaultTaskPollInterval
+	}
+
+	return poll.Until(ctx, pollInterval, func(ctx context.Context) (*model.TaskScheme, error) {
+		task, _, err := t.internalClient.Get(ctx, taskID)
+		return task, err
+	}, func(task *model.TaskScheme) bool {
+		return taskFinishedStatuses[task.Status]
+	})
+}
+
 // Cancel cancels a task.
 //
 // POST /rest/api/{2-3}/task/{taskID}/cancel
@@ -7,8 +7,10 @@ import (
 	"net/http"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -372,3 +374,67 @@ func Test_NewTaskService(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskService_WaitForCompletion(t *testing.T) {
+
+	t.Run("when the task completes", func(t *testing.T) {
+
+		client := mocks.NewConnector(t)
+
+		client.On("NewRequest", context.Background(), http.MethodGet, "rest/api/3/task/uuid-sample", "", nil).
+			Return(&http.Request{}, nil)
+
+		statuses := []string{"ENQUEUED", "RUNNING", "COMPLETE"}
+		client.On("Call", &http.Request{}, &model.TaskScheme{}).
+			Run(func(args mock.Arguments) {
+				task := args.Get(1).(*model.TaskScheme)
+				task.Status, task.Progress = statuses[0], 100/len(statuses)
+				statuses = statuses[1:]
+			}).
+			Return(&model.ResponseScheme{}, nil).
+			Times(3)
+
+		newService, err := NewTaskService(client, "3")
+		assert.NoError(t, err)
+
+		task, err := newService.WaitForCompletion(context.Background(), "uuid-sample", time.Millisecond)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "COMPLETE", task.Status)
+	})
+
+	t.Run("when the context is done", func(t *testing.T) {
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		client := mocks.NewConnector(t)
+
+		client.On("NewRequest", ctx, http.MethodGet, "rest/api/3/task/uuid-sample", "", nil).
+			Return(&http.Request{}, nil)
+
+		client.On("Call", &http.Request{}, &model.TaskScheme{}).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.TaskScheme).Status = "RUNNING"
+				cancel()
+			}).
+			Return(&model.ResponseScheme{}, nil).
+			Once()
+
+		newService, err := NewTaskService(client, "3")
+		assert.NoError(t, err)
+
+		task, err := newService.WaitForCompletion(ctx, "uuid-sample", time.Hour)
+
+		assert.True(t, errors.Is(err, context.Canceled))
+		assert.Equal(t, "RUNNING", task.Status)
+	})
+
+	t.Run("when the task id is not provided", func(t *testing.T) {
+
+		newService, err := NewTaskService(mocks.NewConnector(t), "3")
+		assert.NoError(t, err)
+
+		_, err = newService.WaitForCompletion(context.Background(), "", 0)
+		assert.True(t, errors.Is(err, model.ErrNoTaskID))
+	})
+}
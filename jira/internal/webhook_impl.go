@@ -0,0 +1,210 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/paginate"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// NewWebhookService creates a new instance of WebhookService.
+func NewWebhookService(client service.Connector, version string) (*WebhookService, error) {
+
+	if version == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoVersionProvided)
+	}
+
+	return &WebhookService{
+		internalClient: &internalWebhookImpl{c: client, version: version},
+	}, nil
+}
+
+// WebhookService provides methods to manage the dynamic webhooks registered by a Connect or an OAuth 2.0 app.
+type WebhookService struct {
+	// internalClient is the connector interface for webhook operations.
+	internalClient jira.WebhookConnector
+}
+
+// Gets returns a paginated list of the webhooks registered by the calling app.
+//
+// GET /rest/api/{2-3}/webhook
+func (w *WebhookService) Gets(ctx context.Context, startAt, maxResults int) (*model.WebhookPageScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Gets(ctx, startAt, maxResults)
+}
+
+// GetsAll iterates over all the webhooks registered by the calling app.
+//
+// The pages are fetched lazily using Gets, the iteration stops on the first error or when the context is done.
+//
+// GET /rest/api/{2-3}/webhook
+func (w *WebhookService) GetsAll(ctx context.Context, opts ...paginate.Option) iter.Seq2[*model.WebhookScheme, error] {
+	return paginate.Offset(ctx, func(ctx context.Context, startAt, maxResults int) (*paginate.Page[*model.WebhookScheme], error) {
+
+		result, _, err := w.Gets(ctx, startAt, maxResults)
+		if err != nil {
+			return nil, err
+		}
+
+		return &paginate.Page[*model.WebhookScheme]{Items: result.Values, Total: result.Total, IsLast: result.IsLast}, nil
+	}, opts...)
+}
+
+// Register registers webhooks sending their deliveries to the URL.
+//
+// The webhooks are registered independently, the result of each one is returned in the payload order.
+//
+// POST /rest/api/{2-3}/webhook
+func (w *WebhookService) Register(ctx context.Context, payload *model.WebhookRegistrationPayloadScheme) (*model.WebhookRegistrationResultScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Register(ctx, payload)
+}
+
+// Delete removes the webhooks registered by the calling app, the IDs of the webhooks of other apps are ignored.
+//
+// DELETE /rest/api/{2-3}/webhook
+func (w *WebhookService) Delete(ctx context.Context, webhookIDs []int) (*model.ResponseScheme, error) {
+	return w.internalClient.Delete(ctx, webhookIDs)
+}
+
+// Refresh extends the life of the webhooks by 30 days, the IDs of the webhooks of other apps are ignored.
+//
+// PUT /rest/api/{2-3}/webhook/refresh
+func (w *WebhookService) Refresh(ctx context.Context, webhookIDs []int) (*model.WebhookRefreshScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Refresh(ctx, webhookIDs)
+}
+
+// Failed returns the deliveries of the webhooks of the calling app which failed in the last 72 hours, the oldest first.
+//
+// The after parameter, in milliseconds since the epoch, skips the failures that occurred before, it's ignored when zero.
+//
+// GET /rest/api/{2-3}/webhook/failed
+func (w *WebhookService) Failed(ctx context.Context, maxResults int, after int64) (*model.FailedWebhookPageScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Failed(ctx, maxResults, after)
+}
+
+type internalWebhookImpl struct {
+	c       service.Connector
+	version string
+}
+
+func (i *internalWebhookImpl) Gets(ctx context.Context, startAt, maxResults int) (*model.WebhookPageScheme, *model.ResponseScheme, error) {
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	endpoint := fmt.Sprintf("rest/api/%v/webhook?%v", i.version, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.WebhookPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalWebhookImpl) Register(ctx context.Context, payload *model.WebhookRegistrationPayloadScheme) (*model.WebhookRegistrationResultScheme, *model.ResponseScheme, error) {
+
+	if payload == nil || payload.URL == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoWebhookURL)
+	}
+
+	if len(payload.Webhooks) == 0 {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoWebhooks)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/webhook", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(model.WebhookRegistrationResultScheme)
+	response, err := i.c.Call(request, result)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return result, response, nil
+}
+
+func (i *internalWebhookImpl) Delete(ctx context.Context, webhookIDs []int) (*model.ResponseScheme, error) {
+
+	if len(webhookIDs) == 0 {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoWebhookID)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/webhook", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", &model.WebhookIDsPayloadScheme{WebhookIDs: webhookIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalWebhookImpl) Refresh(ctx context.Context, webhookIDs []int) (*model.WebhookRefreshScheme, *model.ResponseScheme, error) {
+
+	if len(webhookIDs) == 0 {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoWebhookID)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/webhook/refresh", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", &model.WebhookIDsPayloadScheme{WebhookIDs: webhookIDs})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	refresh := new(model.WebhookRefreshScheme)
+	response, err := i.c.Call(request, refresh)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return refresh, response, nil
+}
+
+func (i *internalWebhookImpl) Failed(ctx context.Context, maxResults int, after int64) (*model.FailedWebhookPageScheme, *model.ResponseScheme, error) {
+
+	params := url.Values{}
+	if maxResults > 0 {
+		params.Add("maxResults", strconv.Itoa(maxResults))
+	}
+
+	if after > 0 {
+		params.Add("after", strconv.FormatInt(after, 10))
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/webhook/failed", i.version)
+	if len(params) != 0 {
+		endpoint = fmt.Sprintf("%v?%v", endpoint, params.Encode())
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.FailedWebhookPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
@@ -6,13 +6,23 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strings"
 
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/ctreminiom/go-atlassian/v2/jira/internal"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/cache"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/logging"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/middleware"
 	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/oauth2"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/ratelimit"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/retry"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/telemetry"
 	"github.com/ctreminiom/go-atlassian/v2/service/common"
 )
 
@@ -116,6 +126,102 @@ func WithTokenCallback(callback oauth2.TokenCallback) ClientOption {
 	}
 }
 
+// WithRetryPolicy configures the client to retry the requests that failed with a transient error,
+// such as a rate limit (429) or a service unavailable (503) response.
+// Use retry.DefaultPolicy() for the recommended defaults.
+func WithRetryPolicy(policy *retry.Policy) ClientOption {
+	return func(c *Client) error {
+		if err := policy.Validate(); err != nil {
+			return err
+		}
+
+		c.retryPolicy = policy
+		return nil
+	}
+}
+
+// WithRateLimiter configures the client to throttle its requests using the provided limiter.
+// The same limiter can be shared by several clients, even across products, targeting the same site.
+// Use ratelimit.New to create a token bucket limiter.
+func WithRateLimiter(limiter ratelimit.Limiter) ClientOption {
+	return func(c *Client) error {
+		if limiter == nil {
+			return fmt.Errorf("rate limiter cannot be nil")
+		}
+
+		c.limiter = limiter
+		return nil
+	}
+}
+
+// WithTracerProvider configures the client to create a span for every request using the provided tracer provider.
+// The spans are named after the method and the templated route, e.g. "GET /rest/api/3/issue/{issueIdOrKey}".
+func WithTracerProvider(provider trace.TracerProvider) ClientOption {
+	return func(c *Client) error {
+		if c.telemetry == nil {
+			c.telemetry = telemetry.New("jira")
+		}
+
+		return c.telemetry.SetTracerProvider(provider)
+	}
+}
+
+// WithMeterProvider configures the client to record the request latency histogram
+// and the error counter using the provided meter provider.
+func WithMeterProvider(provider metric.MeterProvider) ClientOption {
+	return func(c *Client) error {
+		if c.telemetry == nil {
+			c.telemetry = telemetry.New("jira")
+		}
+
+		return c.telemetry.SetMeterProvider(provider)
+	}
+}
+
+// WithMiddleware configures the client to send its requests through the provided middlewares,
+// the first middleware being the outermost one. It can be used several times, the middlewares are appended.
+// The middlewares can read the logical operation name of a request using middleware.OperationFromContext.
+func WithMiddleware(middlewares ...middleware.Middleware) ClientOption {
+	return func(c *Client) error {
+		for _, m := range middlewares {
+			if m == nil {
+				return fmt.Errorf("middleware cannot be nil")
+			}
+		}
+
+		c.middlewares = append(c.middlewares, middlewares...)
+		return nil
+	}
+}
+
+// WithLogger configures the client to log its requests using the provided logger.
+// The requests are logged at the debug level and the error responses, with their body, at the warn level.
+// The credentials and the SCIM user personal data are redacted.
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(c *Client) error {
+		if logger == nil {
+			return fmt.Errorf("logger cannot be nil")
+		}
+
+		c.logger = logging.New(logger, "jira")
+		return nil
+	}
+}
+
+// WithCache configures the client to cache the responses of its GET requests using the provided cache,
+// e.g. cache.NewLRU. Only the routes having a TTL are cached, see cache.WithTTL and cache.WithDefaultTTL.
+// The writes sent by the client invalidate the cached responses of the same resource family.
+func WithCache(store cache.Cache, options ...cache.Option) ClientOption {
+	return func(c *Client) error {
+		if store == nil {
+			return fmt.Errorf("cache cannot be nil")
+		}
+
+		c.cache = cache.NewLayer(store, options...)
+		return nil
+	}
+}
+
 // New creates a new Jira API client.
 // If a nil httpClient is provided, http.DefaultClient will be used.
 // If the site is empty, an error will be returned.
@@ -251,7 +357,12 @@ func New(httpClient common.HTTPClient, site string, options ...ClientOption) (*C
 		return nil, err
 	}
 
-	priority, err := internal.NewPriorityService(client, APIVersion)
+	priorityScheme, err := internal.NewPrioritySchemeService(client, APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	priority, err := internal.NewPriorityService(client, APIVersion, priorityScheme)
 	if err != nil {
 		return nil, err
 	}
@@ -301,6 +412,11 @@ func New(httpClient common.HTTPClient, site string, options ...ClientOption) (*C
 		return nil, err
 	}
 
+	changelog, err := internal.NewIssueChangelogService(client, APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
 	issueServices := &internal.IssueServices{
 		Attachment: issueAttachmentService,
 		CommentADF: commentService,
@@ -316,6 +432,7 @@ func New(httpClient common.HTTPClient, site string, options ...ClientOption) (*C
 		Watcher:    watcher,
 		WorklogAdf: worklog,
 		Property:   issueProperty,
+		Changelog:  changelog,
 	}
 
 	mySelf, err := internal.NewMySelfService(client, APIVersion)
@@ -475,6 +592,31 @@ func New(httpClient common.HTTPClient, site string, options ...ClientOption) (*C
 		return nil, err
 	}
 
+	webhook, err := internal.NewWebhookService(client, APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	expression, err := internal.NewExpressionService(client, APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	issueSecurityLevelMember, err := internal.NewIssueSecurityLevelMemberService(client, APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	issueSecurityLevel, err := internal.NewIssueSecurityLevelService(client, APIVersion, issueSecurityLevelMember)
+	if err != nil {
+		return nil, err
+	}
+
+	issueSecurityScheme, err := internal.NewIssueSecuritySchemeService(client, APIVersion, issueSecurityLevel)
+	if err != nil {
+		return nil, err
+	}
+
 	client.Audit = auditRecord
 	client.Permission = permission
 	client.MySelf = mySelf
@@ -493,6 +635,9 @@ func New(httpClient common.HTTPClient, site string, options ...ClientOption) (*C
 	client.User = user
 	client.Workflow = workflow
 	client.JQL = jql
+	client.Webhook = webhook
+	client.Expression = expression
+	client.IssueSecurityScheme = issueSecurityScheme
 	client.NotificationScheme = projectNotificationScheme
 	client.Team = internal.NewTeamService(client)
 
@@ -509,31 +654,41 @@ func New(httpClient common.HTTPClient, site string, options ...ClientOption) (*C
 }
 
 type Client struct {
-	HTTP               common.HTTPClient
-	Auth               common.Authentication
-	OAuth              common.OAuth2Service
-	Site               *url.URL
-	Audit              *internal.AuditRecordService
-	Role               *internal.ApplicationRoleService
-	Banner             *internal.AnnouncementBannerService
-	Dashboard          *internal.DashboardService
-	Filter             *internal.FilterService
-	Group              *internal.GroupService
-	GroupUserPicker    *internal.GroupUserPickerService
-	Issue              *internal.IssueADFService
-	MySelf             *internal.MySelfService
-	Permission         *internal.PermissionService
-	Project            *internal.ProjectService
-	Screen             *internal.ScreenService
-	Task               *internal.TaskService
-	Server             *internal.ServerService
-	User               *internal.UserService
-	Workflow           *internal.WorkflowService
-	JQL                *internal.JQLService
-	NotificationScheme *internal.NotificationSchemeService
-	Team               *internal.TeamService
+	HTTP                common.HTTPClient
+	Auth                common.Authentication
+	OAuth               common.OAuth2Service
+	Site                *url.URL
+	Audit               *internal.AuditRecordService
+	Role                *internal.ApplicationRoleService
+	Banner              *internal.AnnouncementBannerService
+	Dashboard           *internal.DashboardService
+	Filter              *internal.FilterService
+	Group               *internal.GroupService
+	GroupUserPicker     *internal.GroupUserPickerService
+	Issue               *internal.IssueADFService
+	MySelf              *internal.MySelfService
+	Permission          *internal.PermissionService
+	Project             *internal.ProjectService
+	Screen              *internal.ScreenService
+	Task                *internal.TaskService
+	Server              *internal.ServerService
+	User                *internal.UserService
+	Workflow            *internal.WorkflowService
+	JQL                 *internal.JQLService
+	Webhook             *internal.WebhookService
+	Expression          *internal.ExpressionService
+	IssueSecurityScheme *internal.IssueSecuritySchemeService
+	NotificationScheme  *internal.NotificationSchemeService
+	Team                *internal.TeamService
 
 	Archival *internal.IssueArchivalService
+
+	retryPolicy *retry.Policy
+	limiter     ratelimit.Limiter
+	telemetry   *telemetry.Instrumentation
+	middlewares []middleware.Middleware
+	logger      *logging.Logger
+	cache       *cache.Layer
 }
 
 // NewRequest creates an API request.
@@ -593,16 +748,42 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr, contentType str
 
 func (c *Client) Call(request *http.Request, structure interface{}) (*models.ResponseScheme, error) {
 
-	response, err := c.HTTP.Do(request)
+	response, retries, err := c.send(request)
 	if err != nil {
 		return nil, err
 	}
 
-	return c.processResponse(response, structure)
+	res, err := c.processResponse(response, structure)
+	res.Retries = retries
+
+	return res, err
 }
 
 func (c *Client) Do(request *http.Request) (*http.Response, error) {
-	return c.HTTP.Do(request)
+	response, _, err := c.send(request)
+	return response, err
+}
+
+// send sends the request through the configured middlewares, cache, instrumentation, logger, rate limiter and retry policy.
+// It returns the number of retries performed.
+func (c *Client) send(request *http.Request) (*http.Response, int, error) {
+
+	var retries int
+	core := middleware.DoerFunc(func(request *http.Request) (*http.Response, error) {
+
+		request, call := c.telemetry.Start(request)
+		entry := c.logger.Start(request)
+
+		response, attempts, err := c.retryPolicy.Do(ratelimit.Client(c.HTTP, c.limiter), request)
+		call.End(response, attempts, err)
+		entry.End(response, attempts, err)
+
+		retries = attempts
+		return response, err
+	})
+
+	response, err := middleware.Chain(c.cache.Wrap(core), c.middlewares...).Do(request)
+	return response, retries, err
 }
 
 func (c *Client) processResponse(response *http.Response, structure interface{}) (*models.ResponseScheme, error) {
@@ -627,23 +808,7 @@ func (c *Client) processResponse(response *http.Response, structure interface{})
 
 	if !wasSuccess {
 
-		switch response.StatusCode {
-
-		case http.StatusNotFound:
-			return res, models.ErrNotFound
-
-		case http.StatusUnauthorized:
-			return res, models.ErrUnauthorized
-
-		case http.StatusInternalServerError:
-			return res, models.ErrInternal
-
-		case http.StatusBadRequest:
-			return res, models.ErrBadRequest
-
-		default:
-			return res, models.ErrInvalidStatusCode
-		}
+		return res, models.NewAPIError(response, responseAsBytes)
 	}
 
 	if structure != nil {
@@ -6,15 +6,27 @@ import (
 	"encoding/json"
 	"errors"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 
 	"github.com/ctreminiom/go-atlassian/v2/jira/internal"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/cache"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/middleware"
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/retry"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/telemetry"
 	"github.com/ctreminiom/go-atlassian/v2/service/common"
 	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
 )
@@ -448,6 +460,23 @@ func TestClient_processResponse(t *testing.T) {
 			},
 			wantErr: false,
 		},
+
+		{
+			name:   "when the response status is forbidden",
+			fields: fields{},
+			args: args{
+				response: &http.Response{
+					StatusCode: http.StatusForbidden,
+					Body:       io.NopCloser(strings.NewReader(`{"errorMessages":["You do not have permission to edit issues"],"errors":{}}`)),
+					Request: &http.Request{
+						Method: http.MethodPut,
+						URL:    &url.URL{},
+					},
+				},
+			},
+			wantErr: true,
+			Err:     model.ErrForbidden,
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -475,6 +504,12 @@ func TestClient_processResponse(t *testing.T) {
 				} else {
 					assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
 				}
+
+				var apiErr *model.APIError
+				if errors.As(err, &apiErr) {
+					assert.Equal(t, got.Code, apiErr.StatusCode)
+					assert.Equal(t, got.Bytes.Bytes(), apiErr.Body)
+				}
 			} else {
 				assert.NoError(t, err)
 				assert.NotEqual(t, got, nil)
@@ -570,3 +605,246 @@ func TestNew(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_Call_WithRetryPolicy(t *testing.T) {
+
+	rateLimitedResponse := &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": []string{"0"}},
+		Body:       io.NopCloser(strings.NewReader("")),
+	}
+
+	request, err := http.NewRequest(http.MethodGet, "https://ctreminiom.atlassian.net/rest/api/3/myself", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedResponse := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader("{}")),
+		Request:    request,
+	}
+
+	client := mocks.NewHTTPClient(t)
+
+	client.On("Do", mock.Anything).
+		Return(rateLimitedResponse, nil).Once()
+
+	client.On("Do", mock.Anything).
+		Return(expectedResponse, nil).Once()
+
+	c, err := New(client, "https://ctreminiom.atlassian.net", WithRetryPolicy(retry.DefaultPolicy()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := c.Call(request, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, got.Code)
+	assert.Equal(t, 1, got.Retries)
+
+	_, err = New(client, "https://ctreminiom.atlassian.net", WithRetryPolicy(&retry.Policy{}))
+	assert.True(t, errors.Is(err, model.ErrInvalidRetryPolicy))
+}
+
+func TestClient_Call_WithTelemetry(t *testing.T) {
+
+	request, err := http.NewRequest(http.MethodGet, "https://ctreminiom.atlassian.net/rest/api/3/issue/KP-1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := mocks.NewHTTPClient(t)
+
+	client.On("Do", mock.Anything).
+		Return(&http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			Header:     http.Header{"Retry-After": []string{"0"}},
+			Body:       io.NopCloser(strings.NewReader("")),
+		}, nil).Once()
+
+	client.On("Do", mock.Anything).
+		Return(&http.Response{
+			StatusCode: http.StatusNotFound,
+			Body:       io.NopCloser(strings.NewReader(`{"errorMessages":["Issue does not exist"]}`)),
+			Request:    request,
+		}, nil).Once()
+
+	recorder := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+
+	c, err := New(client, "https://ctreminiom.atlassian.net",
+		WithRetryPolicy(retry.DefaultPolicy()),
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))),
+		WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.Call(request, nil)
+	assert.True(t, errors.Is(err, model.ErrNotFound))
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 1)
+	assert.Equal(t, "GET /rest/api/3/issue/{issueIdOrKey}", spans[0].Name())
+
+	attributes := attribute.NewSet(spans[0].Attributes()...)
+	statusCode, _ := attributes.Value(telemetry.StatusCodeKey)
+	retries, _ := attributes.Value(telemetry.RetriesKey)
+	assert.Equal(t, int64(http.StatusNotFound), statusCode.AsInt64())
+	assert.Equal(t, int64(1), retries.AsInt64())
+
+	var data metricdata.ResourceMetrics
+	assert.NoError(t, reader.Collect(context.Background(), &data))
+
+	counts := make(map[string]int)
+	for _, scope := range data.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			switch points := m.Data.(type) {
+			case metricdata.Histogram[float64]:
+				counts[m.Name] = int(points.DataPoints[0].Count)
+			case metricdata.Sum[int64]:
+				counts[m.Name] = int(points.DataPoints[0].Value)
+			}
+		}
+	}
+
+	assert.Equal(t, map[string]int{telemetry.DurationMetric: 1, telemetry.ErrorsMetric: 1}, counts)
+
+	_, err = New(client, "https://ctreminiom.atlassian.net", WithTracerProvider(nil))
+	assert.Error(t, err)
+}
+
+func TestClient_Call_WithMiddleware(t *testing.T) {
+
+	client := mocks.NewHTTPClient(t)
+
+	var operations []string
+	audit := func(next middleware.Doer) middleware.Doer {
+		return middleware.DoerFunc(func(request *http.Request) (*http.Response, error) {
+			operations = append(operations, middleware.OperationFromContext(request.Context()))
+			return next.Do(request)
+		})
+	}
+
+	c, err := New(client, "https://ctreminiom.atlassian.net",
+		WithMiddleware(audit, middleware.SetHeader("X-Audit-User", "ctreminiom")),
+		WithMiddleware(middleware.DryRun()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	request, err := c.NewRequest(context.Background(), http.MethodGet, "rest/api/3/issue/KP-1", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client.On("Do", mock.MatchedBy(func(request *http.Request) bool {
+		return request.Header.Get("X-Audit-User") == "ctreminiom"
+	})).
+		Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader("{}")),
+			Request:    request,
+		}, nil).Once()
+
+	got, err := c.Call(request, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, got.Code)
+
+	request, err = c.NewRequest(context.Background(), http.MethodDelete, "rest/api/3/issue/KP-1", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.Call(request, nil)
+	assert.True(t, errors.Is(err, model.ErrDryRun))
+
+	assert.Equal(t, []string{"GET /rest/api/3/issue/{issueIdOrKey}", "DELETE /rest/api/3/issue/{issueIdOrKey}"}, operations)
+
+	_, err = New(client, "https://ctreminiom.atlassian.net", WithMiddleware(nil))
+	assert.Error(t, err)
+}
+
+func TestClient_Call_WithLogger(t *testing.T) {
+
+	buf := new(bytes.Buffer)
+	logger := slog.New(slog.NewTextHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	client := mocks.NewHTTPClient(t)
+
+	c, err := New(client, "https://ctreminiom.atlassian.net", WithLogger(logger))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c.Auth.SetBasicAuth("example@atlassian.com", "api-token")
+
+	request, err := c.NewRequest(context.Background(), http.MethodGet, "rest/api/3/issue/KP-1", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client.On("Do", mock.Anything).
+		Return(&http.Response{
+			StatusCode: http.StatusNotFound,
+			Body:       io.NopCloser(strings.NewReader(`{"errorMessages":["Issue does not exist or you do not have permission to see it."]}`)),
+			Request:    request,
+		}, nil).Once()
+
+	_, err = c.Call(request, nil)
+	assert.True(t, errors.Is(err, model.ErrNotFound))
+
+	var apiErr *model.APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, []string{"Issue does not exist or you do not have permission to see it."}, apiErr.Messages)
+
+	assert.Contains(t, buf.String(), "level=WARN")
+	assert.Contains(t, buf.String(), "route=/rest/api/3/issue/{issueIdOrKey}")
+	assert.Contains(t, buf.String(), "Issue does not exist")
+	assert.NotContains(t, buf.String(), "api-token")
+
+	_, err = New(client, "https://ctreminiom.atlassian.net", WithLogger(nil))
+	assert.Error(t, err)
+}
+
+func TestClient_Call_WithCache(t *testing.T) {
+
+	client := mocks.NewHTTPClient(t)
+
+	c, err := New(client, "https://ctreminiom.atlassian.net", WithCache(cache.NewLRU(10), cache.WithTTL("/rest/api/3/field", time.Hour)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c.Auth.SetBasicAuth("mail@example.com", "token")
+
+	client.On("Do", mock.Anything).
+		Return(func(request *http.Request) *http.Response {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`[{"id":"summary","name":"Summary"}]`)),
+				Request:    request,
+			}
+		}, nil).Once()
+
+	// The second call is served from the cache.
+	for range 2 {
+
+		request, err := c.NewRequest(context.Background(), http.MethodGet, "rest/api/3/field", "", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var fields []*model.IssueFieldScheme
+		response, err := c.Call(request, &fields)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.Equal(t, "Summary", fields[0].Name)
+	}
+
+	_, err = New(client, "https://ctreminiom.atlassian.net", WithCache(nil))
+	assert.Error(t, err)
+}
@@ -0,0 +1,82 @@
+package webhook
+
+import (
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// The names of the events sent by Jira Cloud in the webhookEvent attribute of the payload.
+const (
+	EventIssueCreated   = "jira:issue_created"
+	EventIssueUpdated   = "jira:issue_updated"
+	EventIssueDeleted   = "jira:issue_deleted"
+	EventCommentCreated = "comment_created"
+	EventCommentUpdated = "comment_updated"
+	EventCommentDeleted = "comment_deleted"
+	EventSprintCreated  = "sprint_created"
+	EventSprintUpdated  = "sprint_updated"
+	EventSprintDeleted  = "sprint_deleted"
+	EventSprintStarted  = "sprint_started"
+	EventSprintClosed   = "sprint_closed"
+)
+
+// issueEvents are the names of the events decoded as an IssueEvent.
+var issueEvents = []string{
+	EventIssueCreated,
+	EventIssueUpdated,
+	EventIssueDeleted,
+}
+
+// commentEvents are the names of the events decoded as a CommentEvent.
+var commentEvents = []string{
+	EventCommentCreated,
+	EventCommentUpdated,
+	EventCommentDeleted,
+}
+
+// sprintEvents are the names of the events decoded as a SprintEvent.
+var sprintEvents = []string{
+	EventSprintCreated,
+	EventSprintUpdated,
+	EventSprintDeleted,
+	EventSprintStarted,
+	EventSprintClosed,
+}
+
+// Delivery represents a webhook delivery, as received by the handler.
+type Delivery struct {
+	Event      string // The name of the event, from the webhookEvent attribute of the payload.
+	Identifier string // The ID of the delivery, the same for its retries, from the X-Atlassian-Webhook-Identifier header.
+	Retry      int    // The number of the retry, zero for the first attempt, from the X-Atlassian-Webhook-Retry header.
+	Payload    []byte // The raw payload of the delivery.
+}
+
+// IssueEvent represents a jira:issue_created, a jira:issue_updated or a jira:issue_deleted event.
+type IssueEvent struct {
+	Delivery           *Delivery                          `json:"-"`                               // The delivery of the event.
+	Timestamp          int64                              `json:"timestamp,omitempty"`             // The time of the event, in milliseconds since the epoch.
+	WebhookEvent       string                             `json:"webhookEvent,omitempty"`          // The name of the event.
+	IssueEventTypeName string                             `json:"issue_event_type_name,omitempty"` // The type of the update, e.g. "issue_generic" or "issue_assigned".
+	User               *model.UserScheme                  `json:"user,omitempty"`                  // The user who triggered the event.
+	Issue              *model.IssueScheme                 `json:"issue,omitempty"`                 // The issue.
+	Changelog          *model.IssueChangelogHistoryScheme `json:"changelog,omitempty"`             // The fields changed, for the update events.
+	MatchedWebhookIDs  []int                              `json:"matchedWebhookIds,omitempty"`     // The IDs of the dynamic webhooks matching the event.
+}
+
+// CommentEvent represents a comment_created, a comment_updated or a comment_deleted event.
+type CommentEvent struct {
+	Delivery          *Delivery                 `json:"-"`                           // The delivery of the event.
+	Timestamp         int64                     `json:"timestamp,omitempty"`         // The time of the event, in milliseconds since the epoch.
+	WebhookEvent      string                    `json:"webhookEvent,omitempty"`      // The name of the event.
+	Comment           *model.IssueCommentScheme `json:"comment,omitempty"`           // The comment.
+	Issue             *model.IssueScheme        `json:"issue,omitempty"`             // The issue commented, only its main fields are sent.
+	MatchedWebhookIDs []int                     `json:"matchedWebhookIds,omitempty"` // The IDs of the dynamic webhooks matching the event.
+}
+
+// SprintEvent represents a sprint_created, a sprint_updated, a sprint_deleted, a sprint_started or a sprint_closed event.
+type SprintEvent struct {
+	Delivery     *Delivery           `json:"-"`                      // The delivery of the event.
+	Timestamp    int64               `json:"timestamp,omitempty"`    // The time of the event, in milliseconds since the epoch.
+	WebhookEvent string              `json:"webhookEvent,omitempty"` // The name of the event.
+	Sprint       *model.SprintScheme `json:"sprint,omitempty"`       // The sprint.
+	OldValue     *model.SprintScheme `json:"oldValue,omitempty"`     // The sprint before the update, for the sprint_updated events.
+}
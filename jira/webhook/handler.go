@@ -0,0 +1,179 @@
+// Package webhook receives the Jira Cloud webhook deliveries.
+//
+// The Handler is an http.Handler validating the X-Hub-Signature of the deliveries, decoding their payload
+// based on its webhookEvent attribute and dispatching them to the handlers registered for the event.
+//
+//	handler, err := webhook.NewHandler(secret)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//
+//	handler.OnIssue(func(ctx context.Context, event *webhook.IssueEvent) error {
+//		log.Println(event.WebhookEvent, event.Issue.Key)
+//		return nil
+//	}, webhook.EventIssueCreated, webhook.EventIssueUpdated)
+//
+//	handler.OnComment(func(ctx context.Context, event *webhook.CommentEvent) error {
+//		log.Println(event.Issue.Key, event.Comment.ID)
+//		return nil
+//	})
+//
+//	http.Handle("/jira/events", handler)
+//
+// The signature is only sent for the webhooks created by an administrator with a secret, so a Handler
+// created with a secret rejects the other deliveries. The deliveries of the dynamic webhooks registered
+// by the Connect and the OAuth 2.0 apps are authenticated with a JWT in their Authorization header instead,
+// create their Handler with the WithJWTVerification option:
+//
+//	handler, err := webhook.NewHandler("", webhook.WithJWTVerification(clientSecret))
+//
+// The dynamic webhooks registered with the Webhook service of the Jira clients expire after 30 days,
+// use a Refresher to keep them registered.
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	infra "github.com/ctreminiom/go-atlassian/v2/pkg/infra/webhook"
+)
+
+// Option configures a Handler.
+type Option = infra.Option
+
+// WithErrorHandler sets the function called with the errors the handler responds with,
+// to log the invalid deliveries and the failures of the registered handlers.
+func WithErrorHandler(fn func(request *http.Request, err error)) Option {
+	return infra.WithErrorHandler(fn)
+}
+
+// WithoutSignatureVerification disables the validation of the X-Hub-Signature of the deliveries,
+// for the webhooks created without a secret and the dynamic webhooks authenticated with a JWT.
+// Any client reaching the handler can then forge a delivery, only use it behind another authentication.
+func WithoutSignatureVerification() Option {
+	return infra.WithoutSignatureVerification()
+}
+
+// Handler receives the webhook deliveries and dispatches them to the registered handlers.
+//
+// The handlers are called sequentially in their registration order, until one of them returns an error.
+// The handler responds with 204 once the delivery is handled, even when no handler is registered for its event,
+// with 500 when a registered handler fails and with a 4xx status when the delivery is invalid.
+type Handler struct {
+	handler *infra.Handler[*Delivery]
+}
+
+// NewHandler returns a Handler validating the deliveries signed with the secret of an administrator webhook.
+//
+// The secret is required, unless the WithJWTVerification or the WithoutSignatureVerification option is provided.
+func NewHandler(secret string, opts ...Option) (*Handler, error) {
+
+	handler, err := infra.NewHandler("jira", secret, parse, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Handler{handler: handler}, nil
+}
+
+// OnEvent registers a handler receiving the raw deliveries of the events,
+// of every event when no event is provided.
+func (h *Handler) OnEvent(fn func(ctx context.Context, delivery *Delivery) error, events ...string) {
+	h.handler.OnEvent(fn, events...)
+}
+
+// OnIssue registers a handler receiving the issue events,
+// of every issue event when no event is provided.
+func (h *Handler) OnIssue(fn func(ctx context.Context, event *IssueEvent) error, events ...string) {
+	on(h, fn, eventsOrDefault(events, issueEvents))
+}
+
+// OnComment registers a handler receiving the comment events,
+// of every comment event when no event is provided.
+func (h *Handler) OnComment(fn func(ctx context.Context, event *CommentEvent) error, events ...string) {
+	on(h, fn, eventsOrDefault(events, commentEvents))
+}
+
+// OnSprint registers a handler receiving the sprint events,
+// of every sprint event when no event is provided.
+func (h *Handler) OnSprint(fn func(ctx context.Context, event *SprintEvent) error, events ...string) {
+	on(h, fn, eventsOrDefault(events, sprintEvents))
+}
+
+// ServeHTTP validates the delivery of the request and dispatches it to the registered handlers.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.handler.ServeHTTP(w, r)
+}
+
+// Dispatch calls the handlers registered for the event of the delivery,
+// use it to process the deliveries received by another transport, e.g. replayed from a queue.
+func (h *Handler) Dispatch(ctx context.Context, delivery *Delivery) error {
+	return h.handler.Dispatch(ctx, delivery.Event, delivery)
+}
+
+// Signature returns the X-Hub-Signature header value of a payload signed with the secret,
+// use it to test the handlers with signed deliveries.
+func Signature(secret string, payload []byte) string {
+	return infra.Signature(secret, payload)
+}
+
+// parse builds the delivery of a request, the event name is sent in the webhookEvent attribute of the payload.
+func parse(r *http.Request, payload []byte) (*Delivery, string, error) {
+
+	var envelope struct {
+		WebhookEvent string `json:"webhookEvent"`
+	}
+
+	if err := json.Unmarshal(payload, &envelope); err != nil {
+		return nil, "", fmt.Errorf("jira: webhook: %w: %v", model.ErrInvalidWebhookPayload, err)
+	}
+
+	retry, _ := strconv.Atoi(r.Header.Get("X-Atlassian-Webhook-Retry"))
+	delivery := &Delivery{
+		Event:      envelope.WebhookEvent,
+		Identifier: r.Header.Get("X-Atlassian-Webhook-Identifier"),
+		Retry:      retry,
+		Payload:    payload,
+	}
+
+	return delivery, delivery.Event, nil
+}
+
+// event is implemented by the typed events, to attach the delivery they are decoded from.
+type event[T any] interface {
+	*T
+	setDelivery(delivery *Delivery)
+}
+
+// on registers a handler receiving the events decoded as a T.
+func on[T any, P event[T]](h *Handler, fn func(context.Context, P) error, events []string) {
+
+	h.OnEvent(func(ctx context.Context, delivery *Delivery) error {
+
+		payload := P(new(T))
+		if err := json.Unmarshal(delivery.Payload, payload); err != nil {
+			return fmt.Errorf("jira: webhook: %w: %v", model.ErrInvalidWebhookPayload, err)
+		}
+
+		payload.setDelivery(delivery)
+		return fn(ctx, payload)
+	}, events...)
+}
+
+// eventsOrDefault returns the events, the default ones when none is provided.
+func eventsOrDefault(events, defaults []string) []string {
+
+	if len(events) == 0 {
+		return defaults
+	}
+
+	return events
+}
+
+func (e *IssueEvent) setDelivery(delivery *Delivery)   { e.Delivery = delivery }
+func (e *CommentEvent) setDelivery(delivery *Delivery) { e.Delivery = delivery }
+func (e *SprintEvent) setDelivery(delivery *Delivery)  { e.Delivery = delivery }
@@ -0,0 +1,277 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+const (
+	issueUpdatedPayload = `{
+		"timestamp": 1700000000000,
+		"webhookEvent": "jira:issue_updated",
+		"issue_event_type_name": "issue_assigned",
+		"user": {"accountId": "5b10ac8d82e05b22cc7d4ef5", "displayName": "Carlos Treminio"},
+		"issue": {"id": "10002", "key": "KP-2", "fields": {"summary": "Fix the build"}},
+		"changelog": {"id": "10010", "items": [{"field": "assignee", "fieldId": "assignee", "to": "5b10ac8d82e05b22cc7d4ef5"}]},
+		"matchedWebhookIds": [1]
+	}`
+
+	commentCreatedPayload = `{
+		"timestamp": 1700000000000,
+		"webhookEvent": "comment_created",
+		"comment": {"id": "10050", "body": {"type": "doc", "version": 1}, "author": {"accountId": "5b10ac8d82e05b22cc7d4ef5"}},
+		"issue": {"id": "10002", "key": "KP-2"}
+	}`
+
+	sprintStartedPayload = `{
+		"timestamp": 1700000000000,
+		"webhookEvent": "sprint_started",
+		"sprint": {"id": 7, "state": "active", "name": "KP Sprint 7", "startDate": "2023-11-14T22:13:20.000Z", "originBoardId": 3}
+	}`
+)
+
+func newDelivery(t *testing.T, secret, payload string) *http.Request {
+	t.Helper()
+
+	request := httptest.NewRequest(http.MethodPost, "/jira/events", strings.NewReader(payload))
+	request.Header.Set("X-Atlassian-Webhook-Identifier", "9f9a0b1c-2d3e-4f50-8a6b-7c8d9e0f1a2b")
+	request.Header.Set("X-Atlassian-Webhook-Retry", "1")
+
+	if secret != "" {
+		request.Header.Set("X-Hub-Signature", Signature(secret, []byte(payload)))
+	}
+
+	return request
+}
+
+func newHandler(t *testing.T, secret string, opts ...Option) *Handler {
+	t.Helper()
+
+	handler, err := NewHandler(secret, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return handler
+}
+
+func TestNewHandler(t *testing.T) {
+
+	t.Run("when the secret is not provided", func(t *testing.T) {
+
+		handler, err := NewHandler("")
+
+		assert.Nil(t, handler)
+		assert.True(t, errors.Is(err, model.ErrNoWebhookSecret))
+	})
+
+	t.Run("when the signature verification is disabled", func(t *testing.T) {
+
+		handler, err := NewHandler("", WithoutSignatureVerification())
+		assert.NoError(t, err)
+
+		handler.OnIssue(func(ctx context.Context, event *IssueEvent) error { return nil })
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, newDelivery(t, "", issueUpdatedPayload))
+
+		assert.Equal(t, http.StatusNoContent, recorder.Code)
+	})
+}
+
+func TestHandler_ServeHTTP(t *testing.T) {
+
+	t.Run("when the issue event is dispatched", func(t *testing.T) {
+
+		handler := newHandler(t, "s3cr3t")
+
+		var received *IssueEvent
+		handler.OnIssue(func(ctx context.Context, event *IssueEvent) error {
+			received = event
+			return nil
+		})
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, newDelivery(t, "s3cr3t", issueUpdatedPayload))
+
+		assert.Equal(t, http.StatusNoContent, recorder.Code)
+		if assert.NotNil(t, received) {
+			assert.Equal(t, "KP-2", received.Issue.Key)
+			assert.Equal(t, "Fix the build", received.Issue.Fields.Summary)
+			assert.Equal(t, "assignee", received.Changelog.Items[0].FieldID)
+			assert.Equal(t, "issue_assigned", received.IssueEventTypeName)
+			assert.Equal(t, []int{1}, received.MatchedWebhookIDs)
+			assert.Equal(t, EventIssueUpdated, received.Delivery.Event)
+			assert.Equal(t, "9f9a0b1c-2d3e-4f50-8a6b-7c8d9e0f1a2b", received.Delivery.Identifier)
+			assert.Equal(t, 1, received.Delivery.Retry)
+		}
+	})
+
+	t.Run("when the issue events are filtered by name", func(t *testing.T) {
+
+		handler := newHandler(t, "", WithoutSignatureVerification())
+
+		called := false
+		handler.OnIssue(func(ctx context.Context, event *IssueEvent) error {
+			called = true
+			return nil
+		}, EventIssueCreated)
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, newDelivery(t, "", issueUpdatedPayload))
+
+		assert.Equal(t, http.StatusNoContent, recorder.Code)
+		assert.False(t, called)
+	})
+
+	t.Run("when the comment event is dispatched", func(t *testing.T) {
+
+		handler := newHandler(t, "s3cr3t")
+
+		var received *CommentEvent
+		handler.OnComment(func(ctx context.Context, event *CommentEvent) error {
+			received = event
+			return nil
+		})
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, newDelivery(t, "s3cr3t", commentCreatedPayload))
+
+		assert.Equal(t, http.StatusNoContent, recorder.Code)
+		if assert.NotNil(t, received) {
+			assert.Equal(t, "10050", received.Comment.ID)
+			assert.Equal(t, "doc", received.Comment.Body.Type)
+			assert.Equal(t, "KP-2", received.Issue.Key)
+		}
+	})
+
+	t.Run("when the sprint event is dispatched", func(t *testing.T) {
+
+		handler := newHandler(t, "s3cr3t")
+
+		var received *SprintEvent
+		handler.OnSprint(func(ctx context.Context, event *SprintEvent) error {
+			received = event
+			return nil
+		}, EventSprintStarted)
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, newDelivery(t, "s3cr3t", sprintStartedPayload))
+
+		assert.Equal(t, http.StatusNoContent, recorder.Code)
+		if assert.NotNil(t, received) {
+			assert.Equal(t, 7, received.Sprint.ID)
+			assert.Equal(t, "active", received.Sprint.State)
+			assert.Equal(t, 2023, received.Sprint.StartDate.Year())
+		}
+	})
+
+	t.Run("when the raw handlers receive every event", func(t *testing.T) {
+
+		handler := newHandler(t, "", WithoutSignatureVerification())
+
+		var events []string
+		handler.OnEvent(func(ctx context.Context, delivery *Delivery) error {
+			events = append(events, delivery.Event)
+			return nil
+		})
+
+		for _, payload := range []string{issueUpdatedPayload, commentCreatedPayload, `{"webhookEvent": "board_created"}`} {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, newDelivery(t, "", payload))
+			assert.Equal(t, http.StatusNoContent, recorder.Code)
+		}
+
+		assert.Equal(t, []string{EventIssueUpdated, EventCommentCreated, "board_created"}, events)
+	})
+
+	t.Run("when the signature does not match", func(t *testing.T) {
+
+		var reported error
+		handler := newHandler(t, "s3cr3t", WithErrorHandler(func(request *http.Request, err error) {
+			reported = err
+		}))
+
+		handler.OnIssue(func(ctx context.Context, event *IssueEvent) error {
+			t.Error("the handler must not be called")
+			return nil
+		})
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, newDelivery(t, "another-secret", issueUpdatedPayload))
+
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+		assert.True(t, errors.Is(reported, model.ErrInvalidWebhookSignature))
+	})
+
+	t.Run("when the signature is not provided", func(t *testing.T) {
+
+		recorder := httptest.NewRecorder()
+		newHandler(t, "s3cr3t").ServeHTTP(recorder, newDelivery(t, "", issueUpdatedPayload))
+
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	})
+
+	t.Run("when the event name is not provided", func(t *testing.T) {
+
+		var reported error
+		handler := newHandler(t, "", WithoutSignatureVerification(), WithErrorHandler(func(request *http.Request, err error) {
+			reported = err
+		}))
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, newDelivery(t, "", `{"timestamp": 1700000000000}`))
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.True(t, errors.Is(reported, model.ErrNoWebhookEventKey))
+	})
+
+	t.Run("when the payload is not a json object", func(t *testing.T) {
+
+		recorder := httptest.NewRecorder()
+		newHandler(t, "", WithoutSignatureVerification()).ServeHTTP(recorder, newDelivery(t, "", `{"webhookEvent": [`))
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("when the payload cannot be decoded", func(t *testing.T) {
+
+		handler := newHandler(t, "", WithoutSignatureVerification())
+		handler.OnIssue(func(ctx context.Context, event *IssueEvent) error { return nil })
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, newDelivery(t, "", `{"webhookEvent": "jira:issue_created", "issue": "KP-2"}`))
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("when a handler fails", func(t *testing.T) {
+
+		handler := newHandler(t, "s3cr3t")
+		handler.OnIssue(func(ctx context.Context, event *IssueEvent) error {
+			return errors.New("queue unavailable")
+		})
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, newDelivery(t, "s3cr3t", issueUpdatedPayload))
+
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	})
+
+	t.Run("when the method is not allowed", func(t *testing.T) {
+
+		recorder := httptest.NewRecorder()
+		newHandler(t, "s3cr3t").ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/jira/events", nil))
+
+		assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+		assert.Equal(t, http.MethodPost, recorder.Header().Get("Allow"))
+	})
+}
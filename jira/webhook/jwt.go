@@ -0,0 +1,99 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	infra "github.com/ctreminiom/go-atlassian/v2/pkg/infra/webhook"
+)
+
+// jwtClockSkew is the difference tolerated between the clocks of Jira and of the handler when checking the expiry of a JWT.
+const jwtClockSkew = 30 * time.Second
+
+// WithJWTVerification authenticates the deliveries of the dynamic webhooks registered by a Connect or an OAuth 2.0 app,
+// using the JWT of their Authorization header instead of the X-Hub-Signature.
+//
+// The JWT must be signed with the secret using HS256 and not be expired: the secret is the shared secret of the
+// installation for a Connect app, the client secret for an OAuth 2.0 app. Its query string hash isn't checked.
+//
+//	handler, err := webhook.NewHandler("", webhook.WithJWTVerification(clientSecret))
+func WithJWTVerification(secret string) Option {
+	return infra.WithVerifier(func(request *http.Request, _ []byte) error {
+		return verifyJWT(request.Header.Get("Authorization"), []byte(secret), time.Now())
+	})
+}
+
+// verifyJWT checks the JWT of the Authorization header, sent with either the JWT or the Bearer scheme.
+func verifyJWT(authorization string, secret []byte, now time.Time) error {
+
+	if len(secret) == 0 {
+		return fmt.Errorf("jira: webhook: %w", model.ErrNoWebhookSecret)
+	}
+
+	scheme, token, found := strings.Cut(authorization, " ")
+	if !found || (!strings.EqualFold(scheme, "JWT") && !strings.EqualFold(scheme, "Bearer")) {
+		return fmt.Errorf("jira: webhook: %w: no jwt", model.ErrInvalidWebhookSignature)
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return fmt.Errorf("jira: webhook: %w: malformed jwt", model.ErrInvalidWebhookSignature)
+	}
+
+	var header struct {
+		Algorithm string `json:"alg"`
+	}
+
+	if err := decodeJWTSegment(parts[0], &header); err != nil || header.Algorithm != "HS256" {
+		return fmt.Errorf("jira: webhook: %w: unsupported jwt algorithm", model.ErrInvalidWebhookSignature)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return fmt.Errorf("jira: webhook: %w: malformed jwt", model.ErrInvalidWebhookSignature)
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+
+	if !hmac.Equal(mac.Sum(nil), signature) {
+		return fmt.Errorf("jira: webhook: %w", model.ErrInvalidWebhookSignature)
+	}
+
+	var claims struct {
+		ExpiresAt int64 `json:"exp"`
+		NotBefore int64 `json:"nbf"`
+	}
+
+	if err := decodeJWTSegment(parts[1], &claims); err != nil {
+		return fmt.Errorf("jira: webhook: %w: malformed jwt", model.ErrInvalidWebhookSignature)
+	}
+
+	if claims.ExpiresAt == 0 || now.After(time.Unix(claims.ExpiresAt, 0).Add(jwtClockSkew)) {
+		return fmt.Errorf("jira: webhook: %w: expired jwt", model.ErrInvalidWebhookSignature)
+	}
+
+	if claims.NotBefore != 0 && now.Add(jwtClockSkew).Before(time.Unix(claims.NotBefore, 0)) {
+		return fmt.Errorf("jira: webhook: %w: jwt not valid yet", model.ErrInvalidWebhookSignature)
+	}
+
+	return nil
+}
+
+// decodeJWTSegment decodes the base64url encoded JSON segment of a JWT.
+func decodeJWTSegment(segment string, v interface{}) error {
+
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,112 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// newJWT returns a JWT of the header and the claims signed with the secret using HS256.
+func newJWT(header, claims, secret string) string {
+
+	unsigned := base64.RawURLEncoding.EncodeToString([]byte(header)) + "." + base64.RawURLEncoding.EncodeToString([]byte(claims))
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unsigned))
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func Test_verifyJWT(t *testing.T) {
+
+	now := time.Unix(1700000000, 0)
+	header := `{"alg":"HS256","typ":"JWT"}`
+	claims := `{"iss":"5b10ac8d82e05b22cc7d4ef5","iat":1699999900,"exp":1700000180}`
+
+	testCases := []struct {
+		name          string
+		authorization string
+		secret        string
+		wantErr       error
+	}{
+		{name: "when the jwt is sent with the bearer scheme", authorization: "Bearer " + newJWT(header, claims, "client-secret"), secret: "client-secret"},
+		{name: "when the jwt is sent with the jwt scheme", authorization: "JWT " + newJWT(header, claims, "client-secret"), secret: "client-secret"},
+		{name: "when the jwt is signed with another secret", authorization: "Bearer " + newJWT(header, claims, "another-secret"), secret: "client-secret", wantErr: model.ErrInvalidWebhookSignature},
+		{name: "when the jwt is expired", authorization: "Bearer " + newJWT(header, `{"exp":1699999900}`, "client-secret"), secret: "client-secret", wantErr: model.ErrInvalidWebhookSignature},
+		{name: "when the jwt has no expiry", authorization: "Bearer " + newJWT(header, `{"iss":"5b10ac8d82e05b22cc7d4ef5"}`, "client-secret"), secret: "client-secret", wantErr: model.ErrInvalidWebhookSignature},
+		{name: "when the jwt is not valid yet", authorization: "Bearer " + newJWT(header, `{"nbf":1700000600,"exp":1700000900}`, "client-secret"), secret: "client-secret", wantErr: model.ErrInvalidWebhookSignature},
+		{name: "when the jwt is not signed", authorization: "Bearer " + newJWT(`{"alg":"none"}`, claims, "client-secret"), secret: "client-secret", wantErr: model.ErrInvalidWebhookSignature},
+		{name: "when the jwt is malformed", authorization: "Bearer not-a-jwt", secret: "client-secret", wantErr: model.ErrInvalidWebhookSignature},
+		{name: "when the jwt is not provided", secret: "client-secret", wantErr: model.ErrInvalidWebhookSignature},
+		{name: "when the secret is not provided", authorization: "Bearer " + newJWT(header, claims, ""), wantErr: model.ErrNoWebhookSecret},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			err := verifyJWT(testCase.authorization, []byte(testCase.secret), now)
+			if testCase.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.True(t, errors.Is(err, testCase.wantErr), "expected error: %v, got: %v", testCase.wantErr, err)
+		})
+	}
+}
+
+func TestHandler_ServeHTTP_JWT(t *testing.T) {
+
+	claims := `{"iss":"5b10ac8d82e05b22cc7d4ef5","exp":` + strconv.FormatInt(time.Now().Add(time.Minute).Unix(), 10) + `}`
+	token := newJWT(`{"alg":"HS256","typ":"JWT"}`, claims, "client-secret")
+
+	t.Run("when the delivery of a dynamic webhook is authenticated", func(t *testing.T) {
+
+		handler := newHandler(t, "", WithJWTVerification("client-secret"))
+
+		var received *IssueEvent
+		handler.OnIssue(func(ctx context.Context, event *IssueEvent) error {
+			received = event
+			return nil
+		})
+
+		request := newDelivery(t, "", issueUpdatedPayload)
+		request.Header.Set("Authorization", "JWT "+token)
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+
+		assert.Equal(t, http.StatusNoContent, recorder.Code)
+		assert.NotNil(t, received)
+	})
+
+	t.Run("when the delivery of a dynamic webhook has no jwt", func(t *testing.T) {
+
+		recorder := httptest.NewRecorder()
+		newHandler(t, "", WithJWTVerification("client-secret")).ServeHTTP(recorder, newDelivery(t, "", issueUpdatedPayload))
+
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	})
+
+	t.Run("when the delivery of a dynamic webhook reaches a handler with a secret", func(t *testing.T) {
+
+		request := newDelivery(t, "", issueUpdatedPayload)
+		request.Header.Set("Authorization", "JWT "+token)
+
+		recorder := httptest.NewRecorder()
+		newHandler(t, "s3cr3t").ServeHTTP(recorder, request)
+
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	})
+}
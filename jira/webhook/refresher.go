@@ -0,0 +1,157 @@
+package webhook
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// DefaultRefreshInterval is the interval between two refreshes when none is configured,
+// leaving many attempts before the 30-day expiry of the webhooks.
+const DefaultRefreshInterval = 24 * time.Hour
+
+// RefreshClient refreshes dynamic webhooks, it's implemented by the Webhook service of the Jira clients.
+type RefreshClient interface {
+	Refresh(ctx context.Context, webhookIDs []int) (*model.WebhookRefreshScheme, *model.ResponseScheme, error)
+}
+
+// RefresherOption configures a Refresher.
+type RefresherOption func(*Refresher)
+
+// WithRefreshInterval sets the interval between two refreshes.
+func WithRefreshInterval(interval time.Duration) RefresherOption {
+	return func(r *Refresher) {
+		if interval > 0 {
+			r.interval = interval
+		}
+	}
+}
+
+// WithRefreshErrorHandler sets the function called with the errors of the refreshes run in the background,
+// the failed refreshes are retried at the next interval.
+func WithRefreshErrorHandler(fn func(err error)) RefresherOption {
+	return func(r *Refresher) {
+		r.onError = fn
+	}
+}
+
+// Refresher keeps dynamic webhooks registered past their 30-day expiry, refreshing them periodically.
+//
+//	result, _, err := client.Webhook.Register(ctx, payload)
+//	...
+//	refresher := webhook.NewRefresher(client.Webhook, []int{result.WebhookRegistrationResult[0].CreatedWebhookID})
+//	go refresher.Run(ctx)
+type Refresher struct {
+	client   RefreshClient
+	interval time.Duration
+	onError  func(err error)
+
+	mu         sync.Mutex
+	webhookIDs map[int]struct{}
+	expiration time.Time
+}
+
+// NewRefresher returns a Refresher refreshing the webhooks with the client.
+func NewRefresher(client RefreshClient, webhookIDs []int, opts ...RefresherOption) *Refresher {
+
+	r := &Refresher{
+		client:     client,
+		interval:   DefaultRefreshInterval,
+		webhookIDs: make(map[int]struct{}),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	r.Add(webhookIDs...)
+	return r
+}
+
+// Add adds webhooks to the ones refreshed, e.g. after registering new ones.
+func (r *Refresher) Add(webhookIDs ...int) {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, id := range webhookIDs {
+		r.webhookIDs[id] = struct{}{}
+	}
+}
+
+// Remove removes webhooks from the ones refreshed, e.g. after deleting them.
+func (r *Refresher) Remove(webhookIDs ...int) {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, id := range webhookIDs {
+		delete(r.webhookIDs, id)
+	}
+}
+
+// WebhookIDs returns the IDs of the webhooks refreshed, in ascending order.
+func (r *Refresher) WebhookIDs() []int {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return slices.Sorted(maps.Keys(r.webhookIDs))
+}
+
+// Expiration returns the expiration time of the webhooks returned by the last successful refresh,
+// the zero time before the first one.
+func (r *Refresher) Expiration() time.Time {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.expiration
+}
+
+// Refresh refreshes the webhooks now, it does nothing when there is no webhook to refresh.
+func (r *Refresher) Refresh(ctx context.Context) error {
+
+	webhookIDs := r.WebhookIDs()
+	if len(webhookIDs) == 0 {
+		return nil
+	}
+
+	result, _, err := r.client.Refresh(ctx, webhookIDs)
+	if err != nil {
+		return err
+	}
+
+	if result != nil && result.ExpirationDate != nil {
+		r.mu.Lock()
+		r.expiration = time.Time(*result.ExpirationDate)
+		r.mu.Unlock()
+	}
+
+	return nil
+}
+
+// Run refreshes the webhooks immediately, then at every interval until the context is done,
+// it returns the error of the context. The errors of the refreshes are reported to the error handler.
+func (r *Refresher) Run(ctx context.Context) error {
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+
+		if err := r.Refresh(ctx); err != nil && ctx.Err() == nil && r.onError != nil {
+			r.onError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// fakeRefreshClient records the refreshes and fails the ones listed in its errors.
+type fakeRefreshClient struct {
+	mu     sync.Mutex
+	calls  [][]int
+	errors []error
+}
+
+func (f *fakeRefreshClient) Refresh(ctx context.Context, webhookIDs []int) (*model.WebhookRefreshScheme, *model.ResponseScheme, error) {
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls = append(f.calls, webhookIDs)
+	if len(f.errors) != 0 {
+		err := f.errors[0]
+		f.errors = f.errors[1:]
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	expiration := model.WebhookExpirationDateScheme(time.UnixMilli(1702592000000))
+	return &model.WebhookRefreshScheme{ExpirationDate: &expiration}, &model.ResponseScheme{}, nil
+}
+
+func (f *fakeRefreshClient) Calls() [][]int {
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([][]int{}, f.calls...)
+}
+
+func TestRefresher_Refresh(t *testing.T) {
+
+	t.Run("when the webhooks are refreshed", func(t *testing.T) {
+
+		client := &fakeRefreshClient{}
+		refresher := NewRefresher(client, []int{10002, 10000})
+		refresher.Add(10001, 10000)
+		refresher.Remove(10002)
+
+		assert.NoError(t, refresher.Refresh(context.Background()))
+		assert.Equal(t, [][]int{{10000, 10001}}, client.Calls())
+		assert.Equal(t, time.UnixMilli(1702592000000), refresher.Expiration())
+	})
+
+	t.Run("when there is no webhook to refresh", func(t *testing.T) {
+
+		client := &fakeRefreshClient{}
+
+		assert.NoError(t, NewRefresher(client, nil).Refresh(context.Background()))
+		assert.Empty(t, client.Calls())
+	})
+
+	t.Run("when the refresh fails", func(t *testing.T) {
+
+		client := &fakeRefreshClient{errors: []error{model.ErrNoWebhookID}}
+		refresher := NewRefresher(client, []int{10000})
+
+		assert.True(t, errors.Is(refresher.Refresh(context.Background()), model.ErrNoWebhookID))
+		assert.True(t, refresher.Expiration().IsZero())
+	})
+}
+
+func TestRefresher_Run(t *testing.T) {
+
+	client := &fakeRefreshClient{errors: []error{errors.New("service unavailable")}}
+
+	var mu sync.Mutex
+	var reported []error
+	refresher := NewRefresher(client, []int{10000},
+		WithRefreshInterval(5*time.Millisecond),
+		WithRefreshErrorHandler(func(err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			reported = append(reported, err)
+		}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan error)
+	go func() { done <- refresher.Run(ctx) }()
+
+	assert.Eventually(t, func() bool { return len(client.Calls()) >= 3 }, time.Second, time.Millisecond)
+	cancel()
+
+	assert.True(t, errors.Is(<-done, context.Canceled))
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Len(t, reported, 1)
+	assert.Equal(t, time.UnixMilli(1702592000000), refresher.Expiration())
+}
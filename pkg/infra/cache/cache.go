@@ -0,0 +1,49 @@
+// Package cache caches the responses of the read requests sent by the Atlassian clients,
+// e.g. the fields, the issue types or the priorities, which rarely change but are fetched over and over.
+//
+// Only the successful GET responses of the routes having a TTL are cached. Once the TTL expires,
+// the cached response is revalidated using the If-None-Match and If-Modified-Since headers when
+// the site returned an ETag or a Last-Modified header, so a 304 response refreshes the cached one.
+// The writes sent by the client invalidate the cached responses of the same resource family,
+// e.g. a PUT /rest/api/3/field/customfield_10010 invalidates every cached /rest/api/3/field response.
+//
+// The responses are cached per credential, the Authorization header of the requests by default,
+// and the requests without credential aren't cached, see WithCredential.
+//
+//	store := cache.NewLRU(500)
+//
+//	instance, err := v3.New(nil, "https://ctreminiom.atlassian.net",
+//		v3.WithCache(store,
+//			cache.WithTTL("/rest/api/3/field", time.Hour),
+//			cache.WithTTL("/rest/api/3/project/{projectIdOrKey}", 10*time.Minute),
+//		),
+//	)
+package cache
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Entry is a cached response.
+type Entry struct {
+	Header       http.Header `json:"header,omitempty"`
+	Body         []byte      `json:"body,omitempty"`
+	ETag         string      `json:"etag,omitempty"`
+	LastModified string      `json:"lastModified,omitempty"`
+
+	// Expires is the time the entry must be revalidated at.
+	Expires time.Time `json:"expires"`
+}
+
+// Cache stores the cached responses, it must be safe for concurrent use.
+// The entries are kept after their expiration so they can be revalidated,
+// the implementations are responsible for their eviction.
+//
+// A distributed implementation, e.g. backed by Redis, should report its errors as cache misses.
+type Cache interface {
+	Get(ctx context.Context, key string) (*Entry, bool)
+	Set(ctx context.Context, key string, entry *Entry)
+	Delete(ctx context.Context, key string)
+}
@@ -0,0 +1,371 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/middleware"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/route"
+)
+
+// defaultMaxEntries is the number of cached keys indexed by a layer when none is configured.
+const defaultMaxEntries = 10_000
+
+// versionPattern matches the API version segments, e.g. "3", "1.0", "2.0" or "v2".
+var versionPattern = regexp.MustCompile(`^v?\d+(\.\d+)?$`)
+
+// Option configures a Layer.
+type Option func(*Layer)
+
+// WithTTL sets the TTL of the responses of a route, either a request path or a route template,
+// e.g. "/rest/api/3/field" or "/rest/api/3/project/{projectIdOrKey}".
+// A non-positive TTL disables the caching of the route.
+func WithTTL(path string, ttl time.Duration) Option {
+	return func(l *Layer) {
+		l.routes[template(path)] = ttl
+	}
+}
+
+// WithDefaultTTL sets the TTL of the responses of the routes without their own TTL.
+// By default, only the routes configured using WithTTL are cached.
+func WithDefaultTTL(ttl time.Duration) Option {
+	return func(l *Layer) {
+		l.defaultTTL = ttl
+	}
+}
+
+// WithCredential sets the function returning the credential of a request, the responses are only
+// shared by the requests having the same credential and the requests without credential aren't cached.
+//
+// By default, the credential is the Authorization header of the request. Set it when the credential
+// is added below the client middlewares, e.g. by an OAuth 2.0 transport of the HTTP client.
+func WithCredential(fn func(request *http.Request) string) Option {
+	return func(l *Layer) {
+		if fn != nil {
+			l.credential = fn
+		}
+	}
+}
+
+// WithMaxEntries sets the maximum number of responses cached by the layer, 10000 by default.
+// Once reached, the oldest cached responses are removed from the cache to make room for the new ones.
+func WithMaxEntries(maxEntries int) Option {
+	return func(l *Layer) {
+		if maxEntries > 0 {
+			l.maxEntries = maxEntries
+		}
+	}
+}
+
+// Layer caches the responses of a client using a Cache.
+type Layer struct {
+	store      Cache
+	defaultTTL time.Duration
+	routes     map[string]time.Duration
+	credential func(request *http.Request) string
+	maxEntries int
+	now        func() time.Time
+
+	mu sync.Mutex
+	// families indexes the cached keys by resource family, to invalidate them.
+	families map[string]map[string]struct{}
+	// keys are the cached keys in their caching order, to remove the oldest ones.
+	keys []string
+	// index maps the cached keys to their resource family.
+	index map[string]string
+}
+
+// NewLayer creates a Layer storing the responses in the cache.
+func NewLayer(store Cache, options ...Option) *Layer {
+
+	l := &Layer{
+		store:      store,
+		routes:     make(map[string]time.Duration),
+		credential: authorization,
+		maxEntries: defaultMaxEntries,
+		now:        time.Now,
+		families:   make(map[string]map[string]struct{}),
+		index:      make(map[string]string),
+	}
+
+	for _, option := range options {
+		option(l)
+	}
+
+	return l
+}
+
+// Wrap returns a Doer serving the cached responses and caching the responses of the next Doer.
+// It returns next unchanged when the layer is nil.
+func (l *Layer) Wrap(next middleware.Doer) middleware.Doer {
+
+	if l == nil {
+		return next
+	}
+
+	return middleware.DoerFunc(func(request *http.Request) (*http.Response, error) {
+
+		switch request.Method {
+		case http.MethodGet:
+			return l.get(next, request)
+
+		case http.MethodHead, http.MethodOptions:
+			return next.Do(request)
+		}
+
+		response, err := next.Do(request)
+		l.Invalidate(request.Context(), request.URL.Path)
+
+		return response, err
+	})
+}
+
+// Invalidate removes the cached responses of the resource family of the path.
+func (l *Layer) Invalidate(ctx context.Context, path string) {
+
+	family := Family(path)
+
+	l.mu.Lock()
+	keys := l.families[family]
+	delete(l.families, family)
+
+	for key := range keys {
+		delete(l.index, key)
+	}
+	l.mu.Unlock()
+
+	for key := range keys {
+		l.store.Delete(ctx, key)
+	}
+}
+
+// get serves the GET request from the cache, or sends it and caches its response.
+func (l *Layer) get(next middleware.Doer, request *http.Request) (*http.Response, error) {
+
+	ttl := l.ttl(request)
+
+	// The conditional requests sent by the caller are theirs to handle.
+	if ttl <= 0 || request.Header.Get("If-None-Match") != "" || request.Header.Get("If-Modified-Since") != "" {
+		return next.Do(request)
+	}
+
+	credential := l.credential(request)
+	if credential == "" {
+		return next.Do(request)
+	}
+
+	ctx, key := request.Context(), l.key(request, credential)
+
+	entry, ok := l.store.Get(ctx, key)
+	if ok && l.now().Before(entry.Expires) {
+		return entry.response(request), nil
+	}
+
+	if !ok {
+		// The store evicted or expired the entry, its key isn't worth indexing anymore.
+		l.mu.Lock()
+		l.unindex(key)
+		l.mu.Unlock()
+	}
+
+	outgoing := request
+	if ok && (entry.ETag != "" || entry.LastModified != "") {
+
+		outgoing = request.Clone(ctx)
+
+		if entry.ETag != "" {
+			outgoing.Header.Set("If-None-Match", entry.ETag)
+		}
+
+		if entry.LastModified != "" {
+			outgoing.Header.Set("If-Modified-Since", entry.LastModified)
+		}
+	}
+
+	response, err := next.Do(outgoing)
+	if err != nil {
+		return response, err
+	}
+
+	if ok && response.StatusCode == http.StatusNotModified {
+
+		_, _ = io.Copy(io.Discard, response.Body)
+		_ = response.Body.Close()
+
+		refreshed := *entry
+		refreshed.Expires = l.now().Add(ttl)
+
+		if etag := response.Header.Get("ETag"); etag != "" {
+			refreshed.ETag = etag
+		}
+
+		if lastModified := response.Header.Get("Last-Modified"); lastModified != "" {
+			refreshed.LastModified = lastModified
+		}
+
+		l.set(ctx, request, key, &refreshed)
+		return refreshed.response(request), nil
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return response, nil
+	}
+
+	body, err := io.ReadAll(response.Body)
+	_ = response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response.Body = io.NopCloser(bytes.NewReader(body))
+
+	l.set(ctx, request, key, &Entry{
+		Header:       response.Header.Clone(),
+		Body:         body,
+		ETag:         response.Header.Get("ETag"),
+		LastModified: response.Header.Get("Last-Modified"),
+		Expires:      l.now().Add(ttl),
+	})
+
+	return response, nil
+}
+
+// set stores the entry and indexes its key by resource family,
+// removing the oldest entries from the store when the index is full.
+func (l *Layer) set(ctx context.Context, request *http.Request, key string, entry *Entry) {
+
+	family := Family(request.URL.Path)
+
+	var evicted []string
+
+	l.mu.Lock()
+	if _, found := l.index[key]; !found {
+
+		for len(l.index) >= l.maxEntries && len(l.keys) != 0 {
+
+			oldest := l.keys[0]
+			l.keys = l.keys[1:]
+
+			if _, indexed := l.index[oldest]; indexed {
+				l.unindex(oldest)
+				evicted = append(evicted, oldest)
+			}
+		}
+
+		l.keys = append(l.keys, key)
+	}
+
+	if l.families[family] == nil {
+		l.families[family] = make(map[string]struct{})
+	}
+
+	l.families[family][key] = struct{}{}
+	l.index[key] = family
+	l.mu.Unlock()
+
+	for _, key := range evicted {
+		l.store.Delete(ctx, key)
+	}
+
+	l.store.Set(ctx, key, entry)
+}
+
+// unindex removes the key from the index, the caller must hold the lock.
+// The caching order keeps the key until it's the oldest one or the order is compacted.
+func (l *Layer) unindex(key string) {
+
+	family, found := l.index[key]
+	if !found {
+		return
+	}
+
+	delete(l.index, key)
+	delete(l.families[family], key)
+
+	if len(l.families[family]) == 0 {
+		delete(l.families, family)
+	}
+
+	// The removed keys are compacted once they are the majority, so the order stays bounded.
+	if len(l.keys) > 2*len(l.index)+1 {
+		l.keys = slices.DeleteFunc(l.keys, func(key string) bool {
+			_, indexed := l.index[key]
+			return !indexed
+		})
+	}
+}
+
+// ttl returns the TTL of the request route.
+func (l *Layer) ttl(request *http.Request) time.Duration {
+
+	if ttl, ok := l.routes[route.Template(request.URL.Path)]; ok {
+		return ttl
+	}
+
+	return l.defaultTTL
+}
+
+// key returns the cache key of the request, the URL prefixed by a digest of the credential,
+// so the responses aren't shared by different users of a shared cache.
+func (l *Layer) key(request *http.Request, credential string) string {
+
+	digest := sha256.Sum256([]byte(credential))
+	return hex.EncodeToString(digest[:8]) + " " + request.URL.String()
+}
+
+// authorization returns the Authorization header of the request, the default credential of a layer.
+func authorization(request *http.Request) string {
+	return request.Header.Get("Authorization")
+}
+
+// response returns a response serving the cached entry.
+func (e *Entry) response(request *http.Request) *http.Response {
+
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       request,
+	}
+}
+
+// Family returns the resource family of a path, the route template up to the first collection
+// following the API base, e.g. /rest/api/3/issue for /rest/api/3/issue/KP-1/comment.
+func Family(path string) string {
+
+	segments := strings.Split(strings.Trim(template(path), "/"), "/")
+
+	base := -1
+	for index, segment := range segments {
+		if versionPattern.MatchString(segment) || segment == "api" || segment == "servicedeskapi" {
+			base = index
+		}
+	}
+
+	end := min(base+2, len(segments))
+	return "/" + strings.Join(segments[:end], "/")
+}
+
+// template returns the route template of a path, the paths already templated are returned unchanged.
+func template(path string) string {
+
+	if strings.Contains(path, "{") {
+		return path
+	}
+
+	return route.Template(path)
+}
@@ -0,0 +1,272 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/middleware"
+)
+
+func TestFamily(t *testing.T) {
+
+	testCases := []struct {
+		path string
+		want string
+	}{
+		{"/rest/api/3/issue/KP-1/comment/10000", "/rest/api/3/issue"},
+		{"/rest/api/3/field", "/rest/api/3/field"},
+		{"/rest/agile/1.0/board/1/sprint", "/rest/agile/1.0/board"},
+		{"/2.0/repositories/workspace/repo/pullrequests", "/2.0/repositories"},
+		{"/wiki/rest/api/content/10000", "/wiki/rest/api/content"},
+		{"/wiki/api/v2/pages/10000", "/wiki/api/v2/pages"},
+		{"/rest/servicedeskapi/servicedesk/1/queue", "/rest/servicedeskapi/servicedesk"},
+		{"/scim/directory/d6a4b2e0/Users", "/scim"},
+		{"/rest/api/3/project/{projectIdOrKey}", "/rest/api/3/project"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.path, func(t *testing.T) {
+			assert.Equal(t, testCase.want, Family(testCase.path))
+		})
+	}
+}
+
+func TestLayer_Wrap(t *testing.T) {
+
+	var requests, revalidations atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		requests.Add(1)
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/rest/api/3/field":
+			if r.Header.Get("If-None-Match") == `"v1"` {
+				revalidations.Add(1)
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+
+			w.Header().Set("ETag", `"v1"`)
+			_, _ = io.WriteString(w, `[{"id":"summary"}]`)
+
+		case r.Method == http.MethodGet && r.URL.Path == "/rest/api/3/issue/KP-1":
+			_, _ = io.WriteString(w, `{"key":"KP-1"}`)
+
+		case r.Method == http.MethodPost && r.URL.Path == "/rest/api/3/field":
+			w.WriteHeader(http.StatusCreated)
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	now := time.Now()
+	store := NewLRU(10)
+
+	layer := NewLayer(store, WithTTL("/rest/api/3/field", time.Minute))
+	layer.now = func() time.Time { return now }
+
+	doer := layer.Wrap(server.Client())
+
+	send := func(method, path string) (int, string) {
+
+		request, err := http.NewRequest(method, server.URL+path, nil)
+		assert.NoError(t, err)
+		request.SetBasicAuth("carlos@example.com", "token")
+
+		response, err := doer.Do(request)
+		assert.NoError(t, err)
+		defer response.Body.Close()
+
+		body, err := io.ReadAll(response.Body)
+		assert.NoError(t, err)
+
+		return response.StatusCode, string(body)
+	}
+
+	// The second request is served from the cache.
+	for range 2 {
+		status, body := send(http.MethodGet, "/rest/api/3/field")
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, `[{"id":"summary"}]`, body)
+	}
+
+	assert.Equal(t, int32(1), requests.Load())
+
+	// The expired response is revalidated.
+	now = now.Add(2 * time.Minute)
+
+	status, body := send(http.MethodGet, "/rest/api/3/field")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, `[{"id":"summary"}]`, body)
+	assert.Equal(t, int32(2), requests.Load())
+	assert.Equal(t, int32(1), revalidations.Load())
+
+	send(http.MethodGet, "/rest/api/3/field")
+	assert.Equal(t, int32(2), requests.Load())
+
+	// The routes without TTL aren't cached.
+	send(http.MethodGet, "/rest/api/3/issue/KP-1")
+	send(http.MethodGet, "/rest/api/3/issue/KP-1")
+	assert.Equal(t, int32(4), requests.Load())
+
+	// A write to the same family invalidates the cached responses.
+	send(http.MethodPost, "/rest/api/3/field")
+	assert.Equal(t, 0, store.Len())
+
+	send(http.MethodGet, "/rest/api/3/field")
+	assert.Equal(t, int32(6), requests.Load())
+	assert.Equal(t, int32(1), revalidations.Load())
+}
+
+func TestLayer_Wrap_Credential(t *testing.T) {
+
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		_, _ = io.WriteString(w, `{"accountId":"`+r.Header.Get("Authorization")+`"}`)
+	}))
+	defer server.Close()
+
+	send := func(doer middleware.Doer, authorization string) string {
+
+		request, err := http.NewRequest(http.MethodGet, server.URL+"/rest/api/3/myself", nil)
+		assert.NoError(t, err)
+
+		if authorization != "" {
+			request.Header.Set("Authorization", authorization)
+		}
+
+		response, err := doer.Do(request)
+		assert.NoError(t, err)
+		defer response.Body.Close()
+
+		body, err := io.ReadAll(response.Body)
+		assert.NoError(t, err)
+
+		return string(body)
+	}
+
+	t.Run("when the requests have no credential", func(t *testing.T) {
+
+		requests.Store(0)
+		store := NewLRU(10)
+		doer := NewLayer(store, WithDefaultTTL(time.Minute)).Wrap(server.Client())
+
+		send(doer, "")
+		send(doer, "")
+
+		assert.Equal(t, int32(2), requests.Load())
+		assert.Equal(t, 0, store.Len())
+	})
+
+	t.Run("when the requests have different credentials", func(t *testing.T) {
+
+		requests.Store(0)
+		doer := NewLayer(NewLRU(10), WithDefaultTTL(time.Minute)).Wrap(server.Client())
+
+		assert.Equal(t, `{"accountId":"Bearer alice"}`, send(doer, "Bearer alice"))
+		assert.Equal(t, `{"accountId":"Bearer bob"}`, send(doer, "Bearer bob"))
+		assert.Equal(t, `{"accountId":"Bearer alice"}`, send(doer, "Bearer alice"))
+		assert.Equal(t, int32(2), requests.Load())
+	})
+
+	t.Run("when the credential is resolved by the caller", func(t *testing.T) {
+
+		requests.Store(0)
+		credential := "alice"
+		doer := NewLayer(NewLRU(10), WithDefaultTTL(time.Minute),
+			WithCredential(func(*http.Request) string { return credential })).Wrap(server.Client())
+
+		send(doer, "")
+		send(doer, "")
+		assert.Equal(t, int32(1), requests.Load())
+
+		credential = "bob"
+		send(doer, "")
+		assert.Equal(t, int32(2), requests.Load())
+	})
+}
+
+func TestLayer_Index(t *testing.T) {
+
+	var deleted atomic.Bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		if deleted.Load() && r.URL.Path == "/rest/api/3/issue/KP-1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = io.WriteString(w, `{}`)
+	}))
+	defer server.Close()
+
+	store := NewLRU(10)
+	layer := NewLayer(store, WithDefaultTTL(time.Minute), WithMaxEntries(3))
+	doer := layer.Wrap(server.Client())
+
+	send := func(path string) {
+
+		request, err := http.NewRequest(http.MethodGet, server.URL+path, nil)
+		assert.NoError(t, err)
+		request.Header.Set("Authorization", "Bearer alice")
+
+		response, err := doer.Do(request)
+		assert.NoError(t, err)
+		_ = response.Body.Close()
+	}
+
+	// The keys evicted by the store are pruned from the index when they are missed.
+	send("/rest/api/3/issue/KP-1")
+	send("/rest/api/3/issue/KP-2")
+	assert.Len(t, layer.index, 2)
+
+	for key := range layer.index {
+		if strings.HasSuffix(key, "/KP-1") {
+			store.Delete(context.Background(), key)
+		}
+	}
+
+	deleted.Store(true)
+	send("/rest/api/3/issue/KP-1")
+
+	assert.Len(t, layer.index, 1)
+	assert.Len(t, layer.families["/rest/api/3/issue"], 1)
+
+	// The index never exceeds the maximum number of entries.
+	for index := range 20 {
+		send(fmt.Sprintf("/rest/api/3/project/%d", 10000+index))
+	}
+
+	assert.LessOrEqual(t, len(layer.index), 3)
+	assert.LessOrEqual(t, len(layer.keys), 2*3+1)
+
+	total := 0
+	for _, keys := range layer.families {
+		total += len(keys)
+	}
+
+	assert.Equal(t, len(layer.index), total)
+}
+
+func TestLayer_Wrap_Nil(t *testing.T) {
+
+	var layer *Layer
+	doer := middleware.DoerFunc(func(*http.Request) (*http.Response, error) { return nil, nil })
+
+	assert.NotNil(t, layer.Wrap(doer))
+}
@@ -0,0 +1,95 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+)
+
+// DefaultCapacity is the capacity of the LRU caches created with a non-positive capacity.
+const DefaultCapacity = 1000
+
+// LRU is an in-memory Cache evicting the least recently used entries once its capacity is reached.
+type LRU struct {
+	capacity int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List
+}
+
+type lruItem struct {
+	key   string
+	entry *Entry
+}
+
+// NewLRU creates an in-memory cache holding up to capacity entries.
+func NewLRU(capacity int) *LRU {
+
+	if capacity <= 0 {
+		capacity = DefaultCapacity
+	}
+
+	return &LRU{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Get returns the entry of the key and marks it as the most recently used.
+func (l *LRU) Get(_ context.Context, key string) (*Entry, bool) {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	element, ok := l.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	l.order.MoveToFront(element)
+	return element.Value.(*lruItem).entry, true
+}
+
+// Set stores the entry of the key, evicting the least recently used entry when the cache is full.
+func (l *LRU) Set(_ context.Context, key string, entry *Entry) {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if element, ok := l.entries[key]; ok {
+		element.Value.(*lruItem).entry = entry
+		l.order.MoveToFront(element)
+		return
+	}
+
+	l.entries[key] = l.order.PushFront(&lruItem{key: key, entry: entry})
+
+	if l.order.Len() > l.capacity {
+		oldest := l.order.Back()
+		l.order.Remove(oldest)
+		delete(l.entries, oldest.Value.(*lruItem).key)
+	}
+}
+
+// Delete removes the entry of the key.
+func (l *LRU) Delete(_ context.Context, key string) {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if element, ok := l.entries[key]; ok {
+		l.order.Remove(element)
+		delete(l.entries, key)
+	}
+}
+
+// Len returns the number of entries.
+func (l *LRU) Len() int {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.order.Len()
+}
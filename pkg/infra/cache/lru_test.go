@@ -0,0 +1,39 @@
+package cache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLRU(t *testing.T) {
+
+	ctx := context.Background()
+	lru := NewLRU(2)
+
+	lru.Set(ctx, "first", &Entry{ETag: "1"})
+	lru.Set(ctx, "second", &Entry{ETag: "2"})
+
+	// Reading the first entry makes the second one the least recently used.
+	entry, ok := lru.Get(ctx, "first")
+	assert.True(t, ok)
+	assert.Equal(t, "1", entry.ETag)
+
+	lru.Set(ctx, "third", &Entry{ETag: "3"})
+	assert.Equal(t, 2, lru.Len())
+
+	_, ok = lru.Get(ctx, "second")
+	assert.False(t, ok)
+
+	lru.Set(ctx, "first", &Entry{ETag: "updated"})
+	entry, _ = lru.Get(ctx, "first")
+	assert.Equal(t, "updated", entry.ETag)
+
+	lru.Delete(ctx, "first")
+	_, ok = lru.Get(ctx, "first")
+	assert.False(t, ok)
+	assert.Equal(t, 1, lru.Len())
+
+	assert.Equal(t, DefaultCapacity, NewLRU(0).capacity)
+}
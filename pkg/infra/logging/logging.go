@@ -0,0 +1,125 @@
+// Package logging logs the requests sent by the Atlassian clients using log/slog.
+//
+// The requests are logged at the debug level and the error responses at the warn level.
+// The credentials and the SCIM user personal data are redacted from the records.
+package logging
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/middleware"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/route"
+)
+
+// maxBodySize is the number of bytes of a redacted error body included in a record.
+const maxBodySize = 16 << 10
+
+// Logger logs the requests of a product client.
+// A nil *Logger is valid and logs nothing.
+type Logger struct {
+	logger  *slog.Logger
+	product string
+}
+
+// New creates a Logger for the product, e.g. "jira" or "bitbucket".
+func New(logger *slog.Logger, product string) *Logger {
+	return &Logger{logger: logger, product: product}
+}
+
+// Start returns the Call logging the request once ended.
+func (l *Logger) Start(request *http.Request) *Call {
+
+	if l == nil || request == nil || !l.logger.Enabled(request.Context(), slog.LevelWarn) {
+		return nil
+	}
+
+	return &Call{logger: l, request: request, start: time.Now()}
+}
+
+// Call is a logged request in flight.
+// A nil *Call is valid and logs nothing.
+type Call struct {
+	logger  *Logger
+	request *http.Request
+	start   time.Time
+}
+
+// End logs the request once its response, or its error, is received.
+// The body of an error response is read for the record and replaced with an identical reader.
+func (c *Call) End(response *http.Response, retries int, err error) {
+
+	if c == nil {
+		return
+	}
+
+	request, logger := c.request, c.logger.logger
+
+	ctx := request.Context()
+	attributes := []slog.Attr{
+		slog.String("product", c.logger.product),
+		slog.String("method", request.Method),
+		slog.String("route", route.Template(request.URL.Path)),
+		slog.Duration("duration", time.Since(c.start)),
+		slog.Int("retries", retries),
+	}
+
+	if operation := middleware.OperationFromContext(ctx); operation != "" {
+		attributes = append(attributes, slog.String("operation", operation))
+	}
+
+	if err != nil {
+		attributes = append(attributes, slog.String("error", RedactError(err)))
+		logger.LogAttrs(ctx, slog.LevelWarn, "atlassian request failed", attributes...)
+		return
+	}
+
+	attributes = append(attributes, slog.Int("status", response.StatusCode))
+
+	if response.StatusCode < http.StatusBadRequest {
+
+		if logger.Enabled(ctx, slog.LevelDebug) {
+			attributes = append(attributes,
+				slog.String("url", RedactURL(request.URL)),
+				slog.Any("headers", RedactHeaders(request.Header)),
+			)
+		}
+
+		logger.LogAttrs(ctx, slog.LevelDebug, "atlassian request", attributes...)
+		return
+	}
+
+	if logger.Enabled(ctx, slog.LevelWarn) && response.Body != nil {
+
+		body, readErr := io.ReadAll(response.Body)
+		_ = response.Body.Close()
+		response.Body = io.NopCloser(bytes.NewReader(body))
+
+		if readErr == nil {
+			scim := strings.Contains(request.URL.Path, "/scim/")
+			// The body is redacted before being truncated, a truncated JSON body can't be redacted field by field.
+			attributes = append(attributes, slog.String("body", truncate(RedactBody(body, scim), maxBodySize)))
+		}
+	}
+
+	logger.LogAttrs(ctx, slog.LevelWarn, "atlassian request returned an error status", attributes...)
+}
+
+// truncate returns the first bytes of the text, up to the size, without splitting a character.
+func truncate(text string, size int) string {
+
+	if len(text) <= size {
+		return text
+	}
+
+	for size > 0 && !utf8.RuneStart(text[size]) {
+		size--
+	}
+
+	return text[:size]
+}
@@ -0,0 +1,193 @@
+package logging
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Redacted replaces the secrets and the personal data in the log records.
+const Redacted = "[REDACTED]"
+
+// sensitiveHeaders are the headers carrying credentials.
+var sensitiveHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Set-Cookie":          true,
+}
+
+// sensitiveKeys are the query parameters and JSON fields carrying credentials, compared in lower case.
+var sensitiveKeys = map[string]bool{
+	"access_token":  true,
+	"refresh_token": true,
+	"id_token":      true,
+	"client_secret": true,
+	"password":      true,
+	"token":         true,
+	"authorization": true,
+}
+
+// freeTextKeys are the query parameters carrying free-text searches, e.g. a SCIM filter or a JQL query,
+// which usually contain personal data such as emails or names, compared in lower case.
+var freeTextKeys = map[string]bool{
+	"filter": true,
+	"query":  true,
+	"jql":    true,
+	"cql":    true,
+	"q":      true,
+}
+
+// scimKeys are the SCIM user attributes carrying personal data, compared in lower case.
+var scimKeys = map[string]bool{
+	"username":     true,
+	"displayname":  true,
+	"nickname":     true,
+	"name":         true,
+	"givenname":    true,
+	"familyname":   true,
+	"formatted":    true,
+	"emails":       true,
+	"email":        true,
+	"phonenumbers": true,
+	"addresses":    true,
+	"photos":       true,
+	"title":        true,
+	"externalid":   true,
+}
+
+var (
+	// sensitivePattern matches the sensitive keys anywhere in a body.
+	sensitivePattern = regexp.MustCompile(`(?i)(?:` + keyAlternation(sensitiveKeys) + `)`)
+
+	// formTokenPattern matches the values of the sensitive keys of a form encoded body.
+	formTokenPattern = regexp.MustCompile(`(?i)\b((?:` + keyAlternation(sensitiveKeys) + `)=)[^&\s"]+`)
+)
+
+// keyAlternation returns the regular expression alternation of the keys, the longest first.
+func keyAlternation(keys map[string]bool) string {
+
+	alternatives := make([]string, 0, len(keys))
+	for key := range keys {
+		alternatives = append(alternatives, regexp.QuoteMeta(key))
+	}
+
+	slices.SortFunc(alternatives, func(a, b string) int {
+		return cmp.Or(len(b)-len(a), strings.Compare(a, b))
+	})
+
+	return strings.Join(alternatives, "|")
+}
+
+// RedactHeaders returns a copy of the headers with the credentials redacted.
+func RedactHeaders(header http.Header) http.Header {
+
+	redacted := header.Clone()
+	for key := range redacted {
+		if sensitiveHeaders[http.CanonicalHeaderKey(key)] {
+			redacted[key] = []string{Redacted}
+		}
+	}
+
+	return redacted
+}
+
+// RedactURL returns the URL without its user info, and with the credentials and the free-text searches of the query redacted.
+func RedactURL(u *url.URL) string {
+
+	if u == nil {
+		return ""
+	}
+
+	redacted := *u
+	redacted.User = nil
+
+	query := redacted.Query()
+	for key := range query {
+		if lower := strings.ToLower(key); sensitiveKeys[lower] || freeTextKeys[lower] {
+			query[key] = []string{Redacted}
+		}
+	}
+
+	if len(query) > 0 {
+		redacted.RawQuery = query.Encode()
+	}
+
+	return redacted.String()
+}
+
+// RedactError returns the message of the error, with the URL of the *url.Error it wraps redacted as RedactURL does.
+func RedactError(err error) string {
+
+	message := err.Error()
+
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) || urlErr.URL == "" {
+		return message
+	}
+
+	parsed, parseErr := url.Parse(urlErr.URL)
+	if parseErr != nil {
+		return strings.ReplaceAll(message, urlErr.URL, Redacted)
+	}
+
+	return strings.ReplaceAll(message, urlErr.URL, RedactURL(parsed))
+}
+
+// HasSensitiveKeys reports whether the body mentions a key carrying credentials, e.g. to skip the redaction of the other bodies.
+func HasSensitiveKeys(body []byte) bool {
+	return sensitivePattern.Match(body)
+}
+
+// RedactBody returns the body with the credentials redacted, and the SCIM user personal data when scim is true.
+// The JSON bodies are redacted field by field, the other bodies only get their form encoded tokens redacted.
+func RedactBody(body []byte, scim bool) string {
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return formTokenPattern.ReplaceAllString(string(body), "${1}"+Redacted)
+	}
+
+	buf := new(bytes.Buffer)
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(redactValue(document, scim)); err != nil {
+		return Redacted
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+func redactValue(value interface{}, scim bool) interface{} {
+
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, field := range typed {
+			lower := strings.ToLower(key)
+
+			if sensitiveKeys[lower] || (scim && scimKeys[lower]) {
+				typed[key] = Redacted
+				continue
+			}
+
+			typed[key] = redactValue(field, scim)
+		}
+
+	case []interface{}:
+		for index, item := range typed {
+			typed[index] = redactValue(item, scim)
+		}
+	}
+
+	return value
+}
@@ -0,0 +1,110 @@
+// Package middleware provides the request/response interceptors of the Atlassian clients.
+//
+// A Middleware wraps the Doer sending the requests of a client, so it can inspect or
+// modify the requests and responses, or short-circuit them, e.g. to inject headers,
+// audit the calls, block the writes or serve cached responses.
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/route"
+)
+
+// Doer sends an HTTP request and returns its response.
+// It has the same method set as common.HTTPClient.
+type Doer interface {
+	Do(request *http.Request) (*http.Response, error)
+}
+
+// DoerFunc is an adapter allowing the use of an ordinary function as a Doer.
+type DoerFunc func(request *http.Request) (*http.Response, error)
+
+// Do calls f(request).
+func (f DoerFunc) Do(request *http.Request) (*http.Response, error) {
+	return f(request)
+}
+
+// Middleware wraps the next Doer of the chain.
+type Middleware func(next Doer) Doer
+
+type operationKey struct{}
+
+// WithOperation returns a copy of the context carrying the logical operation name of the requests created with it.
+// It overrides the default operation name, which is the method followed by the templated route,
+// e.g. "GET /rest/api/3/issue/{issueIdOrKey}".
+func WithOperation(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, operationKey{}, name)
+}
+
+// OperationFromContext returns the logical operation name carried by the context.
+// The requests sent through a chain always carry one.
+func OperationFromContext(ctx context.Context) string {
+	name, _ := ctx.Value(operationKey{}).(string)
+	return name
+}
+
+// Operation returns the default operation name of a request, the method followed by the templated route.
+func Operation(request *http.Request) string {
+	return request.Method + " " + route.Template(request.URL.Path)
+}
+
+// Chain returns a Doer sending the requests through the middlewares, the first middleware being the outermost one.
+// The requests get their default operation name unless the context already carries one.
+// It returns the doer unchanged when there are no middlewares.
+func Chain(doer Doer, middlewares ...Middleware) Doer {
+
+	if len(middlewares) == 0 {
+		return doer
+	}
+
+	for index := len(middlewares) - 1; index >= 0; index-- {
+		doer = middlewares[index](doer)
+	}
+
+	return DoerFunc(func(request *http.Request) (*http.Response, error) {
+
+		if request != nil && OperationFromContext(request.Context()) == "" {
+			request = request.WithContext(WithOperation(request.Context(), Operation(request)))
+		}
+
+		return doer.Do(request)
+	})
+}
+
+// SetHeader returns a middleware setting the header on every request.
+func SetHeader(key, value string) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(request *http.Request) (*http.Response, error) {
+
+			if request != nil {
+				request = request.Clone(request.Context())
+				request.Header.Set(key, value)
+			}
+
+			return next.Do(request)
+		})
+	}
+}
+
+// DryRun returns a middleware blocking the requests using an unsafe method, e.g. POST, PUT or DELETE.
+// The blocked requests return an error wrapping model.ErrDryRun.
+func DryRun() Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(request *http.Request) (*http.Response, error) {
+
+			if request != nil {
+				switch request.Method {
+				case http.MethodGet, http.MethodHead, http.MethodOptions:
+				default:
+					return nil, fmt.Errorf("%w: %v", model.ErrDryRun, OperationFromContext(request.Context()))
+				}
+			}
+
+			return next.Do(request)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+func newRequest(t *testing.T, ctx context.Context, method, rawURL string) *http.Request {
+	t.Helper()
+
+	request, err := http.NewRequestWithContext(ctx, method, rawURL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return request
+}
+
+func TestChain(t *testing.T) {
+
+	var trail []string
+	record := func(name string) Middleware {
+		return func(next Doer) Doer {
+			return DoerFunc(func(request *http.Request) (*http.Response, error) {
+				trail = append(trail, name+" "+OperationFromContext(request.Context()))
+				return next.Do(request)
+			})
+		}
+	}
+
+	core := DoerFunc(func(request *http.Request) (*http.Response, error) {
+		trail = append(trail, "core "+request.Header.Get("X-Audit"))
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	})
+
+	doer := Chain(core, record("first"), record("second"), SetHeader("X-Audit", "go-atlassian"))
+
+	response, err := doer.Do(newRequest(t, context.Background(), http.MethodGet, "https://ctreminiom.atlassian.net/rest/api/3/issue/KP-1"))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+
+	response, err = doer.Do(newRequest(t, WithOperation(context.Background(), "issue.get"), http.MethodGet, "https://ctreminiom.atlassian.net/rest/api/3/issue/KP-1"))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+
+	assert.Equal(t, []string{
+		"first GET /rest/api/3/issue/{issueIdOrKey}",
+		"second GET /rest/api/3/issue/{issueIdOrKey}",
+		"core go-atlassian",
+		"first issue.get",
+		"second issue.get",
+		"core go-atlassian",
+	}, trail)
+}
+
+func TestChain_NoMiddlewares(t *testing.T) {
+
+	core := DoerFunc(func(request *http.Request) (*http.Response, error) {
+		assert.Nil(t, request)
+		return nil, nil
+	})
+
+	doer := Chain(core)
+	_, err := doer.Do(nil)
+	assert.NoError(t, err)
+}
+
+func TestDryRun(t *testing.T) {
+
+	calls := 0
+	core := DoerFunc(func(request *http.Request) (*http.Response, error) {
+		calls++
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	})
+
+	doer := Chain(core, DryRun())
+
+	_, err := doer.Do(newRequest(t, context.Background(), http.MethodGet, "https://ctreminiom.atlassian.net/rest/api/3/myself"))
+	assert.NoError(t, err)
+
+	_, err = doer.Do(newRequest(t, context.Background(), http.MethodDelete, "https://ctreminiom.atlassian.net/rest/api/3/issue/KP-1"))
+	assert.True(t, errors.Is(err, model.ErrDryRun))
+	assert.Contains(t, err.Error(), "DELETE /rest/api/3/issue/{issueIdOrKey}")
+
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,274 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// APIError represents an unsuccessful response returned by an Atlassian API.
+//
+// It wraps the sentinel error matching the HTTP status code, so errors.Is(err, ErrNotFound)
+// keeps working, and it exposes the messages decoded from the response body.
+// Use errors.As(err, &apiErr) to access it.
+type APIError struct {
+	StatusCode int               // The HTTP status code of the response.
+	Method     string            // The HTTP method used for the request.
+	Endpoint   string            // The endpoint that the request was made to.
+	Code       string            // The machine-readable error code, when the API provides one.
+	Messages   []string          // The general error messages.
+	Fields     map[string]string // The validation errors, keyed by the field name.
+	Body       []byte            // The raw response body.
+
+	sentinel error
+}
+
+// NewAPIError creates an APIError from an unsuccessful HTTP response and its body.
+//
+// It understands the error formats used by the Atlassian products:
+//   - Jira and Assets: {"errorMessages": [], "errors": {"field": "message"}}
+//   - Jira Service Management: {"errorMessage": "", "i18nErrorMessage": {}}
+//   - Confluence v1: {"message": "", "data": {"errors": [{"message": {"key": "", "translation": ""}}]}}
+//   - Confluence v2 and Admin: {"errors": [{"status": 400, "code": "", "title": "", "detail": ""}]}
+//   - Bitbucket: {"type": "error", "error": {"message": "", "detail": "", "fields": {"field": []}}}
+//   - SCIM: {"schemas": [], "status": "400", "scimType": "", "detail": ""}
+func NewAPIError(response *http.Response, body []byte) *APIError {
+
+	apiErr := &APIError{
+		StatusCode: response.StatusCode,
+		Body:       body,
+		sentinel:   sentinelFromStatus(response.StatusCode),
+	}
+
+	if response.Request != nil {
+		apiErr.Method = response.Request.Method
+
+		if response.Request.URL != nil {
+			apiErr.Endpoint = response.Request.URL.String()
+		}
+	}
+
+	apiErr.decode(body)
+
+	return apiErr
+}
+
+// Error returns the sentinel error message followed by the messages returned by the API.
+func (e *APIError) Error() string {
+
+	details := append([]string{}, e.Messages...)
+
+	fields := make([]string, 0, len(e.Fields))
+	for field, message := range e.Fields {
+		fields = append(fields, fmt.Sprintf("%v: %v", field, message))
+	}
+
+	sort.Strings(fields)
+	details = append(details, fields...)
+
+	if len(details) == 0 {
+		return e.sentinel.Error()
+	}
+
+	return fmt.Sprintf("%v: %v", e.sentinel.Error(), strings.Join(details, "; "))
+}
+
+// Unwrap returns the sentinel error matching the HTTP status code.
+func (e *APIError) Unwrap() error {
+	return e.sentinel
+}
+
+// Is keeps the 403, 409 and 429 errors matching ErrInvalidStatusCode,
+// the error returned for these status codes before they got a dedicated sentinel.
+func (e *APIError) Is(target error) bool {
+
+	if target != ErrInvalidStatusCode {
+		return false
+	}
+
+	switch e.sentinel {
+	case ErrForbidden, ErrConflict, ErrRateLimited:
+		return true
+	}
+
+	return false
+}
+
+// sentinelFromStatus maps an HTTP status code to its sentinel error.
+func sentinelFromStatus(statusCode int) error {
+
+	switch statusCode {
+
+	case http.StatusNotFound:
+		return ErrNotFound
+
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+
+	case http.StatusForbidden:
+		return ErrForbidden
+
+	case http.StatusConflict:
+		return ErrConflict
+
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+
+	case http.StatusInternalServerError:
+		return ErrInternal
+
+	case http.StatusBadRequest:
+		return ErrBadRequest
+
+	default:
+		return ErrInvalidStatusCode
+	}
+}
+
+// apiErrorPayload is the union of the error formats used by the Atlassian products.
+type apiErrorPayload struct {
+	ErrorMessages []string        `json:"errorMessages"`
+	ErrorMessage  string          `json:"errorMessage"`
+	Errors        json.RawMessage `json:"errors"`
+	Error         json.RawMessage `json:"error"`
+	Message       string          `json:"message"`
+	Detail        string          `json:"detail"`
+	ScimType      string          `json:"scimType"`
+	Code          json.RawMessage `json:"code"`
+	Data          *struct {
+		Errors []*apiErrorItem `json:"errors"`
+	} `json:"data"`
+}
+
+// apiErrorItem is an entry of the Confluence v1, Confluence v2 or Admin "errors" array.
+type apiErrorItem struct {
+	Code    string          `json:"code"`
+	Title   string          `json:"title"`
+	Detail  string          `json:"detail"`
+	Message json.RawMessage `json:"message"`
+}
+
+// bitbucketError is the Bitbucket "error" object.
+type bitbucketError struct {
+	Message string              `json:"message"`
+	Detail  string              `json:"detail"`
+	Fields  map[string][]string `json:"fields"`
+}
+
+// decode populates the messages, the fields and the code from the response body.
+// Bodies that are not JSON, or use an unknown format, are ignored.
+func (e *APIError) decode(body []byte) {
+
+	var payload apiErrorPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return
+	}
+
+	e.Messages = append(e.Messages, payload.ErrorMessages...)
+	e.addMessage(payload.ErrorMessage)
+	e.addMessage(payload.Message)
+	e.addMessage(payload.Detail)
+
+	if payload.ScimType != "" {
+		e.Code = payload.ScimType
+	}
+
+	var code string
+	if json.Unmarshal(payload.Code, &code) == nil && code != "" {
+		e.Code = code
+	}
+
+	// Jira and Assets use an object keyed by the field name.
+	var fields map[string]string
+	if json.Unmarshal(payload.Errors, &fields) == nil {
+		for field, message := range fields {
+			e.addField(field, message)
+		}
+	}
+
+	// Confluence v2 and Admin use an array of error objects.
+	var items []*apiErrorItem
+	if json.Unmarshal(payload.Errors, &items) == nil {
+		e.addItems(items)
+	}
+
+	// Confluence v1 nests the array of error objects under the data attribute.
+	if payload.Data != nil {
+		e.addItems(payload.Data.Errors)
+	}
+
+	// Bitbucket uses an error object, some endpoints use a plain string.
+	var bitbucket bitbucketError
+	if json.Unmarshal(payload.Error, &bitbucket) == nil {
+		e.addMessage(bitbucket.Message)
+		e.addMessage(bitbucket.Detail)
+
+		for field, messages := range bitbucket.Fields {
+			e.addField(field, strings.Join(messages, ", "))
+		}
+	}
+
+	var message string
+	if json.Unmarshal(payload.Error, &message) == nil {
+		e.addMessage(message)
+	}
+}
+
+func (e *APIError) addItems(items []*apiErrorItem) {
+
+	for _, item := range items {
+
+		if item == nil {
+			continue
+		}
+
+		if e.Code == "" {
+			e.Code = item.Code
+		}
+
+		e.addMessage(item.Title)
+		e.addMessage(item.Detail)
+
+		// The Confluence v1 message can be a string or a translation object.
+		var message string
+		var translation struct {
+			Key         string `json:"key"`
+			Translation string `json:"translation"`
+		}
+
+		if json.Unmarshal(item.Message, &message) == nil {
+			e.addMessage(message)
+		} else if json.Unmarshal(item.Message, &translation) == nil {
+			if translation.Translation != "" {
+				e.addMessage(translation.Translation)
+			} else {
+				e.addMessage(translation.Key)
+			}
+		}
+	}
+}
+
+func (e *APIError) addMessage(message string) {
+	if message != "" {
+		e.Messages = append(e.Messages, message)
+	}
+}
+
+func (e *APIError) addField(field, message string) {
+
+	if e.Fields == nil {
+		e.Fields = make(map[string]string)
+	}
+
+	e.Fields[field] = message
+}
+
+// AsAPIError returns the APIError wrapped in err, if any.
+func AsAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	ok := errors.As(err, &apiErr)
+	return apiErr, ok
+}
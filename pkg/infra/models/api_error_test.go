@@ -0,0 +1,134 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAPIError(t *testing.T) {
+
+	request := &http.Request{
+		Method: http.MethodPost,
+		URL:    &url.URL{Scheme: "https", Host: "ctreminiom.atlassian.net", Path: "/rest/api/3/issue"},
+	}
+
+	tests := []struct {
+		name         string
+		statusCode   int
+		body         string
+		wantSentinel error
+		wantCode     string
+		wantMessages []string
+		wantFields   map[string]string
+	}{
+		{
+			name:         "jira",
+			statusCode:   http.StatusBadRequest,
+			body:         `{"errorMessages":["Issue does not exist"],"errors":{"summary":"You must specify a summary of the issue."}}`,
+			wantSentinel: ErrBadRequest,
+			wantMessages: []string{"Issue does not exist"},
+			wantFields:   map[string]string{"summary": "You must specify a summary of the issue."},
+		},
+		{
+			name:         "jira service management",
+			statusCode:   http.StatusNotFound,
+			body:         `{"errorMessage":"The request type does not exist.","i18nErrorMessage":{"i18nKey":"sd.request.type.error.not.found","parameters":[]}}`,
+			wantSentinel: ErrNotFound,
+			wantMessages: []string{"The request type does not exist."},
+		},
+		{
+			name:         "confluence v1",
+			statusCode:   http.StatusBadRequest,
+			body:         `{"statusCode":400,"data":{"authorized":true,"valid":false,"errors":[{"message":{"key":"space.key.invalid","translation":"The space key is not valid"}}],"successful":false},"message":"Could not create the content"}`,
+			wantSentinel: ErrBadRequest,
+			wantMessages: []string{"Could not create the content", "The space key is not valid"},
+		},
+		{
+			name:         "confluence v2",
+			statusCode:   http.StatusForbidden,
+			body:         `{"errors":[{"status":403,"code":"FORBIDDEN","title":"Forbidden","detail":"The user cannot view the page"}]}`,
+			wantSentinel: ErrForbidden,
+			wantCode:     "FORBIDDEN",
+			wantMessages: []string{"Forbidden", "The user cannot view the page"},
+		},
+		{
+			name:         "bitbucket",
+			statusCode:   http.StatusBadRequest,
+			body:         `{"type":"error","error":{"message":"Bad request","detail":"The repository slug is invalid","fields":{"name":["Repository name is too long","Repository name contains spaces"]}}}`,
+			wantSentinel: ErrBadRequest,
+			wantMessages: []string{"Bad request", "The repository slug is invalid"},
+			wantFields:   map[string]string{"name": "Repository name is too long, Repository name contains spaces"},
+		},
+		{
+			name:         "scim",
+			statusCode:   http.StatusConflict,
+			body:         `{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],"status":"409","scimType":"uniqueness","detail":"The user already exists"}`,
+			wantSentinel: ErrConflict,
+			wantCode:     "uniqueness",
+			wantMessages: []string{"The user already exists"},
+		},
+		{
+			name:         "rate limited without body",
+			statusCode:   http.StatusTooManyRequests,
+			body:         ``,
+			wantSentinel: ErrRateLimited,
+		},
+		{
+			name:         "html body",
+			statusCode:   http.StatusBadGateway,
+			body:         `<html><body>Bad Gateway</body></html>`,
+			wantSentinel: ErrInvalidStatusCode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			response := &http.Response{StatusCode: tt.statusCode, Request: request}
+
+			var err error = NewAPIError(response, []byte(tt.body))
+			assert.True(t, errors.Is(err, tt.wantSentinel), "expected error: %v, got: %v", tt.wantSentinel, err)
+
+			apiErr, ok := AsAPIError(fmt.Errorf("client: %w", err))
+			assert.True(t, ok)
+
+			assert.Equal(t, tt.statusCode, apiErr.StatusCode)
+			assert.Equal(t, http.MethodPost, apiErr.Method)
+			assert.Equal(t, "https://ctreminiom.atlassian.net/rest/api/3/issue", apiErr.Endpoint)
+			assert.Equal(t, tt.wantCode, apiErr.Code)
+			assert.Equal(t, tt.wantMessages, apiErr.Messages)
+			assert.Equal(t, tt.wantFields, apiErr.Fields)
+			assert.Equal(t, []byte(tt.body), apiErr.Body)
+		})
+	}
+}
+
+func TestAPIError_Error(t *testing.T) {
+
+	apiErr := &APIError{
+		Messages: []string{"Issue does not exist"},
+		Fields:   map[string]string{"summary": "required", "assignee": "invalid"},
+		sentinel: ErrBadRequest,
+	}
+
+	assert.Equal(t, "atlassian invalid payload: Issue does not exist; assignee: invalid; summary: required", apiErr.Error())
+
+	apiErr = &APIError{sentinel: ErrNotFound}
+	assert.Equal(t, ErrNotFound.Error(), apiErr.Error())
+}
+
+func TestAPIError_Is(t *testing.T) {
+
+	for _, statusCode := range []int{http.StatusForbidden, http.StatusConflict, http.StatusTooManyRequests, http.StatusBadGateway} {
+		err := NewAPIError(&http.Response{StatusCode: statusCode}, nil)
+		assert.True(t, errors.Is(err, ErrInvalidStatusCode), "status code %v", statusCode)
+	}
+
+	err := NewAPIError(&http.Response{StatusCode: http.StatusNotFound}, nil)
+	assert.False(t, errors.Is(err, ErrInvalidStatusCode))
+}
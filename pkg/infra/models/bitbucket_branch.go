@@ -1,7 +1,85 @@
 package models
 
+// RefPageScheme represents a paginated list of references, branches and tags.
+type RefPageScheme struct {
+	Size     int          `json:"size,omitempty"`     // The number of references matching the request.
+	Page     int          `json:"page,omitempty"`     // The current page number.
+	Pagelen  int          `json:"pagelen,omitempty"`  // The number of references per page.
+	Next     string       `json:"next,omitempty"`     // The URL to the next page.
+	Previous string       `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*RefScheme `json:"values,omitempty"`   // The references in the current page.
+}
+
+// RefScheme represents a reference of a repository, a branch or a tag.
+type RefScheme struct {
+	Type   string          `json:"type,omitempty"`   // The type of the reference: branch or tag.
+	Name   string          `json:"name,omitempty"`   // The name of the reference.
+	Target *CommitScheme   `json:"target,omitempty"` // The commit the reference points to.
+	Links  *RefLinksScheme `json:"links,omitempty"`  // A collection of links related to the reference.
+}
+
+// RefLinksScheme represents a collection of links related to a reference.
+type RefLinksScheme struct {
+	Self    *BitbucketLinkScheme `json:"self,omitempty"`    // The link to the reference itself.
+	Commits *BitbucketLinkScheme `json:"commits,omitempty"` // The link to the commits of the reference.
+	HTML    *BitbucketLinkScheme `json:"html,omitempty"`    // The link to the reference's HTML page.
+}
+
+// RefOptionsScheme represents the filters used to list references.
+type RefOptionsScheme struct {
+	Query string // The query used to filter the references, e.g. `name ~ "release/"`.
+	Sort  string // The field used to sort the references, e.g. "-name" or "-target.date".
+}
+
+// BranchPageScheme represents a paginated list of branches.
+type BranchPageScheme struct {
+	Size     int             `json:"size,omitempty"`     // The number of branches matching the request.
+	Page     int             `json:"page,omitempty"`     // The current page number.
+	Pagelen  int             `json:"pagelen,omitempty"`  // The number of branches per page.
+	Next     string          `json:"next,omitempty"`     // The URL to the next page.
+	Previous string          `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*BranchScheme `json:"values,omitempty"`   // The branches in the current page.
+}
+
 // BranchScheme represents a branch in a repository.
 type BranchScheme struct {
-	MergeStrategies      []string `json:"merge_strategies"`       // The merge strategies available for the branch.
-	DefaultMergeStrategy string   `json:"default_merge_strategy"` // The default merge strategy used for the branch.
+	MergeStrategies      []string        `json:"merge_strategies"`       // The merge strategies available for the branch.
+	DefaultMergeStrategy string          `json:"default_merge_strategy"` // The default merge strategy used for the branch.
+	Type                 string          `json:"type,omitempty"`         // The type of the branch.
+	Name                 string          `json:"name,omitempty"`         // The name of the branch.
+	Target               *CommitScheme   `json:"target,omitempty"`       // The head commit of the branch.
+	Links                *RefLinksScheme `json:"links,omitempty"`        // A collection of links related to the branch.
+}
+
+// TagPageScheme represents a paginated list of tags.
+type TagPageScheme struct {
+	Size     int          `json:"size,omitempty"`     // The number of tags matching the request.
+	Page     int          `json:"page,omitempty"`     // The current page number.
+	Pagelen  int          `json:"pagelen,omitempty"`  // The number of tags per page.
+	Next     string       `json:"next,omitempty"`     // The URL to the next page.
+	Previous string       `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*TagScheme `json:"values,omitempty"`   // The tags in the current page.
+}
+
+// TagScheme represents a tag in a repository.
+type TagScheme struct {
+	Type    string              `json:"type,omitempty"`    // The type of the tag.
+	Name    string              `json:"name,omitempty"`    // The name of the tag.
+	Message string              `json:"message,omitempty"` // The message of an annotated tag.
+	Date    string              `json:"date,omitempty"`    // The date of an annotated tag.
+	Tagger  *CommitAuthorScheme `json:"tagger,omitempty"`  // The author of an annotated tag.
+	Target  *CommitScheme       `json:"target,omitempty"`  // The commit the tag points to.
+	Links   *RefLinksScheme     `json:"links,omitempty"`   // A collection of links related to the tag.
+}
+
+// RefPayloadScheme represents the payload used to create a branch or a tag.
+type RefPayloadScheme struct {
+	Name    string           `json:"name,omitempty"`    // The name of the branch or the tag.
+	Target  *RefTargetScheme `json:"target,omitempty"`  // The commit the branch or the tag points to.
+	Message string           `json:"message,omitempty"` // The message of the tag, creating an annotated tag.
+}
+
+// RefTargetScheme represents the commit a new branch or tag points to.
+type RefTargetScheme struct {
+	Hash string `json:"hash,omitempty"` // The hash of the commit, or the name of a branch.
 }
@@ -0,0 +1,92 @@
+package models
+
+// BranchRestrictionPageScheme represents a paginated list of branch restrictions.
+type BranchRestrictionPageScheme struct {
+	Size     int                        `json:"size,omitempty"`     // The number of restrictions matching the request.
+	Page     int                        `json:"page,omitempty"`     // The current page number.
+	Pagelen  int                        `json:"pagelen,omitempty"`  // The number of restrictions per page.
+	Next     string                     `json:"next,omitempty"`     // The URL to the next page.
+	Previous string                     `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*BranchRestrictionScheme `json:"values,omitempty"`   // The restrictions in the current page.
+}
+
+// BranchRestrictionOptionsScheme represents the filters used to list branch restrictions.
+type BranchRestrictionOptionsScheme struct {
+	Kind    string // Filters the restrictions by kind, e.g. "push" or "require_approvals_to_merge".
+	Pattern string // Filters the restrictions by the branch pattern they apply to.
+}
+
+// BranchRestrictionScheme represents a branch restriction, also used as the payload to create or update one.
+//
+// The restriction applies to the branches matching the pattern, or to the branches of the branch type
+// of the branching model, depending on the branch match kind.
+type BranchRestrictionScheme struct {
+	Type            string                        `json:"type,omitempty"`              // The type of the restriction.
+	ID              int                           `json:"id,omitempty"`                // The identifier of the restriction.
+	Kind            string                        `json:"kind,omitempty"`              // The kind of the restriction, e.g. "push", "force" or "require_approvals_to_merge".
+	BranchMatchKind string                        `json:"branch_match_kind,omitempty"` // How the branches are matched: glob or branching_model.
+	BranchType      string                        `json:"branch_type,omitempty"`       // The branch type matched, e.g. "release", when matching the branching model.
+	Pattern         string                        `json:"pattern,omitempty"`           // The glob pattern matched, when matching a glob.
+	Value           int                           `json:"value,omitempty"`             // The value of the restriction, e.g. the number of approvals required.
+	Users           []*BitbucketAccountScheme     `json:"users,omitempty"`             // The users exempted from the restriction.
+	Groups          []*BitbucketGroupScheme       `json:"groups,omitempty"`            // The groups exempted from the restriction.
+	Links           *BranchRestrictionLinksScheme `json:"links,omitempty"`             // A collection of links related to the restriction.
+}
+
+// BranchRestrictionLinksScheme represents a collection of links related to a branch restriction.
+type BranchRestrictionLinksScheme struct {
+	Self *BitbucketLinkScheme `json:"self,omitempty"` // The link to the restriction itself.
+}
+
+// BranchingModelScheme represents the effective branching model of a repository.
+type BranchingModelScheme struct {
+	Type        string                      `json:"type,omitempty"`         // The type of the branching model.
+	Development *BranchingModelBranchScheme `json:"development,omitempty"`  // The development branch.
+	Production  *BranchingModelBranchScheme `json:"production,omitempty"`   // The production branch, when enabled.
+	BranchTypes []*BranchingModelTypeScheme `json:"branch_types,omitempty"` // The enabled branch types.
+	Links       *BranchingModelLinksScheme  `json:"links,omitempty"`        // A collection of links related to the branching model.
+}
+
+// BranchingModelBranchScheme represents the development or the production branch of a branching model.
+type BranchingModelBranchScheme struct {
+	Name          string        `json:"name,omitempty"`           // The name of the branch.
+	Branch        *BranchScheme `json:"branch,omitempty"`         // The branch, when it exists.
+	UseMainbranch bool          `json:"use_mainbranch,omitempty"` // Indicates if the main branch of the repository is used.
+}
+
+// BranchingModelTypeScheme represents a branch type of a branching model.
+type BranchingModelTypeScheme struct {
+	Kind   string `json:"kind,omitempty"`   // The kind of the branch type: feature, bugfix, release or hotfix.
+	Prefix string `json:"prefix,omitempty"` // The prefix of the branches of this type, e.g. "feature/".
+}
+
+// BranchingModelLinksScheme represents a collection of links related to a branching model.
+type BranchingModelLinksScheme struct {
+	Self *BitbucketLinkScheme `json:"self,omitempty"` // The link to the branching model itself.
+}
+
+// BranchingModelSettingsScheme represents the branching model settings of a repository, also used as the payload to update them.
+//
+// The unset attributes are left unchanged by an update.
+type BranchingModelSettingsScheme struct {
+	Type        string                              `json:"type,omitempty"`         // The type of the settings.
+	Development *BranchingModelSettingsBranchScheme `json:"development,omitempty"`  // The development branch settings.
+	Production  *BranchingModelSettingsBranchScheme `json:"production,omitempty"`   // The production branch settings.
+	BranchTypes []*BranchingModelSettingsTypeScheme `json:"branch_types,omitempty"` // The branch types settings.
+	Links       *BranchingModelLinksScheme          `json:"links,omitempty"`        // A collection of links related to the settings.
+}
+
+// BranchingModelSettingsBranchScheme represents the settings of the development or the production branch.
+type BranchingModelSettingsBranchScheme struct {
+	Name          string `json:"name,omitempty"`           // The name of the branch.
+	UseMainbranch bool   `json:"use_mainbranch,omitempty"` // Indicates if the main branch of the repository is used.
+	Enabled       *bool  `json:"enabled,omitempty"`        // Indicates if the branch is enabled, for the production branch only.
+	IsValid       bool   `json:"is_valid,omitempty"`       // Indicates if the branch exists.
+}
+
+// BranchingModelSettingsTypeScheme represents the settings of a branch type.
+type BranchingModelSettingsTypeScheme struct {
+	Kind    string `json:"kind,omitempty"`    // The kind of the branch type: feature, bugfix, release or hotfix.
+	Prefix  string `json:"prefix,omitempty"`  // The prefix of the branches of this type.
+	Enabled *bool  `json:"enabled,omitempty"` // Indicates if the branch type is enabled.
+}
@@ -0,0 +1,127 @@
+package models
+
+// CommitPageScheme represents a paginated list of commits.
+type CommitPageScheme struct {
+	Pagelen int             `json:"pagelen,omitempty"` // The number of commits per page.
+	Next    string          `json:"next,omitempty"`    // The URL to the next page.
+	Values  []*CommitScheme `json:"values,omitempty"`  // The commits in the current page.
+}
+
+// CommitOptionsScheme represents the filters used to list commits.
+type CommitOptionsScheme struct {
+	Include []string // The revisions whose ancestors are listed, the main branch by default.
+	Exclude []string // The revisions whose ancestors are excluded, e.g. the main branch to list the commits of a feature branch.
+	Path    string   // Lists the commits modifying the path only.
+}
+
+// CommitScheme represents a commit.
+type CommitScheme struct {
+	Type       string                  `json:"type,omitempty"`       // The type of the commit.
+	Hash       string                  `json:"hash,omitempty"`       // The hash of the commit.
+	Date       string                  `json:"date,omitempty"`       // The date of the commit.
+	Author     *CommitAuthorScheme     `json:"author,omitempty"`     // The author of the commit.
+	Message    string                  `json:"message,omitempty"`    // The message of the commit.
+	Summary    *BitbucketContentScheme `json:"summary,omitempty"`    // The rendered message of the commit.
+	Parents    []*CommitScheme         `json:"parents,omitempty"`    // The parents of the commit.
+	Repository *RepositoryScheme       `json:"repository,omitempty"` // The repository of the commit.
+	Links      *CommitLinksScheme      `json:"links,omitempty"`      // A collection of links related to the commit.
+}
+
+// CommitAuthorScheme represents the author of a commit.
+type CommitAuthorScheme struct {
+	Type string                  `json:"type,omitempty"` // The type of the author.
+	Raw  string                  `json:"raw,omitempty"`  // The raw author of the commit, e.g. "Jane Doe <jane@example.com>".
+	User *BitbucketAccountScheme `json:"user,omitempty"` // The Bitbucket account of the author, when it is known.
+}
+
+// CommitLinksScheme represents a collection of links related to a commit.
+type CommitLinksScheme struct {
+	Self     *BitbucketLinkScheme `json:"self,omitempty"`     // The link to the commit itself.
+	HTML     *BitbucketLinkScheme `json:"html,omitempty"`     // The link to the commit's HTML page.
+	Diff     *BitbucketLinkScheme `json:"diff,omitempty"`     // The link to the commit's diff.
+	Comments *BitbucketLinkScheme `json:"comments,omitempty"` // The link to the commit's comments.
+	Statuses *BitbucketLinkScheme `json:"statuses,omitempty"` // The link to the commit's statuses.
+}
+
+// CommitCommentPageScheme represents a paginated list of commit comments.
+type CommitCommentPageScheme struct {
+	Size     int                    `json:"size,omitempty"`     // The number of comments matching the request.
+	Page     int                    `json:"page,omitempty"`     // The current page number.
+	Pagelen  int                    `json:"pagelen,omitempty"`  // The number of comments per page.
+	Next     string                 `json:"next,omitempty"`     // The URL to the next page.
+	Previous string                 `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*CommitCommentScheme `json:"values,omitempty"`   // The comments in the current page.
+}
+
+// CommitCommentScheme represents a comment on a commit.
+type CommitCommentScheme struct {
+	Type      string                        `json:"type,omitempty"`       // The type of the comment.
+	ID        int                           `json:"id,omitempty"`         // The ID of the comment.
+	Content   *BitbucketContentScheme       `json:"content,omitempty"`    // The content of the comment.
+	User      *BitbucketAccountScheme       `json:"user,omitempty"`       // The author of the comment.
+	CreatedOn string                        `json:"created_on,omitempty"` // The creation time of the comment.
+	UpdatedOn string                        `json:"updated_on,omitempty"` // The update time of the comment.
+	Deleted   bool                          `json:"deleted,omitempty"`    // Indicates if the comment was deleted.
+	Parent    *CommitCommentReferenceScheme `json:"parent,omitempty"`     // The comment this comment replies to.
+	Inline    *CommitCommentInlineScheme    `json:"inline,omitempty"`     // The location of an inline comment.
+}
+
+// CommitCommentReferenceScheme represents a reference to a commit comment.
+type CommitCommentReferenceScheme struct {
+	ID int `json:"id,omitempty"` // The ID of the comment.
+}
+
+// CommitCommentInlineScheme represents the location of an inline commit comment.
+type CommitCommentInlineScheme struct {
+	Path string `json:"path,omitempty"` // The path of the file.
+	From int    `json:"from,omitempty"` // The line number in the old version of the file.
+	To   int    `json:"to,omitempty"`   // The line number in the new version of the file.
+}
+
+// CommitCommentPayloadScheme represents the payload used to create or update a commit comment.
+type CommitCommentPayloadScheme struct {
+	Content *BitbucketContentScheme       `json:"content,omitempty"` // The content of the comment, only the raw text is required.
+	Parent  *CommitCommentReferenceScheme `json:"parent,omitempty"`  // The comment to reply to.
+	Inline  *CommitCommentInlineScheme    `json:"inline,omitempty"`  // The location of an inline comment.
+}
+
+// CommitStatusPageScheme represents a paginated list of commit statuses.
+type CommitStatusPageScheme struct {
+	Size     int                   `json:"size,omitempty"`     // The number of statuses matching the request.
+	Page     int                   `json:"page,omitempty"`     // The current page number.
+	Pagelen  int                   `json:"pagelen,omitempty"`  // The number of statuses per page.
+	Next     string                `json:"next,omitempty"`     // The URL to the next page.
+	Previous string                `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*CommitStatusScheme `json:"values,omitempty"`   // The statuses in the current page.
+}
+
+// CommitStatusScheme represents a build status of a commit, reported by a CI system.
+type CommitStatusScheme struct {
+	Type        string                   `json:"type,omitempty"`        // The type of the status.
+	UUID        string                   `json:"uuid,omitempty"`        // The UUID of the status.
+	Key         string                   `json:"key,omitempty"`         // The key of the status, unique per commit, e.g. the name of the CI job.
+	Refname     string                   `json:"refname,omitempty"`     // The name of the reference the status applies to.
+	URL         string                   `json:"url,omitempty"`         // The URL of the build.
+	State       string                   `json:"state,omitempty"`       // The state of the build: INPROGRESS, SUCCESSFUL, FAILED or STOPPED.
+	Name        string                   `json:"name,omitempty"`        // The name of the build.
+	Description string                   `json:"description,omitempty"` // The description of the build.
+	CreatedOn   string                   `json:"created_on,omitempty"`  // The creation time of the status.
+	UpdatedOn   string                   `json:"updated_on,omitempty"`  // The update time of the status.
+	Links       *CommitStatusLinksScheme `json:"links,omitempty"`       // A collection of links related to the status.
+}
+
+// CommitStatusLinksScheme represents a collection of links related to a commit status.
+type CommitStatusLinksScheme struct {
+	Self   *BitbucketLinkScheme `json:"self,omitempty"`   // The link to the status itself.
+	Commit *BitbucketLinkScheme `json:"commit,omitempty"` // The link to the commit of the status.
+}
+
+// CommitStatusPayloadScheme represents the payload used to create or update a build status.
+type CommitStatusPayloadScheme struct {
+	Key         string `json:"key,omitempty"`         // The key of the status, required on creation.
+	State       string `json:"state,omitempty"`       // The state of the build: INPROGRESS, SUCCESSFUL, FAILED or STOPPED.
+	URL         string `json:"url,omitempty"`         // The URL of the build, required on creation.
+	Name        string `json:"name,omitempty"`        // The name of the build.
+	Description string `json:"description,omitempty"` // The description of the build.
+	Refname     string `json:"refname,omitempty"`     // The name of the reference the status applies to.
+}
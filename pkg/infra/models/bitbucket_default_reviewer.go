@@ -0,0 +1,18 @@
+package models
+
+// DefaultReviewerPageScheme represents a paginated list of default reviewers with their type.
+type DefaultReviewerPageScheme struct {
+	Size     int                      `json:"size,omitempty"`     // The number of default reviewers matching the request.
+	Page     int                      `json:"page,omitempty"`     // The current page number.
+	Pagelen  int                      `json:"pagelen,omitempty"`  // The number of default reviewers per page.
+	Next     string                   `json:"next,omitempty"`     // The URL to the next page.
+	Previous string                   `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*DefaultReviewerScheme `json:"values,omitempty"`   // The default reviewers in the current page.
+}
+
+// DefaultReviewerScheme represents a default reviewer and the level it's inherited from.
+type DefaultReviewerScheme struct {
+	Type         string                  `json:"type,omitempty"`          // The type of the default reviewer.
+	ReviewerType string                  `json:"reviewer_type,omitempty"` // The level of the default reviewer: repository or project.
+	User         *BitbucketAccountScheme `json:"user,omitempty"`          // The default reviewer.
+}
@@ -0,0 +1,120 @@
+package models
+
+// DeploymentPageScheme represents a paginated list of deployments.
+type DeploymentPageScheme struct {
+	Size     int                 `json:"size,omitempty"`     // The number of deployments matching the request.
+	Page     int                 `json:"page,omitempty"`     // The current page number.
+	Pagelen  int                 `json:"pagelen,omitempty"`  // The number of deployments per page.
+	Next     string              `json:"next,omitempty"`     // The URL to the next page.
+	Previous string              `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*DeploymentScheme `json:"values,omitempty"`   // The deployments in the current page.
+}
+
+// DeploymentScheme represents the deployment of a release to an environment.
+type DeploymentScheme struct {
+	Type           string                       `json:"type,omitempty"`             // The type of the deployment.
+	UUID           string                       `json:"uuid,omitempty"`             // The UUID of the deployment.
+	Number         int                          `json:"number,omitempty"`           // The number of the deployment.
+	Key            string                       `json:"key,omitempty"`              // The key of the deployment.
+	Version        int                          `json:"version,omitempty"`          // The version of the deployment.
+	State          *DeploymentStateScheme       `json:"state,omitempty"`            // The state of the deployment.
+	Environment    *DeploymentEnvironmentScheme `json:"environment,omitempty"`      // The environment deployed to, only the UUID is returned.
+	Release        *DeploymentReleaseScheme     `json:"release,omitempty"`          // The release deployed.
+	LastUpdateTime string                       `json:"last_update_time,omitempty"` // The last time the deployment was updated.
+}
+
+// DeploymentStateScheme represents the state of a deployment.
+type DeploymentStateScheme struct {
+	Type        string                  `json:"type,omitempty"`         // The type of the state, e.g. "deployment_state_completed".
+	Name        string                  `json:"name,omitempty"`         // The name of the state: UNDEPLOYED, IN_PROGRESS or COMPLETED.
+	Status      *DeploymentStatusScheme `json:"status,omitempty"`       // The outcome of a completed deployment.
+	URL         string                  `json:"url,omitempty"`          // The URL of the pipeline deploying the release.
+	Deployer    *BitbucketAccountScheme `json:"deployer,omitempty"`     // The user who triggered the deployment.
+	StartedOn   string                  `json:"started_on,omitempty"`   // The start time of the deployment.
+	CompletedOn string                  `json:"completed_on,omitempty"` // The completion time of the deployment.
+}
+
+// DeploymentStatusScheme represents the outcome of a completed deployment.
+type DeploymentStatusScheme struct {
+	Type string `json:"type,omitempty"` // The type of the status.
+	Name string `json:"name,omitempty"` // The name of the status: SUCCESSFUL, FAILED or STOPPED.
+}
+
+// DeploymentReleaseScheme represents the release of a deployment, the build of a commit by a pipeline.
+type DeploymentReleaseScheme struct {
+	Type      string          `json:"type,omitempty"`       // The type of the release.
+	UUID      string          `json:"uuid,omitempty"`       // The UUID of the release.
+	Name      string          `json:"name,omitempty"`       // The name of the release, e.g. the build number.
+	URL       string          `json:"url,omitempty"`        // The URL of the pipeline building the release.
+	Commit    *CommitScheme   `json:"commit,omitempty"`     // The commit of the release.
+	Pipeline  *PipelineScheme `json:"pipeline,omitempty"`   // The pipeline building the release, only the UUID is returned.
+	CreatedOn string          `json:"created_on,omitempty"` // The creation time of the release.
+}
+
+// DeploymentEnvironmentPageScheme represents a paginated list of deployment environments.
+type DeploymentEnvironmentPageScheme struct {
+	Size     int                            `json:"size,omitempty"`     // The number of environments matching the request.
+	Page     int                            `json:"page,omitempty"`     // The current page number.
+	Pagelen  int                            `json:"pagelen,omitempty"`  // The number of environments per page.
+	Next     string                         `json:"next,omitempty"`     // The URL to the next page.
+	Previous string                         `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*DeploymentEnvironmentScheme `json:"values,omitempty"`   // The environments in the current page.
+}
+
+// DeploymentEnvironmentScheme represents a deployment environment of a repository.
+type DeploymentEnvironmentScheme struct {
+	Type                   string                                   `json:"type,omitempty"`                     // The type of the environment.
+	UUID                   string                                   `json:"uuid,omitempty"`                     // The UUID of the environment.
+	Name                   string                                   `json:"name,omitempty"`                     // The name of the environment.
+	Slug                   string                                   `json:"slug,omitempty"`                     // The URL-friendly name of the environment.
+	Rank                   int                                      `json:"rank,omitempty"`                     // The position of the environment within its type.
+	Hidden                 bool                                     `json:"hidden,omitempty"`                   // Indicates if the environment is hidden from the deployments dashboard.
+	EnvironmentType        *DeploymentEnvironmentTypeScheme         `json:"environment_type,omitempty"`         // The type of the environment: Test, Staging or Production.
+	Restrictions           *DeploymentEnvironmentRestrictionsScheme `json:"restrictions,omitempty"`             // The restrictions of the deployments to the environment.
+	Lock                   *DeploymentEnvironmentLockScheme         `json:"lock,omitempty"`                     // The lock of the environment, held while a deployment is in progress.
+	EnvironmentLockEnabled bool                                     `json:"environment_lock_enabled,omitempty"` // Indicates if concurrent deployments to the environment are prevented.
+	DeploymentGateEnabled  bool                                     `json:"deployment_gate_enabled,omitempty"`  // Indicates if the deployments to the environment wait for the deployment checks.
+}
+
+// DeploymentEnvironmentTypeScheme represents the type of a deployment environment.
+type DeploymentEnvironmentTypeScheme struct {
+	Type string `json:"type,omitempty"` // The type of the environment type.
+	Name string `json:"name,omitempty"` // The name of the environment type: Test, Staging or Production.
+	Rank int    `json:"rank,omitempty"` // The position of the environment type, Test first.
+}
+
+// DeploymentEnvironmentRestrictionsScheme represents the restrictions of the deployments to an environment.
+type DeploymentEnvironmentRestrictionsScheme struct {
+	Type      string `json:"type,omitempty"`       // The type of the restrictions.
+	AdminOnly bool   `json:"admin_only,omitempty"` // Indicates if only the repository admins can deploy to the environment.
+}
+
+// DeploymentEnvironmentLockScheme represents the lock of a deployment environment.
+type DeploymentEnvironmentLockScheme struct {
+	Type string `json:"type,omitempty"` // The type of the lock.
+	Name string `json:"name,omitempty"` // The name of the lock: OPEN or LOCKED.
+}
+
+// DeploymentEnvironmentPayloadScheme represents the payload used to create a deployment environment.
+type DeploymentEnvironmentPayloadScheme struct {
+	Name            string                           `json:"name,omitempty"`             // The name of the environment, unique within the repository.
+	EnvironmentType *DeploymentEnvironmentTypeScheme `json:"environment_type,omitempty"` // The type of the environment, only the name is required.
+	Rank            int                              `json:"rank,omitempty"`             // The position of the environment within its type.
+}
+
+// DeploymentEnvironmentUpdatePayloadScheme represents the changes applied to a deployment environment.
+//
+// Only the attributes set are changed, use the pointers to turn the boolean attributes off.
+type DeploymentEnvironmentUpdatePayloadScheme struct {
+	Name                   string                                          `json:"name,omitempty"`                     // The new name of the environment.
+	Rank                   *int                                            `json:"rank,omitempty"`                     // The new position of the environment within its type.
+	Hidden                 *bool                                           `json:"hidden,omitempty"`                   // Hides or shows the environment on the deployments dashboard.
+	Restrictions           *DeploymentEnvironmentRestrictionsPayloadScheme `json:"restrictions,omitempty"`             // The new restrictions of the deployments to the environment.
+	EnvironmentLockEnabled *bool                                           `json:"environment_lock_enabled,omitempty"` // Prevents or allows concurrent deployments to the environment.
+	DeploymentGateEnabled  *bool                                           `json:"deployment_gate_enabled,omitempty"`  // Makes the deployments wait for the deployment checks or not.
+}
+
+// DeploymentEnvironmentRestrictionsPayloadScheme represents the restrictions set on a deployment environment.
+type DeploymentEnvironmentRestrictionsPayloadScheme struct {
+	AdminOnly bool `json:"admin_only"` // Restricts the deployments to the repository admins.
+}
@@ -0,0 +1,28 @@
+package models
+
+// DiffStatPageScheme represents a paginated list of diffstats.
+type DiffStatPageScheme struct {
+	Size     int               `json:"size,omitempty"`     // The number of modified files.
+	Page     int               `json:"page,omitempty"`     // The current page number.
+	Pagelen  int               `json:"pagelen,omitempty"`  // The number of diffstats per page.
+	Next     string            `json:"next,omitempty"`     // The URL to the next page.
+	Previous string            `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*DiffStatScheme `json:"values,omitempty"`   // The diffstats in the current page.
+}
+
+// DiffStatScheme represents the changes of a file between two revisions.
+type DiffStatScheme struct {
+	Type         string               `json:"type,omitempty"`          // The type of the diffstat.
+	Status       string               `json:"status,omitempty"`        // The status of the file: added, removed, modified or renamed.
+	LinesAdded   int                  `json:"lines_added,omitempty"`   // The number of lines added.
+	LinesRemoved int                  `json:"lines_removed,omitempty"` // The number of lines removed.
+	Old          *BitbucketFileScheme `json:"old,omitempty"`           // The file before the changes, unset when the file was added.
+	New          *BitbucketFileScheme `json:"new,omitempty"`           // The file after the changes, unset when the file was removed.
+}
+
+// BitbucketFileScheme represents a file of a repository.
+type BitbucketFileScheme struct {
+	Type        string `json:"type,omitempty"`         // The type of the file.
+	Path        string `json:"path,omitempty"`         // The path of the file.
+	EscapedPath string `json:"escaped_path,omitempty"` // The escaped path of the file.
+}
@@ -0,0 +1,27 @@
+package models
+
+// RepositoryDownloadPageScheme represents a paginated list of the downloads of a repository.
+type RepositoryDownloadPageScheme struct {
+	Size     int                         `json:"size,omitempty"`     // The number of downloads matching the request.
+	Page     int                         `json:"page,omitempty"`     // The current page number.
+	Pagelen  int                         `json:"pagelen,omitempty"`  // The number of downloads per page.
+	Next     string                      `json:"next,omitempty"`     // The URL to the next page.
+	Previous string                      `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*RepositoryDownloadScheme `json:"values,omitempty"`   // The downloads in the current page.
+}
+
+// RepositoryDownloadScheme represents a file uploaded to the downloads of a repository.
+type RepositoryDownloadScheme struct {
+	Type      string                         `json:"type,omitempty"`       // The type of the download.
+	Name      string                         `json:"name,omitempty"`       // The name of the file, unique within the repository.
+	Size      int64                          `json:"size,omitempty"`       // The size of the file, in bytes.
+	Downloads int                            `json:"downloads,omitempty"`  // The number of times the file was downloaded.
+	User      *BitbucketAccountScheme        `json:"user,omitempty"`       // The user who uploaded the file.
+	CreatedOn string                         `json:"created_on,omitempty"` // The upload time of the file.
+	Links     *RepositoryDownloadLinksScheme `json:"links,omitempty"`      // A collection of links related to the download.
+}
+
+// RepositoryDownloadLinksScheme represents a collection of links related to a repository download.
+type RepositoryDownloadLinksScheme struct {
+	Self *BitbucketLinkScheme `json:"self,omitempty"` // The link to the contents of the file.
+}
@@ -0,0 +1,151 @@
+package models
+
+// RepositoryIssuePageScheme represents a paginated list of repository issues.
+type RepositoryIssuePageScheme struct {
+	Size     int                      `json:"size,omitempty"`     // The number of issues matching the request.
+	Page     int                      `json:"page,omitempty"`     // The current page number.
+	Pagelen  int                      `json:"pagelen,omitempty"`  // The number of issues per page.
+	Next     string                   `json:"next,omitempty"`     // The URL to the next page.
+	Previous string                   `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*RepositoryIssueScheme `json:"values,omitempty"`   // The issues in the current page.
+}
+
+// RepositoryIssueOptionsScheme represents the filters used to list repository issues.
+type RepositoryIssueOptionsScheme struct {
+	Query string // The query used to filter the issues, e.g. `state = "new" AND priority = "major"`.
+	Sort  string // The field used to sort the issues, e.g. "-updated_on".
+}
+
+// RepositoryIssueScheme represents an issue of the issue tracker of a repository.
+type RepositoryIssueScheme struct {
+	Type      string                      `json:"type,omitempty"`       // The type of the issue.
+	ID        int                         `json:"id,omitempty"`         // The ID of the issue, unique within the repository.
+	Title     string                      `json:"title,omitempty"`      // The title of the issue.
+	Content   *BitbucketContentScheme     `json:"content,omitempty"`    // The description of the issue.
+	Kind      string                      `json:"kind,omitempty"`       // The kind of the issue: bug, enhancement, proposal or task.
+	Priority  string                      `json:"priority,omitempty"`   // The priority of the issue: trivial, minor, major, critical or blocker.
+	State     string                      `json:"state,omitempty"`      // The state of the issue, e.g. new, open, resolved or closed.
+	Reporter  *BitbucketAccountScheme     `json:"reporter,omitempty"`   // The user who reported the issue.
+	Assignee  *BitbucketAccountScheme     `json:"assignee,omitempty"`   // The user assigned to the issue.
+	Component *RepositoryIssueNameScheme  `json:"component,omitempty"`  // The component of the issue.
+	Milestone *RepositoryIssueNameScheme  `json:"milestone,omitempty"`  // The milestone of the issue.
+	Version   *RepositoryIssueNameScheme  `json:"version,omitempty"`    // The version of the issue.
+	Votes     int                         `json:"votes,omitempty"`      // The number of votes on the issue.
+	Watches   int                         `json:"watches,omitempty"`    // The number of users watching the issue.
+	CreatedOn string                      `json:"created_on,omitempty"` // The creation time of the issue.
+	UpdatedOn string                      `json:"updated_on,omitempty"` // The update time of the issue.
+	EditedOn  string                      `json:"edited_on,omitempty"`  // The last time the content of the issue was edited.
+	Links     *RepositoryIssueLinksScheme `json:"links,omitempty"`      // A collection of links related to the issue.
+}
+
+// RepositoryIssueNameScheme represents a component, a milestone or a version of an issue tracker.
+type RepositoryIssueNameScheme struct {
+	ID   int    `json:"id,omitempty"`   // The ID of the component, milestone or version.
+	Name string `json:"name,omitempty"` // The name of the component, milestone or version.
+}
+
+// RepositoryIssueLinksScheme represents a collection of links related to a repository issue.
+type RepositoryIssueLinksScheme struct {
+	Self        *BitbucketLinkScheme `json:"self,omitempty"`        // The link to the issue itself.
+	HTML        *BitbucketLinkScheme `json:"html,omitempty"`        // The link to the issue's HTML page.
+	Comments    *BitbucketLinkScheme `json:"comments,omitempty"`    // The link to the comments of the issue.
+	Attachments *BitbucketLinkScheme `json:"attachments,omitempty"` // The link to the attachments of the issue.
+	Watch       *BitbucketLinkScheme `json:"watch,omitempty"`       // The link to watch the issue.
+	Vote        *BitbucketLinkScheme `json:"vote,omitempty"`        // The link to vote for the issue.
+}
+
+// RepositoryIssuePayloadScheme represents the payload used to create or update a repository issue.
+type RepositoryIssuePayloadScheme struct {
+	Title     string                     `json:"title,omitempty"`     // The title of the issue, required on creation.
+	Content   *BitbucketContentScheme    `json:"content,omitempty"`   // The description of the issue, only the raw text is required.
+	Kind      string                     `json:"kind,omitempty"`      // The kind of the issue: bug, enhancement, proposal or task.
+	Priority  string                     `json:"priority,omitempty"`  // The priority of the issue: trivial, minor, major, critical or blocker.
+	State     string                     `json:"state,omitempty"`     // The state of the issue, e.g. new, open, resolved or closed.
+	Assignee  *BitbucketAccountScheme    `json:"assignee,omitempty"`  // The user to assign the issue to, only the account ID is required.
+	Component *RepositoryIssueNameScheme `json:"component,omitempty"` // The component of the issue, only the name is required.
+	Milestone *RepositoryIssueNameScheme `json:"milestone,omitempty"` // The milestone of the issue, only the name is required.
+	Version   *RepositoryIssueNameScheme `json:"version,omitempty"`   // The version of the issue, only the name is required.
+}
+
+// RepositoryIssueCommentPageScheme represents a paginated list of repository issue comments.
+type RepositoryIssueCommentPageScheme struct {
+	Size     int                             `json:"size,omitempty"`     // The number of comments matching the request.
+	Page     int                             `json:"page,omitempty"`     // The current page number.
+	Pagelen  int                             `json:"pagelen,omitempty"`  // The number of comments per page.
+	Next     string                          `json:"next,omitempty"`     // The URL to the next page.
+	Previous string                          `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*RepositoryIssueCommentScheme `json:"values,omitempty"`   // The comments in the current page.
+}
+
+// RepositoryIssueCommentScheme represents a comment on a repository issue.
+type RepositoryIssueCommentScheme struct {
+	Type      string                         `json:"type,omitempty"`       // The type of the comment.
+	ID        int                            `json:"id,omitempty"`         // The ID of the comment.
+	Content   *BitbucketContentScheme        `json:"content,omitempty"`    // The content of the comment.
+	User      *BitbucketAccountScheme        `json:"user,omitempty"`       // The author of the comment.
+	CreatedOn string                         `json:"created_on,omitempty"` // The creation time of the comment.
+	UpdatedOn string                         `json:"updated_on,omitempty"` // The update time of the comment.
+	Links     *PullRequestCommentLinksScheme `json:"links,omitempty"`      // A collection of links related to the comment.
+}
+
+// RepositoryIssueCommentPayloadScheme represents the payload used to create or update a repository issue comment.
+type RepositoryIssueCommentPayloadScheme struct {
+	Content *BitbucketContentScheme `json:"content,omitempty"` // The content of the comment, only the raw text is required.
+}
+
+// RepositoryIssueAttachmentPageScheme represents a paginated list of repository issue attachments.
+type RepositoryIssueAttachmentPageScheme struct {
+	Size     int                                `json:"size,omitempty"`     // The number of attachments matching the request.
+	Page     int                                `json:"page,omitempty"`     // The current page number.
+	Pagelen  int                                `json:"pagelen,omitempty"`  // The number of attachments per page.
+	Next     string                             `json:"next,omitempty"`     // The URL to the next page.
+	Previous string                             `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*RepositoryIssueAttachmentScheme `json:"values,omitempty"`   // The attachments in the current page.
+}
+
+// RepositoryIssueAttachmentScheme represents a file attached to a repository issue.
+type RepositoryIssueAttachmentScheme struct {
+	Type  string                                `json:"type,omitempty"`  // The type of the attachment.
+	Name  string                                `json:"name,omitempty"`  // The name of the attachment, unique within the issue.
+	Links *RepositoryIssueAttachmentLinksScheme `json:"links,omitempty"` // A collection of links related to the attachment.
+}
+
+// RepositoryIssueAttachmentLinksScheme represents a collection of links related to a repository issue attachment.
+type RepositoryIssueAttachmentLinksScheme struct {
+	Self *BitbucketLinkScheme `json:"self,omitempty"` // The link to the contents of the attachment.
+}
+
+// RepositoryIssueChangePageScheme represents a paginated list of repository issue changes.
+type RepositoryIssueChangePageScheme struct {
+	Size     int                            `json:"size,omitempty"`     // The number of changes matching the request.
+	Page     int                            `json:"page,omitempty"`     // The current page number.
+	Pagelen  int                            `json:"pagelen,omitempty"`  // The number of changes per page.
+	Next     string                         `json:"next,omitempty"`     // The URL to the next page.
+	Previous string                         `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*RepositoryIssueChangeScheme `json:"values,omitempty"`   // The changes in the current page.
+}
+
+// RepositoryIssueChangeScheme represents a change of the attributes of a repository issue, e.g. a state transition.
+type RepositoryIssueChangeScheme struct {
+	Type      string                                  `json:"type,omitempty"`       // The type of the change.
+	ID        int                                     `json:"id,omitempty"`         // The ID of the change.
+	Name      string                                  `json:"name,omitempty"`       // The name of the change.
+	Message   *BitbucketContentScheme                 `json:"message,omitempty"`    // The message describing the change, posted as a comment.
+	User      *BitbucketAccountScheme                 `json:"user,omitempty"`       // The user who made the change.
+	CreatedOn string                                  `json:"created_on,omitempty"` // The time of the change.
+	Changes   map[string]*RepositoryIssueChangeValues `json:"changes,omitempty"`    // The attributes changed, keyed by name, e.g. "state" or "assignee_account_id".
+}
+
+// RepositoryIssueChangeValues represents the old and the new value of an attribute changed on a repository issue.
+type RepositoryIssueChangeValues struct {
+	Old string `json:"old,omitempty"` // The value before the change.
+	New string `json:"new,omitempty"` // The value after the change.
+}
+
+// RepositoryIssueChangePayloadScheme represents the payload used to change the attributes of a repository issue.
+//
+// Only the new values of the changed attributes are required, e.g. {"state": {"new": "resolved"}}.
+type RepositoryIssueChangePayloadScheme struct {
+	Message *BitbucketContentScheme                 `json:"message,omitempty"` // The message describing the change, posted as a comment.
+	Changes map[string]*RepositoryIssueChangeValues `json:"changes,omitempty"` // The attributes to change, keyed by name.
+}
@@ -0,0 +1,59 @@
+package models
+
+// DeployKeyPageScheme represents a paginated list of deploy keys.
+type DeployKeyPageScheme struct {
+	Size     int                `json:"size,omitempty"`     // The number of keys matching the request.
+	Page     int                `json:"page,omitempty"`     // The current page number.
+	Pagelen  int                `json:"pagelen,omitempty"`  // The number of keys per page.
+	Next     string             `json:"next,omitempty"`     // The URL to the next page.
+	Previous string             `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*DeployKeyScheme `json:"values,omitempty"`   // The keys in the current page.
+}
+
+// DeployKeyScheme represents a deploy key, an SSH key granting a read-only access to a repository.
+type DeployKeyScheme struct {
+	Type       string                  `json:"type,omitempty"`       // The type of the key.
+	ID         int                     `json:"id,omitempty"`         // The identifier of the key.
+	Key        string                  `json:"key,omitempty"`        // The public key value.
+	Label      string                  `json:"label,omitempty"`      // The label of the key.
+	Comment    string                  `json:"comment,omitempty"`    // The comment parsed from the public key.
+	CreatedOn  string                  `json:"created_on,omitempty"` // The creation time of the key.
+	LastUsed   string                  `json:"last_used,omitempty"`  // The last time the key was used.
+	Repository *RepositoryScheme       `json:"repository,omitempty"` // The repository the key grants an access to.
+	Owner      *BitbucketAccountScheme `json:"owner,omitempty"`      // The account who added the key.
+	Links      *KeyLinksScheme         `json:"links,omitempty"`      // A collection of links related to the key.
+}
+
+// SSHKeyPageScheme represents a paginated list of the SSH keys of a user.
+type SSHKeyPageScheme struct {
+	Size     int             `json:"size,omitempty"`     // The number of keys matching the request.
+	Page     int             `json:"page,omitempty"`     // The current page number.
+	Pagelen  int             `json:"pagelen,omitempty"`  // The number of keys per page.
+	Next     string          `json:"next,omitempty"`     // The URL to the next page.
+	Previous string          `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*SSHKeyScheme `json:"values,omitempty"`   // The keys in the current page.
+}
+
+// SSHKeyScheme represents an SSH key of a user.
+type SSHKeyScheme struct {
+	Type      string                  `json:"type,omitempty"`       // The type of the key.
+	UUID      string                  `json:"uuid,omitempty"`       // The unique identifier of the key.
+	Key       string                  `json:"key,omitempty"`        // The public key value.
+	Label     string                  `json:"label,omitempty"`      // The label of the key.
+	Comment   string                  `json:"comment,omitempty"`    // The comment parsed from the public key.
+	CreatedOn string                  `json:"created_on,omitempty"` // The creation time of the key.
+	LastUsed  string                  `json:"last_used,omitempty"`  // The last time the key was used.
+	Owner     *BitbucketAccountScheme `json:"owner,omitempty"`      // The user owning the key.
+	Links     *KeyLinksScheme         `json:"links,omitempty"`      // A collection of links related to the key.
+}
+
+// KeyLinksScheme represents a collection of links related to a key.
+type KeyLinksScheme struct {
+	Self *BitbucketLinkScheme `json:"self,omitempty"` // The link to the key itself.
+}
+
+// KeyPayloadScheme represents the payload used to add or update a deploy key or an SSH key.
+type KeyPayloadScheme struct {
+	Key   string `json:"key,omitempty"`   // The public key value, in the OpenSSH format.
+	Label string `json:"label,omitempty"` // The label of the key.
+}
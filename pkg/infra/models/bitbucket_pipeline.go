@@ -0,0 +1,143 @@
+package models
+
+// PipelinePageScheme represents a paginated list of pipelines.
+type PipelinePageScheme struct {
+	Size     int               `json:"size,omitempty"`     // The number of pipelines matching the request.
+	Page     int               `json:"page,omitempty"`     // The current page number.
+	Pagelen  int               `json:"pagelen,omitempty"`  // The number of pipelines per page.
+	Next     string            `json:"next,omitempty"`     // The URL to the next page.
+	Previous string            `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*PipelineScheme `json:"values,omitempty"`   // The pipelines in the current page.
+}
+
+// PipelineOptionsScheme represents the filters used to list pipelines.
+type PipelineOptionsScheme struct {
+	Branch      string // Filters the pipelines by the name of the target branch.
+	Status      string // Filters the pipelines by status, e.g. "PENDING", "IN_PROGRESS" or "COMPLETED".
+	TriggerType string // Filters the pipelines by trigger type, e.g. "PUSH", "MANUAL" or "SCHEDULED".
+	Sort        string // The field used to sort the pipelines, e.g. "-created_on".
+}
+
+// PipelineScheme represents a pipeline.
+type PipelineScheme struct {
+	Type              string                    `json:"type,omitempty"`                // The type of the pipeline.
+	UUID              string                    `json:"uuid,omitempty"`                // The UUID of the pipeline.
+	BuildNumber       int                       `json:"build_number,omitempty"`        // The build number of the pipeline.
+	RunNumber         int                       `json:"run_number,omitempty"`          // The run number of the pipeline, incremented when it is rerun.
+	Creator           *BitbucketAccountScheme   `json:"creator,omitempty"`             // The user who created the pipeline.
+	Repository        *RepositoryScheme         `json:"repository,omitempty"`          // The repository of the pipeline.
+	Target            *PipelineTargetScheme     `json:"target,omitempty"`              // The target of the pipeline.
+	Trigger           *PipelineTriggerScheme    `json:"trigger,omitempty"`             // The trigger of the pipeline.
+	State             *PipelineStateScheme      `json:"state,omitempty"`               // The state of the pipeline.
+	Variables         []*PipelineVariableScheme `json:"variables,omitempty"`           // The variables the pipeline was triggered with.
+	CreatedOn         string                    `json:"created_on,omitempty"`          // The creation time of the pipeline.
+	CompletedOn       string                    `json:"completed_on,omitempty"`        // The completion time of the pipeline.
+	BuildSecondsUsed  int                       `json:"build_seconds_used,omitempty"`  // The number of build seconds used by the pipeline.
+	DurationInSeconds int                       `json:"duration_in_seconds,omitempty"` // The duration of the pipeline.
+}
+
+// PipelineTargetScheme represents the target of a pipeline, the reference and the commit it runs on.
+type PipelineTargetScheme struct {
+	Type     string                  `json:"type,omitempty"`     // The type of the target, e.g. "pipeline_ref_target" or "pipeline_commit_target".
+	RefType  string                  `json:"ref_type,omitempty"` // The type of the reference, e.g. "branch" or "tag".
+	RefName  string                  `json:"ref_name,omitempty"` // The name of the reference.
+	Selector *PipelineSelectorScheme `json:"selector,omitempty"` // The pipeline definition to run, the default one when unset.
+	Commit   *PipelineCommitScheme   `json:"commit,omitempty"`   // The commit to run the pipeline on, the head of the reference when unset.
+}
+
+// PipelineSelectorScheme represents the selector of a pipeline definition.
+type PipelineSelectorScheme struct {
+	Type    string `json:"type,omitempty"`    // The type of the definition, e.g. "custom", "branches" or "tags".
+	Pattern string `json:"pattern,omitempty"` // The name of the definition, e.g. the name of a custom pipeline.
+}
+
+// PipelineCommitScheme represents the commit of a pipeline target.
+type PipelineCommitScheme struct {
+	Type string `json:"type,omitempty"` // The type of the commit.
+	Hash string `json:"hash,omitempty"` // The hash of the commit.
+}
+
+// PipelineTriggerScheme represents the trigger of a pipeline.
+type PipelineTriggerScheme struct {
+	Type string `json:"type,omitempty"` // The type of the trigger.
+	Name string `json:"name,omitempty"` // The name of the trigger, e.g. "PUSH", "MANUAL" or "SCHEDULE".
+}
+
+// PipelineStateScheme represents the state of a pipeline or a step.
+type PipelineStateScheme struct {
+	Type   string                   `json:"type,omitempty"`   // The type of the state.
+	Name   string                   `json:"name,omitempty"`   // The name of the state: PENDING, IN_PROGRESS or COMPLETED.
+	Result *PipelineStateNameScheme `json:"result,omitempty"` // The result, once completed, e.g. SUCCESSFUL, FAILED, ERROR or STOPPED.
+	Stage  *PipelineStateNameScheme `json:"stage,omitempty"`  // The stage, while in progress, e.g. RUNNING or PAUSED.
+}
+
+// PipelineStateNameScheme represents the result or the stage of a pipeline state.
+type PipelineStateNameScheme struct {
+	Type string `json:"type,omitempty"` // The type of the result or the stage.
+	Name string `json:"name,omitempty"` // The name of the result or the stage.
+}
+
+// PipelinePayloadScheme represents the payload used to trigger a pipeline.
+type PipelinePayloadScheme struct {
+	Target    *PipelineTargetScheme     `json:"target,omitempty"`    // The target of the pipeline.
+	Variables []*PipelineVariableScheme `json:"variables,omitempty"` // The variables of the pipeline, used by the custom pipelines.
+}
+
+// PipelineStepPageScheme represents a paginated list of pipeline steps.
+type PipelineStepPageScheme struct {
+	Size     int                   `json:"size,omitempty"`     // The number of steps matching the request.
+	Page     int                   `json:"page,omitempty"`     // The current page number.
+	Pagelen  int                   `json:"pagelen,omitempty"`  // The number of steps per page.
+	Next     string                `json:"next,omitempty"`     // The URL to the next page.
+	Previous string                `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*PipelineStepScheme `json:"values,omitempty"`   // The steps in the current page.
+}
+
+// PipelineStepScheme represents a step of a pipeline.
+type PipelineStepScheme struct {
+	Type              string                   `json:"type,omitempty"`                // The type of the step.
+	UUID              string                   `json:"uuid,omitempty"`                // The UUID of the step.
+	Name              string                   `json:"name,omitempty"`                // The name of the step.
+	State             *PipelineStateScheme     `json:"state,omitempty"`               // The state of the step.
+	Image             *PipelineImageScheme     `json:"image,omitempty"`               // The Docker image the step runs in.
+	SetupCommands     []*PipelineCommandScheme `json:"setup_commands,omitempty"`      // The commands preparing the step.
+	ScriptCommands    []*PipelineCommandScheme `json:"script_commands,omitempty"`     // The commands of the step script.
+	StartedOn         string                   `json:"started_on,omitempty"`          // The start time of the step.
+	CompletedOn       string                   `json:"completed_on,omitempty"`        // The completion time of the step.
+	MaxTime           int                      `json:"max_time,omitempty"`            // The maximum duration of the step, in minutes.
+	DurationInSeconds int                      `json:"duration_in_seconds,omitempty"` // The duration of the step.
+	BuildSecondsUsed  int                      `json:"build_seconds_used,omitempty"`  // The number of build seconds used by the step.
+	RunNumber         int                      `json:"run_number,omitempty"`          // The run number of the step.
+}
+
+// PipelineImageScheme represents the Docker image of a pipeline step.
+type PipelineImageScheme struct {
+	Name string `json:"name,omitempty"` // The name of the image.
+}
+
+// PipelineCommandScheme represents a command of a pipeline step.
+type PipelineCommandScheme struct {
+	Name    string `json:"name,omitempty"`    // The name of the command.
+	Command string `json:"command,omitempty"` // The command line.
+}
+
+// PipelineVariablePageScheme represents a paginated list of pipeline variables.
+type PipelineVariablePageScheme struct {
+	Size     int                       `json:"size,omitempty"`     // The number of variables matching the request.
+	Page     int                       `json:"page,omitempty"`     // The current page number.
+	Pagelen  int                       `json:"pagelen,omitempty"`  // The number of variables per page.
+	Next     string                    `json:"next,omitempty"`     // The URL to the next page.
+	Previous string                    `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*PipelineVariableScheme `json:"values,omitempty"`   // The variables in the current page.
+}
+
+// PipelineVariableScheme represents a pipeline variable.
+//
+// The value of a secured variable is write-only, it isn't returned by Bitbucket.
+type PipelineVariableScheme struct {
+	Type    string `json:"type,omitempty"`    // The type of the variable.
+	UUID    string `json:"uuid,omitempty"`    // The UUID of the variable.
+	Key     string `json:"key,omitempty"`     // The name of the variable.
+	Value   string `json:"value,omitempty"`   // The value of the variable, unset when the variable is secured.
+	Secured bool   `json:"secured,omitempty"` // Indicates if the variable is secured.
+}
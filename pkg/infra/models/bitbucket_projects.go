@@ -29,3 +29,54 @@ type BitbucketProjectLinksScheme struct {
 	HTML   *BitbucketLinkScheme `json:"html,omitempty"`   // The HTML link of the project.
 	Avatar *BitbucketLinkScheme `json:"avatar,omitempty"` // The avatar link of the project.
 }
+
+// BitbucketProjectPayloadScheme represents the payload used to create or update a Bitbucket project.
+type BitbucketProjectPayloadScheme struct {
+	Key         string `json:"key,omitempty"`         // The key of the project, required on creation.
+	Name        string `json:"name,omitempty"`        // The name of the project, required on creation.
+	Description string `json:"description,omitempty"` // The description of the project.
+	IsPrivate   *bool  `json:"is_private,omitempty"`  // Whether the project is private, the projects are private by default.
+}
+
+// ProjectGroupPermissionPageScheme represents a paginated list of the group permissions of a project.
+type ProjectGroupPermissionPageScheme struct {
+	Size     int                             `json:"size,omitempty"`     // The number of permissions matching the request.
+	Page     int                             `json:"page,omitempty"`     // The current page number.
+	Pagelen  int                             `json:"pagelen,omitempty"`  // The number of permissions per page.
+	Next     string                          `json:"next,omitempty"`     // The URL to the next page.
+	Previous string                          `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*ProjectGroupPermissionScheme `json:"values,omitempty"`   // The permissions in the current page.
+}
+
+// ProjectGroupPermissionScheme represents the permission granted to a group on a project.
+type ProjectGroupPermissionScheme struct {
+	Type       string                           `json:"type,omitempty"`       // The type of the permission.
+	Permission string                           `json:"permission,omitempty"` // The level of the permission: read, write, create-repo or admin.
+	Group      *BitbucketGroupScheme            `json:"group,omitempty"`      // The group granted with the permission.
+	Project    *BitbucketProjectScheme          `json:"project,omitempty"`    // The project to which the permission applies.
+	Links      *RepositoryPermissionLinksScheme `json:"links,omitempty"`      // A collection of links related to the permission.
+}
+
+// ProjectUserPermissionPageScheme represents a paginated list of the user permissions of a project.
+type ProjectUserPermissionPageScheme struct {
+	Size     int                            `json:"size,omitempty"`     // The number of permissions matching the request.
+	Page     int                            `json:"page,omitempty"`     // The current page number.
+	Pagelen  int                            `json:"pagelen,omitempty"`  // The number of permissions per page.
+	Next     string                         `json:"next,omitempty"`     // The URL to the next page.
+	Previous string                         `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*ProjectUserPermissionScheme `json:"values,omitempty"`   // The permissions in the current page.
+}
+
+// ProjectUserPermissionScheme represents the permission granted explicitly to a user on a project.
+type ProjectUserPermissionScheme struct {
+	Type       string                           `json:"type,omitempty"`       // The type of the permission.
+	Permission string                           `json:"permission,omitempty"` // The level of the permission: read, write, create-repo or admin.
+	User       *BitbucketAccountScheme          `json:"user,omitempty"`       // The user granted with the permission.
+	Project    *BitbucketProjectScheme          `json:"project,omitempty"`    // The project to which the permission applies.
+	Links      *RepositoryPermissionLinksScheme `json:"links,omitempty"`      // A collection of links related to the permission.
+}
+
+// ProjectPermissionPayloadScheme represents the payload used to grant a permission on a project.
+type ProjectPermissionPayloadScheme struct {
+	Permission string `json:"permission,omitempty"` // The level of the permission: read, write, create-repo or admin.
+}
@@ -0,0 +1,235 @@
+package models
+
+// PullRequestPageScheme represents a paginated list of pull requests.
+type PullRequestPageScheme struct {
+	Size     int                  `json:"size,omitempty"`     // The number of pull requests matching the request.
+	Page     int                  `json:"page,omitempty"`     // The current page number.
+	Pagelen  int                  `json:"pagelen,omitempty"`  // The number of pull requests per page.
+	Next     string               `json:"next,omitempty"`     // The URL to the next page.
+	Previous string               `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*PullRequestScheme `json:"values,omitempty"`   // The pull requests in the current page.
+}
+
+// PullRequestOptionsScheme represents the filters used to list pull requests.
+type PullRequestOptionsScheme struct {
+	States []string // Filters the pull requests by state: OPEN, MERGED, DECLINED or SUPERSEDED. Only the open ones are returned by default.
+	Query  string   // The query used to filter the pull requests, e.g. `author.nickname = "jdoe"`.
+	Sort   string   // The field used to sort the pull requests, e.g. "-updated_on".
+}
+
+// PullRequestScheme represents a pull request.
+type PullRequestScheme struct {
+	Type              string                          `json:"type,omitempty"`                // The type of the pull request.
+	ID                int                             `json:"id,omitempty"`                  // The ID of the pull request, unique within the repository.
+	Title             string                          `json:"title,omitempty"`               // The title of the pull request.
+	Description       string                          `json:"description,omitempty"`         // The description of the pull request.
+	Summary           *BitbucketContentScheme         `json:"summary,omitempty"`             // The rendered description of the pull request.
+	State             string                          `json:"state,omitempty"`               // The state of the pull request: OPEN, MERGED, DECLINED or SUPERSEDED.
+	Draft             bool                            `json:"draft,omitempty"`               // Indicates if the pull request is a draft.
+	Author            *BitbucketAccountScheme         `json:"author,omitempty"`              // The author of the pull request.
+	Source            *PullRequestEndpointScheme      `json:"source,omitempty"`              // The source branch of the pull request.
+	Destination       *PullRequestEndpointScheme      `json:"destination,omitempty"`         // The destination branch of the pull request.
+	MergeCommit       *PullRequestCommitScheme        `json:"merge_commit,omitempty"`        // The merge commit, once the pull request is merged.
+	CommentCount      int                             `json:"comment_count,omitempty"`       // The number of comments on the pull request.
+	TaskCount         int                             `json:"task_count,omitempty"`          // The number of open tasks on the pull request.
+	CloseSourceBranch bool                            `json:"close_source_branch,omitempty"` // Indicates if the source branch is closed when the pull request is merged.
+	ClosedBy          *BitbucketAccountScheme         `json:"closed_by,omitempty"`           // The user who merged or declined the pull request.
+	Reason            string                          `json:"reason,omitempty"`              // The reason the pull request was declined.
+	CreatedOn         string                          `json:"created_on,omitempty"`          // The creation time of the pull request.
+	UpdatedOn         string                          `json:"updated_on,omitempty"`          // The update time of the pull request.
+	Reviewers         []*BitbucketAccountScheme       `json:"reviewers,omitempty"`           // The reviewers of the pull request.
+	Participants      []*PullRequestParticipantScheme `json:"participants,omitempty"`        // The participants of the pull request, reviewers included.
+	Links             *PullRequestLinksScheme         `json:"links,omitempty"`               // A collection of links related to the pull request.
+}
+
+// PullRequestEndpointScheme represents the source or the destination of a pull request.
+type PullRequestEndpointScheme struct {
+	Repository *RepositoryScheme        `json:"repository,omitempty"` // The repository of the branch, only the full name is required for the forks.
+	Branch     *PullRequestBranchScheme `json:"branch,omitempty"`     // The branch.
+	Commit     *PullRequestCommitScheme `json:"commit,omitempty"`     // The head commit of the branch.
+}
+
+// PullRequestBranchScheme represents the branch of a pull request endpoint.
+type PullRequestBranchScheme struct {
+	Name                 string   `json:"name,omitempty"`                   // The name of the branch.
+	MergeStrategies      []string `json:"merge_strategies,omitempty"`       // The merge strategies available for the branch.
+	DefaultMergeStrategy string   `json:"default_merge_strategy,omitempty"` // The default merge strategy of the branch.
+}
+
+// PullRequestCommitScheme represents a commit referenced by a pull request.
+type PullRequestCommitScheme struct {
+	Type string `json:"type,omitempty"` // The type of the commit.
+	Hash string `json:"hash,omitempty"` // The hash of the commit.
+}
+
+// PullRequestParticipantScheme represents a participant of a pull request.
+type PullRequestParticipantScheme struct {
+	Type           string                  `json:"type,omitempty"`            // The type of the participant.
+	User           *BitbucketAccountScheme `json:"user,omitempty"`            // The participant.
+	Role           string                  `json:"role,omitempty"`            // The role of the participant: PARTICIPANT or REVIEWER.
+	Approved       bool                    `json:"approved,omitempty"`        // Indicates if the participant approved the pull request.
+	State          string                  `json:"state,omitempty"`           // The review state: approved, changes_requested or empty.
+	ParticipatedOn string                  `json:"participated_on,omitempty"` // The time of the last participation.
+}
+
+// PullRequestLinksScheme represents a collection of links related to a pull request.
+type PullRequestLinksScheme struct {
+	Self     *BitbucketLinkScheme `json:"self,omitempty"`     // The link to the pull request itself.
+	HTML     *BitbucketLinkScheme `json:"html,omitempty"`     // The link to the pull request's HTML page.
+	Commits  *BitbucketLinkScheme `json:"commits,omitempty"`  // The link to the pull request's commits.
+	Approve  *BitbucketLinkScheme `json:"approve,omitempty"`  // The link to approve the pull request.
+	Diff     *BitbucketLinkScheme `json:"diff,omitempty"`     // The link to the pull request's diff.
+	DiffStat *BitbucketLinkScheme `json:"diffstat,omitempty"` // The link to the pull request's diffstat.
+	Comments *BitbucketLinkScheme `json:"comments,omitempty"` // The link to the pull request's comments.
+	Activity *BitbucketLinkScheme `json:"activity,omitempty"` // The link to the pull request's activity.
+	Merge    *BitbucketLinkScheme `json:"merge,omitempty"`    // The link to merge the pull request.
+	Decline  *BitbucketLinkScheme `json:"decline,omitempty"`  // The link to decline the pull request.
+}
+
+// PullRequestPayloadScheme represents the payload used to create or update a pull request.
+type PullRequestPayloadScheme struct {
+	Title             string                     `json:"title,omitempty"`               // The title of the pull request.
+	Description       string                     `json:"description,omitempty"`         // The description of the pull request.
+	Source            *PullRequestEndpointScheme `json:"source,omitempty"`              // The source branch, required on creation.
+	Destination       *PullRequestEndpointScheme `json:"destination,omitempty"`         // The destination branch, the main branch of the repository by default.
+	Reviewers         []*BitbucketAccountScheme  `json:"reviewers,omitempty"`           // The reviewers, only the UUID is required.
+	CloseSourceBranch bool                       `json:"close_source_branch,omitempty"` // Indicates if the source branch is closed when the pull request is merged.
+	Draft             bool                       `json:"draft,omitempty"`               // Indicates if the pull request is a draft.
+}
+
+// PullRequestMergePayloadScheme represents the payload used to merge a pull request.
+type PullRequestMergePayloadScheme struct {
+	Type              string `json:"type,omitempty"`                // The type of the payload, e.g. "pullrequest_merge_parameters".
+	Message           string `json:"message,omitempty"`             // The message of the merge commit.
+	CloseSourceBranch bool   `json:"close_source_branch,omitempty"` // Indicates if the source branch is closed.
+	MergeStrategy     string `json:"merge_strategy,omitempty"`      // The merge strategy, e.g. merge_commit, squash or fast_forward.
+}
+
+// PullRequestActivityPageScheme represents a paginated list of pull request activities.
+type PullRequestActivityPageScheme struct {
+	Pagelen int                          `json:"pagelen,omitempty"` // The number of activities per page.
+	Next    string                       `json:"next,omitempty"`    // The URL to the next page.
+	Values  []*PullRequestActivityScheme `json:"values,omitempty"`  // The activities in the current page.
+}
+
+// PullRequestActivityScheme represents an activity of a pull request, only one of the update,
+// approval, changes requested and comment attributes is set.
+type PullRequestActivityScheme struct {
+	PullRequest      *PullRequestScheme               `json:"pull_request,omitempty"`      // The pull request.
+	Update           *PullRequestUpdateActivityScheme `json:"update,omitempty"`            // The update of the pull request.
+	Approval         *PullRequestReviewScheme         `json:"approval,omitempty"`          // The approval of the pull request.
+	ChangesRequested *PullRequestReviewScheme         `json:"changes_requested,omitempty"` // The changes requested on the pull request.
+	Comment          *PullRequestCommentScheme        `json:"comment,omitempty"`           // The comment added to the pull request.
+}
+
+// PullRequestUpdateActivityScheme represents an update of a pull request.
+type PullRequestUpdateActivityScheme struct {
+	State       string                     `json:"state,omitempty"`       // The state of the pull request after the update.
+	Title       string                     `json:"title,omitempty"`       // The title of the pull request after the update.
+	Description string                     `json:"description,omitempty"` // The description of the pull request after the update.
+	Reason      string                     `json:"reason,omitempty"`      // The reason of the update.
+	Author      *BitbucketAccountScheme    `json:"author,omitempty"`      // The user who updated the pull request.
+	Date        string                     `json:"date,omitempty"`        // The time of the update.
+	Source      *PullRequestEndpointScheme `json:"source,omitempty"`      // The source branch after the update.
+	Destination *PullRequestEndpointScheme `json:"destination,omitempty"` // The destination branch after the update.
+}
+
+// PullRequestReviewScheme represents the approval or the changes requested by a reviewer.
+type PullRequestReviewScheme struct {
+	Date string                  `json:"date,omitempty"` // The time of the review.
+	User *BitbucketAccountScheme `json:"user,omitempty"` // The reviewer.
+}
+
+// PullRequestCommentPageScheme represents a paginated list of pull request comments.
+type PullRequestCommentPageScheme struct {
+	Size     int                         `json:"size,omitempty"`     // The number of comments matching the request.
+	Page     int                         `json:"page,omitempty"`     // The current page number.
+	Pagelen  int                         `json:"pagelen,omitempty"`  // The number of comments per page.
+	Next     string                      `json:"next,omitempty"`     // The URL to the next page.
+	Previous string                      `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*PullRequestCommentScheme `json:"values,omitempty"`   // The comments in the current page.
+}
+
+// PullRequestCommentScheme represents a comment on a pull request.
+type PullRequestCommentScheme struct {
+	Type       string                              `json:"type,omitempty"`       // The type of the comment.
+	ID         int                                 `json:"id,omitempty"`         // The ID of the comment.
+	Content    *BitbucketContentScheme             `json:"content,omitempty"`    // The content of the comment.
+	User       *BitbucketAccountScheme             `json:"user,omitempty"`       // The author of the comment.
+	CreatedOn  string                              `json:"created_on,omitempty"` // The creation time of the comment.
+	UpdatedOn  string                              `json:"updated_on,omitempty"` // The update time of the comment.
+	Deleted    bool                                `json:"deleted,omitempty"`    // Indicates if the comment was deleted.
+	Pending    bool                                `json:"pending,omitempty"`    // Indicates if the comment is pending, part of a review not yet published.
+	Parent     *PullRequestCommentReferenceScheme  `json:"parent,omitempty"`     // The comment this comment replies to.
+	Inline     *PullRequestCommentInlineScheme     `json:"inline,omitempty"`     // The location of an inline comment.
+	Resolution *PullRequestCommentResolutionScheme `json:"resolution,omitempty"` // The resolution of the comment thread.
+	Links      *PullRequestCommentLinksScheme      `json:"links,omitempty"`      // A collection of links related to the comment.
+}
+
+// PullRequestCommentReferenceScheme represents a reference to a pull request comment.
+type PullRequestCommentReferenceScheme struct {
+	ID int `json:"id,omitempty"` // The ID of the comment.
+}
+
+// PullRequestCommentInlineScheme represents the location of an inline comment.
+//
+// A comment on an added line only sets To, a comment on a removed line only sets From.
+type PullRequestCommentInlineScheme struct {
+	Path string `json:"path,omitempty"` // The path of the file.
+	From int    `json:"from,omitempty"` // The line number in the old version of the file.
+	To   int    `json:"to,omitempty"`   // The line number in the new version of the file.
+}
+
+// PullRequestCommentResolutionScheme represents the resolution of a comment thread.
+type PullRequestCommentResolutionScheme struct {
+	Type      string                  `json:"type,omitempty"`       // The type of the resolution.
+	User      *BitbucketAccountScheme `json:"user,omitempty"`       // The user who resolved the thread.
+	CreatedOn string                  `json:"created_on,omitempty"` // The time of the resolution.
+}
+
+// PullRequestCommentLinksScheme represents a collection of links related to a pull request comment.
+type PullRequestCommentLinksScheme struct {
+	Self *BitbucketLinkScheme `json:"self,omitempty"` // The link to the comment itself.
+	HTML *BitbucketLinkScheme `json:"html,omitempty"` // The link to the comment's HTML page.
+	Code *BitbucketLinkScheme `json:"code,omitempty"` // The link to the commented code of an inline comment.
+}
+
+// PullRequestCommentPayloadScheme represents the payload used to create or update a pull request comment.
+type PullRequestCommentPayloadScheme struct {
+	Content *BitbucketContentScheme            `json:"content,omitempty"` // The content of the comment, only the raw text is required.
+	Parent  *PullRequestCommentReferenceScheme `json:"parent,omitempty"`  // The comment to reply to.
+	Inline  *PullRequestCommentInlineScheme    `json:"inline,omitempty"`  // The location of an inline comment.
+	Pending bool                               `json:"pending,omitempty"` // Indicates if the comment is pending, part of a review not yet published.
+}
+
+// PullRequestTaskPageScheme represents a paginated list of pull request tasks.
+type PullRequestTaskPageScheme struct {
+	Size     int                      `json:"size,omitempty"`     // The number of tasks matching the request.
+	Page     int                      `json:"page,omitempty"`     // The current page number.
+	Pagelen  int                      `json:"pagelen,omitempty"`  // The number of tasks per page.
+	Next     string                   `json:"next,omitempty"`     // The URL to the next page.
+	Previous string                   `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*PullRequestTaskScheme `json:"values,omitempty"`   // The tasks in the current page.
+}
+
+// PullRequestTaskScheme represents a task on a pull request.
+type PullRequestTaskScheme struct {
+	ID         int                                `json:"id,omitempty"`          // The ID of the task.
+	State      string                             `json:"state,omitempty"`       // The state of the task: RESOLVED or UNRESOLVED.
+	Content    *BitbucketContentScheme            `json:"content,omitempty"`     // The content of the task.
+	Creator    *BitbucketAccountScheme            `json:"creator,omitempty"`     // The creator of the task.
+	Pending    bool                               `json:"pending,omitempty"`     // Indicates if the task is pending, part of a review not yet published.
+	Comment    *PullRequestCommentReferenceScheme `json:"comment,omitempty"`     // The comment the task is attached to.
+	CreatedOn  string                             `json:"created_on,omitempty"`  // The creation time of the task.
+	UpdatedOn  string                             `json:"updated_on,omitempty"`  // The update time of the task.
+	ResolvedOn string                             `json:"resolved_on,omitempty"` // The resolution time of the task.
+	ResolvedBy *BitbucketAccountScheme            `json:"resolved_by,omitempty"` // The user who resolved the task.
+}
+
+// PullRequestTaskPayloadScheme represents the payload used to create or update a pull request task.
+type PullRequestTaskPayloadScheme struct {
+	Content *BitbucketContentScheme            `json:"content,omitempty"` // The content of the task, only the raw text is required.
+	Comment *PullRequestCommentReferenceScheme `json:"comment,omitempty"` // The comment to attach the task to.
+	Pending bool                               `json:"pending,omitempty"` // Indicates if the task is pending, part of a review not yet published.
+	State   string                             `json:"state,omitempty"`   // The state of the task: RESOLVED or UNRESOLVED.
+}
@@ -0,0 +1,93 @@
+package models
+
+// CommitReportDataType is the type of a data field of a Code Insights report, defining how its value is rendered.
+type CommitReportDataType string
+
+const (
+	CommitReportDataBoolean    CommitReportDataType = "BOOLEAN"    // The value is a bool.
+	CommitReportDataDate       CommitReportDataType = "DATE"       // The value is a timestamp in milliseconds since the epoch.
+	CommitReportDataDuration   CommitReportDataType = "DURATION"   // The value is a duration in milliseconds.
+	CommitReportDataLink       CommitReportDataType = "LINK"       // The value is a *CommitReportLinkScheme.
+	CommitReportDataNumber     CommitReportDataType = "NUMBER"     // The value is a number.
+	CommitReportDataPercentage CommitReportDataType = "PERCENTAGE" // The value is a number between 0 and 100.
+	CommitReportDataText       CommitReportDataType = "TEXT"       // The value is a string.
+)
+
+const (
+	// MaxCommitReportAnnotations is the maximum number of annotations of a report.
+	MaxCommitReportAnnotations = 1000
+
+	// MaxCommitReportAnnotationsPerRequest is the maximum number of annotations Bitbucket accepts in a single request.
+	MaxCommitReportAnnotationsPerRequest = 100
+)
+
+// CommitReportPageScheme represents a paginated list of Code Insights reports.
+type CommitReportPageScheme struct {
+	Size     int                   `json:"size,omitempty"`     // The number of reports matching the request.
+	Page     int                   `json:"page,omitempty"`     // The current page number.
+	Pagelen  int                   `json:"pagelen,omitempty"`  // The number of reports per page.
+	Next     string                `json:"next,omitempty"`     // The URL to the next page.
+	Previous string                `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*CommitReportScheme `json:"values,omitempty"`   // The reports in the current page.
+}
+
+// CommitReportScheme represents a Code Insights report of a commit, e.g. the results of a static analysis.
+//
+// It's also the payload used to create or replace a report, the title, the details and the report type are required.
+type CommitReportScheme struct {
+	Type       string                    `json:"type,omitempty"`        // The type of the report.
+	UUID       string                    `json:"uuid,omitempty"`        // The UUID of the report.
+	ExternalID string                    `json:"external_id,omitempty"` // The ID of the report in the reporting tool, unique within the commit.
+	Title      string                    `json:"title,omitempty"`       // The title of the report.
+	Details    string                    `json:"details,omitempty"`     // The description of the report.
+	ReportType string                    `json:"report_type,omitempty"` // The type of the report: SECURITY, COVERAGE, TEST or BUG.
+	Reporter   string                    `json:"reporter,omitempty"`    // The name of the tool that created the report.
+	Link       string                    `json:"link,omitempty"`        // The URL of the report in the reporting tool.
+	LogoURL    string                    `json:"logo_url,omitempty"`    // The URL of the logo of the reporting tool.
+	Result     string                    `json:"result,omitempty"`      // The overall result of the report: PASSED, FAILED or PENDING.
+	Data       []*CommitReportDataScheme `json:"data,omitempty"`        // The data fields shown on the report, up to 10.
+	CreatedOn  string                    `json:"created_on,omitempty"`  // The creation time of the report.
+	UpdatedOn  string                    `json:"updated_on,omitempty"`  // The update time of the report.
+}
+
+// CommitReportDataScheme represents a data field of a Code Insights report.
+type CommitReportDataScheme struct {
+	Type  CommitReportDataType `json:"type,omitempty"`  // The type of the value.
+	Title string               `json:"title,omitempty"` // The title of the field.
+	Value any                  `json:"value,omitempty"` // The value, of the Go type documented on its data type.
+}
+
+// CommitReportLinkScheme represents the value of a LINK data field.
+type CommitReportLinkScheme struct {
+	Text string `json:"text,omitempty"` // The text of the link.
+	Href string `json:"href,omitempty"` // The URL of the link.
+}
+
+// CommitReportAnnotationPageScheme represents a paginated list of Code Insights annotations.
+type CommitReportAnnotationPageScheme struct {
+	Size     int                             `json:"size,omitempty"`     // The number of annotations matching the request.
+	Page     int                             `json:"page,omitempty"`     // The current page number.
+	Pagelen  int                             `json:"pagelen,omitempty"`  // The number of annotations per page.
+	Next     string                          `json:"next,omitempty"`     // The URL to the next page.
+	Previous string                          `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*CommitReportAnnotationScheme `json:"values,omitempty"`   // The annotations in the current page.
+}
+
+// CommitReportAnnotationScheme represents an annotation of a Code Insights report, an issue found on a line of a file.
+//
+// It's also the payload used to add annotations, the external ID, the annotation type and the summary are required.
+type CommitReportAnnotationScheme struct {
+	Type           string `json:"type,omitempty"`            // The type of the annotation.
+	UUID           string `json:"uuid,omitempty"`            // The UUID of the annotation.
+	ExternalID     string `json:"external_id,omitempty"`     // The ID of the annotation in the reporting tool, unique within the report.
+	AnnotationType string `json:"annotation_type,omitempty"` // The type of the annotation: VULNERABILITY, CODE_SMELL or BUG.
+	Path           string `json:"path,omitempty"`            // The path of the annotated file, relative to the repository root.
+	Line           int    `json:"line,omitempty"`            // The annotated line, the whole file is annotated when it's not set.
+	Summary        string `json:"summary,omitempty"`         // The summary of the annotation.
+	Details        string `json:"details,omitempty"`         // The description of the annotation.
+	Result         string `json:"result,omitempty"`          // The result of the annotation: PASSED, FAILED, SKIPPED or IGNORED.
+	Severity       string `json:"severity,omitempty"`        // The severity of the annotation: CRITICAL, HIGH, MEDIUM or LOW.
+	Link           string `json:"link,omitempty"`            // The URL of the annotation in the reporting tool.
+	CreatedOn      string `json:"created_on,omitempty"`      // The creation time of the annotation.
+	UpdatedOn      string `json:"updated_on,omitempty"`      // The update time of the annotation.
+}
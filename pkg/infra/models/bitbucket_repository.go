@@ -18,6 +18,23 @@ type RepositoryPermissionScheme struct {
 	Repository *RepositoryScheme       `json:"repository,omitempty"` // The repository to which the permission applies.
 }
 
+// RepositoryPageScheme represents a paginated list of repositories.
+type RepositoryPageScheme struct {
+	Size     int                 `json:"size,omitempty"`     // The number of repositories matching the request.
+	Page     int                 `json:"page,omitempty"`     // The current page number.
+	Pagelen  int                 `json:"pagelen,omitempty"`  // The number of repositories per page.
+	Next     string              `json:"next,omitempty"`     // The URL to the next page.
+	Previous string              `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*RepositoryScheme `json:"values,omitempty"`   // The repositories in the current page.
+}
+
+// RepositoryOptionsScheme represents the filters used to list repositories.
+type RepositoryOptionsScheme struct {
+	Role  string // Filters the repositories by the role of the user: member, contributor, admin or owner.
+	Query string // The query used to filter the repositories, e.g. `name ~ "api"`.
+	Sort  string // The field used to sort the repositories, e.g. "-updated_on".
+}
+
 // RepositoryScheme represents a repository.
 type RepositoryScheme struct {
 	Type        string                  `json:"type,omitempty"`        // The type of the repository.
@@ -26,6 +43,7 @@ type RepositoryScheme struct {
 	IsPrivate   bool                    `json:"is_private,omitempty"`  // Indicates if the repository is private.
 	SCM         string                  `json:"scm,omitempty"`         // The source control management system used by the repository.
 	Name        string                  `json:"name,omitempty"`        // The name of the repository.
+	Slug        string                  `json:"slug,omitempty"`        // The slug of the repository.
 	Description string                  `json:"description,omitempty"` // The description of the repository.
 	CreatedOn   string                  `json:"created_on,omitempty"`  // The creation time of the repository.
 	UpdatedOn   string                  `json:"updated_on,omitempty"`  // The update time of the repository.
@@ -38,6 +56,35 @@ type RepositoryScheme struct {
 	Parent      *RepositoryScheme       `json:"parent,omitempty"`      // The parent repository, if the repository is a fork.
 	Project     BitbucketProjectScheme  `json:"project,omitempty"`     // The project to which the repository belongs.
 	Links       *RepositoryLinksScheme  `json:"links,omitempty"`       // A collection of links related to the repository.
+	MainBranch  *RepositoryBranchScheme `json:"mainbranch,omitempty"`  // The main branch of the repository.
+	Workspace   *WorkspaceScheme        `json:"workspace,omitempty"`   // The workspace to which the repository belongs.
+}
+
+// RepositoryBranchScheme represents the main branch of a repository.
+type RepositoryBranchScheme struct {
+	Type string `json:"type,omitempty"` // The type of the branch.
+	Name string `json:"name,omitempty"` // The name of the branch.
+}
+
+// RepositoryForkPayloadScheme represents the payload used to fork a repository.
+// The unset attributes are inherited from the parent repository.
+type RepositoryForkPayloadScheme struct {
+	Name        string                  `json:"name,omitempty"`        // The name of the fork.
+	Description string                  `json:"description,omitempty"` // The description of the fork.
+	IsPrivate   bool                    `json:"is_private,omitempty"`  // Indicates if the fork is private.
+	ForkPolicy  string                  `json:"fork_policy,omitempty"` // The fork policy of the fork.
+	Language    string                  `json:"language,omitempty"`    // The programming language used in the fork.
+	HasIssues   bool                    `json:"has_issues,omitempty"`  // Indicates if the fork has issues enabled.
+	HasWiki     bool                    `json:"has_wiki,omitempty"`    // Indicates if the fork has a wiki enabled.
+	MainBranch  *RepositoryBranchScheme `json:"mainbranch,omitempty"`  // The main branch of the fork.
+	Project     *BitbucketProjectScheme `json:"project,omitempty"`     // The project of the fork, only the key is required.
+	Workspace   *WorkspaceScheme        `json:"workspace,omitempty"`   // The workspace of the fork, only the slug is required.
+}
+
+// RepositoryInheritanceStateScheme represents the settings a repository overrides instead of inheriting them from its project.
+type RepositoryInheritanceStateScheme struct {
+	Type             string          `json:"type,omitempty"`              // The type of the inheritance state.
+	OverrideSettings map[string]bool `json:"override_settings,omitempty"` // The settings overridden by the repository, e.g. "default_merge_strategy" or "branching_model".
 }
 
 // RepositoryLinksScheme represents a collection of links related to a repository.
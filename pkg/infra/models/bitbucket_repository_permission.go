@@ -0,0 +1,58 @@
+package models
+
+// RepositoryGroupPermissionPageScheme represents a paginated list of the group permissions of a repository.
+type RepositoryGroupPermissionPageScheme struct {
+	Size     int                                `json:"size,omitempty"`     // The number of permissions matching the request.
+	Page     int                                `json:"page,omitempty"`     // The current page number.
+	Pagelen  int                                `json:"pagelen,omitempty"`  // The number of permissions per page.
+	Next     string                             `json:"next,omitempty"`     // The URL to the next page.
+	Previous string                             `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*RepositoryGroupPermissionScheme `json:"values,omitempty"`   // The permissions in the current page.
+}
+
+// RepositoryGroupPermissionScheme represents the permission granted to a group on a repository.
+type RepositoryGroupPermissionScheme struct {
+	Type       string                           `json:"type,omitempty"`       // The type of the permission.
+	Permission string                           `json:"permission,omitempty"` // The level of the permission: read, write or admin.
+	Group      *BitbucketGroupScheme            `json:"group,omitempty"`      // The group granted with the permission.
+	Repository *RepositoryScheme                `json:"repository,omitempty"` // The repository to which the permission applies.
+	Links      *RepositoryPermissionLinksScheme `json:"links,omitempty"`      // A collection of links related to the permission.
+}
+
+// RepositoryUserPermissionPageScheme represents a paginated list of the user permissions of a repository.
+type RepositoryUserPermissionPageScheme struct {
+	Size     int                               `json:"size,omitempty"`     // The number of permissions matching the request.
+	Page     int                               `json:"page,omitempty"`     // The current page number.
+	Pagelen  int                               `json:"pagelen,omitempty"`  // The number of permissions per page.
+	Next     string                            `json:"next,omitempty"`     // The URL to the next page.
+	Previous string                            `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*RepositoryUserPermissionScheme `json:"values,omitempty"`   // The permissions in the current page.
+}
+
+// RepositoryUserPermissionScheme represents the permission granted explicitly to a user on a repository.
+type RepositoryUserPermissionScheme struct {
+	Type       string                           `json:"type,omitempty"`       // The type of the permission.
+	Permission string                           `json:"permission,omitempty"` // The level of the permission: read, write or admin.
+	User       *BitbucketAccountScheme          `json:"user,omitempty"`       // The user granted with the permission.
+	Repository *RepositoryScheme                `json:"repository,omitempty"` // The repository to which the permission applies.
+	Links      *RepositoryPermissionLinksScheme `json:"links,omitempty"`      // A collection of links related to the permission.
+}
+
+// RepositoryPermissionLinksScheme represents a collection of links related to a repository permission.
+type RepositoryPermissionLinksScheme struct {
+	Self *BitbucketLinkScheme `json:"self,omitempty"` // The link to the permission itself.
+}
+
+// RepositoryPermissionPayloadScheme represents the payload used to grant a permission on a repository.
+type RepositoryPermissionPayloadScheme struct {
+	Permission string `json:"permission,omitempty"` // The level of the permission: read, write or admin.
+}
+
+// BitbucketGroupScheme represents a group of a workspace.
+type BitbucketGroupScheme struct {
+	Type      string           `json:"type,omitempty"`      // The type of the group.
+	Name      string           `json:"name,omitempty"`      // The name of the group.
+	Slug      string           `json:"slug,omitempty"`      // The slug of the group, used in the URLs.
+	FullSlug  string           `json:"full_slug,omitempty"` // The slug of the group prefixed with the workspace, e.g. "workspace:developers".
+	Workspace *WorkspaceScheme `json:"workspace,omitempty"` // The workspace of the group.
+}
@@ -0,0 +1,121 @@
+package models
+
+import "io"
+
+// SnippetPageScheme represents a paginated list of snippets.
+type SnippetPageScheme struct {
+	Size     int              `json:"size,omitempty"`     // The number of snippets matching the request.
+	Page     int              `json:"page,omitempty"`     // The current page number.
+	Pagelen  int              `json:"pagelen,omitempty"`  // The number of snippets per page.
+	Next     string           `json:"next,omitempty"`     // The URL to the next page.
+	Previous string           `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*SnippetScheme `json:"values,omitempty"`   // The snippets in the current page.
+}
+
+// SnippetOptionsScheme represents the filters used to list snippets.
+type SnippetOptionsScheme struct {
+	Role string // Filters the snippets by the role of the user: owner, contributor or member.
+}
+
+// SnippetScheme represents a snippet.
+type SnippetScheme struct {
+	Type      string                        `json:"type,omitempty"`       // The type of the snippet.
+	ID        int                           `json:"id,omitempty"`         // The ID of the snippet.
+	Title     string                        `json:"title,omitempty"`      // The title of the snippet.
+	SCM       string                        `json:"scm,omitempty"`        // The source control manager of the snippet, "git".
+	IsPrivate bool                          `json:"is_private,omitempty"` // Indicates if the snippet is private.
+	Owner     *BitbucketAccountScheme       `json:"owner,omitempty"`      // The owner of the snippet.
+	Creator   *BitbucketAccountScheme       `json:"creator,omitempty"`    // The creator of the snippet.
+	CreatedOn string                        `json:"created_on,omitempty"` // The creation time of the snippet.
+	UpdatedOn string                        `json:"updated_on,omitempty"` // The update time of the snippet.
+	Files     map[string]*SnippetFileScheme `json:"files,omitempty"`      // The files of the snippet, keyed by path.
+	Links     *SnippetLinksScheme           `json:"links,omitempty"`      // A collection of links related to the snippet.
+}
+
+// SnippetFileScheme represents a file of a snippet.
+type SnippetFileScheme struct {
+	Links *SnippetFileLinksScheme `json:"links,omitempty"` // A collection of links related to the file.
+}
+
+// SnippetFileLinksScheme represents a collection of links related to a snippet file.
+type SnippetFileLinksScheme struct {
+	Self *BitbucketLinkScheme `json:"self,omitempty"` // The link to the raw contents of the file.
+	HTML *BitbucketLinkScheme `json:"html,omitempty"` // The link to the file's HTML page.
+}
+
+// SnippetLinksScheme represents a collection of links related to a snippet.
+type SnippetLinksScheme struct {
+	Self     *BitbucketLinkScheme   `json:"self,omitempty"`     // The link to the snippet itself.
+	HTML     *BitbucketLinkScheme   `json:"html,omitempty"`     // The link to the snippet's HTML page.
+	Comments *BitbucketLinkScheme   `json:"comments,omitempty"` // The link to the comments of the snippet.
+	Watchers *BitbucketLinkScheme   `json:"watchers,omitempty"` // The link to the watchers of the snippet.
+	Commits  *BitbucketLinkScheme   `json:"commits,omitempty"`  // The link to the revisions of the snippet.
+	Clone    []*BitbucketLinkScheme `json:"clone,omitempty"`    // The links to clone the snippet.
+}
+
+// SnippetPayloadScheme represents the payload used to create or update a snippet.
+//
+// The payload is sent as a multipart form, a snippet is created with at least one file.
+// On update, the files are added or replace the existing ones with the same name.
+type SnippetPayloadScheme struct {
+	Title     string                      // The title of the snippet.
+	IsPrivate bool                        // Indicates if the snippet is private.
+	Files     []*SnippetFilePayloadScheme // The files of the snippet.
+}
+
+// SnippetFilePayloadScheme represents a file uploaded to a snippet.
+type SnippetFilePayloadScheme struct {
+	Name    string    // The name of the file.
+	Content io.Reader // The contents of the file.
+}
+
+// SnippetCommentPageScheme represents a paginated list of snippet comments.
+type SnippetCommentPageScheme struct {
+	Size     int                     `json:"size,omitempty"`     // The number of comments matching the request.
+	Page     int                     `json:"page,omitempty"`     // The current page number.
+	Pagelen  int                     `json:"pagelen,omitempty"`  // The number of comments per page.
+	Next     string                  `json:"next,omitempty"`     // The URL to the next page.
+	Previous string                  `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*SnippetCommentScheme `json:"values,omitempty"`   // The comments in the current page.
+}
+
+// SnippetCommentScheme represents a comment on a snippet.
+type SnippetCommentScheme struct {
+	Type      string                             `json:"type,omitempty"`       // The type of the comment.
+	ID        int                                `json:"id,omitempty"`         // The ID of the comment.
+	Content   *BitbucketContentScheme            `json:"content,omitempty"`    // The content of the comment.
+	User      *BitbucketAccountScheme            `json:"user,omitempty"`       // The author of the comment.
+	CreatedOn string                             `json:"created_on,omitempty"` // The creation time of the comment.
+	UpdatedOn string                             `json:"updated_on,omitempty"` // The update time of the comment.
+	Deleted   bool                               `json:"deleted,omitempty"`    // Indicates if the comment was deleted.
+	Parent    *PullRequestCommentReferenceScheme `json:"parent,omitempty"`     // The comment this comment replies to.
+	Links     *PullRequestCommentLinksScheme     `json:"links,omitempty"`      // A collection of links related to the comment.
+}
+
+// SnippetCommentPayloadScheme represents the payload used to create or update a snippet comment.
+type SnippetCommentPayloadScheme struct {
+	Content *BitbucketContentScheme            `json:"content,omitempty"` // The content of the comment, only the raw text is required.
+	Parent  *PullRequestCommentReferenceScheme `json:"parent,omitempty"`  // The comment to reply to.
+}
+
+// SnippetCommitPageScheme represents a paginated list of snippet revisions.
+type SnippetCommitPageScheme struct {
+	Size     int                    `json:"size,omitempty"`     // The number of revisions matching the request.
+	Page     int                    `json:"page,omitempty"`     // The current page number.
+	Pagelen  int                    `json:"pagelen,omitempty"`  // The number of revisions per page.
+	Next     string                 `json:"next,omitempty"`     // The URL to the next page.
+	Previous string                 `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*SnippetCommitScheme `json:"values,omitempty"`   // The revisions in the current page.
+}
+
+// SnippetCommitScheme represents a revision of a snippet.
+type SnippetCommitScheme struct {
+	Type    string                 `json:"type,omitempty"`    // The type of the revision.
+	Hash    string                 `json:"hash,omitempty"`    // The hash of the revision.
+	Date    string                 `json:"date,omitempty"`    // The date of the revision.
+	Author  *CommitAuthorScheme    `json:"author,omitempty"`  // The author of the revision.
+	Message string                 `json:"message,omitempty"` // The message of the revision.
+	Parents []*SnippetCommitScheme `json:"parents,omitempty"` // The parents of the revision.
+	Snippet *SnippetScheme         `json:"snippet,omitempty"` // The snippet at the revision.
+	Links   *CommitLinksScheme     `json:"links,omitempty"`   // A collection of links related to the revision.
+}
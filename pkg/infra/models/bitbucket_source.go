@@ -0,0 +1,37 @@
+package models
+
+// SourcePageScheme represents a paginated list of the entries of a directory.
+type SourcePageScheme struct {
+	Size     int             `json:"size,omitempty"`     // The number of entries matching the request.
+	Page     int             `json:"page,omitempty"`     // The current page number.
+	Pagelen  int             `json:"pagelen,omitempty"`  // The number of entries per page.
+	Next     string          `json:"next,omitempty"`     // The URL to the next page.
+	Previous string          `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*SourceScheme `json:"values,omitempty"`   // The entries in the current page.
+}
+
+// SourceOptionsScheme represents the filters used to list the entries of a directory.
+type SourceOptionsScheme struct {
+	MaxDepth int    // The depth of the listing, the subdirectories are listed recursively up to this depth.
+	Query    string // The query used to filter the entries, e.g. `path ~ ".go"`.
+	Sort     string // The field used to sort the entries, e.g. "-size".
+}
+
+// SourceScheme represents a file or a directory of a repository at a commit.
+type SourceScheme struct {
+	Type        string             `json:"type,omitempty"`         // The type of the entry: commit_file or commit_directory.
+	Path        string             `json:"path,omitempty"`         // The path of the entry.
+	EscapedPath string             `json:"escaped_path,omitempty"` // The escaped path of the entry.
+	Size        int                `json:"size,omitempty"`         // The size of a file, in bytes.
+	Mimetype    string             `json:"mimetype,omitempty"`     // The mime type of a file.
+	Attributes  []string           `json:"attributes,omitempty"`   // The attributes of a file, e.g. "executable", "link" or "lfs".
+	Commit      *CommitScheme      `json:"commit,omitempty"`       // The commit of the entry.
+	Links       *SourceLinksScheme `json:"links,omitempty"`        // A collection of links related to the entry.
+}
+
+// SourceLinksScheme represents a collection of links related to a source entry.
+type SourceLinksScheme struct {
+	Self    *BitbucketLinkScheme `json:"self,omitempty"`    // The link to the entry itself.
+	Meta    *BitbucketLinkScheme `json:"meta,omitempty"`    // The link to the metadata of the entry.
+	History *BitbucketLinkScheme `json:"history,omitempty"` // The link to the history of the entry.
+}
@@ -6,6 +6,7 @@ type WebhookSubscriptionPayloadScheme struct {
 	URL         string   `json:"url,omitempty"`         // The URL of the webhook subscription.
 	Active      bool     `json:"active,omitempty"`      // Indicates if the webhook subscription is active.
 	Events      []string `json:"events,omitempty"`      // The events for the webhook subscription.
+	Secret      string   `json:"secret,omitempty"`      // The secret used to sign the deliveries in the X-Hub-Signature header.
 }
 
 // WebhookSubscriptionPageScheme represents a paginated list of webhook subscriptions.
@@ -28,6 +29,7 @@ type WebhookSubscriptionScheme struct {
 	Active      bool                              `json:"active,omitempty"`       // Indicates if the webhook subscription is active.
 	CreatedAt   string                            `json:"created_at,omitempty"`   // The creation time of the webhook subscription.
 	Events      []string                          `json:"events,omitempty"`       // The events for the webhook subscription.
+	SecretSet   bool                              `json:"secret_set,omitempty"`   // Indicates if the deliveries are signed with a secret.
 }
 
 // WebhookSubscriptionSubjectScheme represents the subject of a webhook subscription.
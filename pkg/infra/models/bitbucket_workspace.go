@@ -29,3 +29,11 @@ type BitbucketLinkScheme struct {
 	Href string `json:"href,omitempty"` // The URL of the link.
 	Name string `json:"name,omitempty"` // The name of the link.
 }
+
+// BitbucketContentScheme represents a rendered text in Bitbucket, e.g. the content of a comment.
+type BitbucketContentScheme struct {
+	Type   string `json:"type,omitempty"`   // The type of the content.
+	Raw    string `json:"raw,omitempty"`    // The text as it was typed by the user.
+	Markup string `json:"markup,omitempty"` // The type of markup language the raw content is to be interpreted in, e.g. "markdown".
+	HTML   string `json:"html,omitempty"`   // The user's content rendered as HTML.
+}
@@ -27,6 +27,16 @@ type WorkspaceMembershipLinksScheme struct {
 	Self *BitbucketLinkScheme `json:"self,omitempty"` // The link to the membership itself.
 }
 
+// BitbucketAccountPageScheme represents a paginated list of Bitbucket accounts.
+type BitbucketAccountPageScheme struct {
+	Size     int                       `json:"size,omitempty"`     // The number of accounts matching the request.
+	Page     int                       `json:"page,omitempty"`     // The current page number.
+	Pagelen  int                       `json:"pagelen,omitempty"`  // The number of accounts per page.
+	Next     string                    `json:"next,omitempty"`     // The URL to the next page.
+	Previous string                    `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*BitbucketAccountScheme `json:"values,omitempty"`   // The accounts in the current page.
+}
+
 // BitbucketAccountScheme represents a Bitbucket account.
 type BitbucketAccountScheme struct {
 	Links       *BitbucketAccountLinksScheme `json:"links,omitempty"`        // The links related to the account.
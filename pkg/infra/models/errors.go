@@ -98,6 +98,114 @@ var (
 	// ErrNoRepository indicates that a required repository was not provided
 	ErrNoRepository = errors.New("no repository set")
 
+	// ErrNoPullRequestID indicates that a required pull request ID was not provided
+	ErrNoPullRequestID = errors.New("no pull request id set")
+
+	// ErrNoPipelineUUID indicates that a required pipeline UUID was not provided
+	ErrNoPipelineUUID = errors.New("no pipeline uuid set")
+
+	// ErrNoPipelineStepUUID indicates that a required pipeline step UUID was not provided
+	ErrNoPipelineStepUUID = errors.New("no pipeline step uuid set")
+
+	// ErrNoVariableUUID indicates that a required variable UUID was not provided
+	ErrNoVariableUUID = errors.New("no variable uuid set")
+
+	// ErrNoEnvironmentUUID indicates that a required environment UUID was not provided
+	ErrNoEnvironmentUUID = errors.New("no environment uuid set")
+
+	// ErrNoBranchName indicates that a required branch name was not provided
+	ErrNoBranchName = errors.New("no branch name set")
+
+	// ErrNoTagName indicates that a required tag name was not provided
+	ErrNoTagName = errors.New("no tag name set")
+
+	// ErrNoCommit indicates that a required commit was not provided
+	ErrNoCommit = errors.New("no commit set")
+
+	// ErrNoDiffSpec indicates that a required diff spec was not provided
+	ErrNoDiffSpec = errors.New("no diff spec set")
+
+	// ErrNoBuildStatusKey indicates that a required build status key was not provided
+	ErrNoBuildStatusKey = errors.New("no build status key set")
+
+	// ErrNoGroupSlug indicates that a required group slug was not provided
+	ErrNoGroupSlug = errors.New("no group slug set")
+
+	// ErrNoDeployKeyID indicates that a required deploy key ID was not provided
+	ErrNoDeployKeyID = errors.New("no deploy key id set")
+
+	// ErrNoSSHKeyUUID indicates that a required SSH key UUID was not provided
+	ErrNoSSHKeyUUID = errors.New("no ssh key uuid set")
+
+	// ErrNoBranchRestrictionID indicates that a required branch restriction ID was not provided
+	ErrNoBranchRestrictionID = errors.New("no branch restriction id set")
+
+	// ErrNoWebhookSecret indicates that the secret validating the webhook deliveries was not provided
+	ErrNoWebhookSecret = errors.New("no webhook secret set")
+
+	// ErrInvalidWebhookSignature indicates that the signature of a webhook delivery is missing or doesn't match its payload
+	ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+
+	// ErrNoWebhookEventKey indicates that the event key of a webhook delivery was not provided
+	ErrNoWebhookEventKey = errors.New("no webhook event key set")
+
+	// ErrInvalidWebhookPayload indicates that the payload of a webhook delivery can't be decoded
+	ErrInvalidWebhookPayload = errors.New("invalid webhook payload")
+
+	// ErrNoRepositoryIssueID indicates that a required repository issue ID was not provided
+	ErrNoRepositoryIssueID = errors.New("no repository issue id set")
+
+	// ErrNoIssueChangeID indicates that a required issue change ID was not provided
+	ErrNoIssueChangeID = errors.New("no issue change id set")
+
+	// ErrNoSnippetID indicates that a required snippet encoded ID was not provided
+	ErrNoSnippetID = errors.New("no snippet id set")
+
+	// ErrNoSnippetRevision indicates that a required snippet revision was not provided
+	ErrNoSnippetRevision = errors.New("no snippet revision set")
+
+	// ErrNoSnippetFiles indicates that the files of a snippet were not provided
+	ErrNoSnippetFiles = errors.New("no snippet files set")
+
+	// ErrNoDeploymentUUID indicates that a required deployment UUID was not provided
+	ErrNoDeploymentUUID = errors.New("no deployment uuid set")
+
+	// ErrNoCommitReportID indicates that a required Code Insights report ID was not provided
+	ErrNoCommitReportID = errors.New("no commit report id set")
+
+	// ErrNoCommitReportAnnotationID indicates that a required Code Insights annotation ID was not provided
+	ErrNoCommitReportAnnotationID = errors.New("no commit report annotation id set")
+
+	// ErrNoCommitReportAnnotations indicates that the Code Insights annotations to add were not provided
+	ErrNoCommitReportAnnotations = errors.New("no commit report annotations set")
+
+	// ErrTooManyCommitReportAnnotations indicates that more annotations than a report can have were provided
+	ErrTooManyCommitReportAnnotations = errors.New("too many commit report annotations")
+
+	// ErrNoProjectKey indicates that a required Bitbucket project key was not provided
+	ErrNoProjectKey = errors.New("no project key set")
+
+	// ErrNoWebhookURL indicates that the URL receiving the webhook deliveries was not provided
+	ErrNoWebhookURL = errors.New("no webhook url set")
+
+	// ErrNoWebhooks indicates that the webhooks to register were not provided
+	ErrNoWebhooks = errors.New("no webhooks set")
+
+	// ErrNoChangelogIDs indicates that the IDs of the change histories of an issue were not provided
+	ErrNoChangelogIDs = errors.New("no changelog ids set")
+
+	// ErrNoExpression indicates that a required Jira expression was not provided
+	ErrNoExpression = errors.New("no jira expression set")
+
+	// ErrNoExpressions indicates that the Jira expressions to analyse were not provided
+	ErrNoExpressions = errors.New("no jira expressions set")
+
+	// ErrNoIssueSecuritySchemeID indicates that a required issue security scheme ID was not provided
+	ErrNoIssueSecuritySchemeID = errors.New("no issue security scheme id set")
+
+	// ErrNoIssueSecurityLevelID indicates that a required security level ID was not provided
+	ErrNoIssueSecurityLevelID = errors.New("no issue security level id set")
+
 	// ErrNoVersionProvided indicates that a required module version was not provided
 	ErrNoVersionProvided = errors.New("no module version set")
 
@@ -116,6 +224,15 @@ var (
 	// ErrBadRequest indicates that the request payload was invalid
 	ErrBadRequest = errors.New("atlassian invalid payload")
 
+	// ErrForbidden indicates that the user is not allowed to perform the requested operation
+	ErrForbidden = errors.New("atlassian forbidden operation")
+
+	// ErrConflict indicates that the request conflicts with the current state of the Atlassian resource
+	ErrConflict = errors.New("atlassian resource conflict")
+
+	// ErrRateLimited indicates that the request was rejected by the Atlassian rate limits
+	ErrRateLimited = errors.New("atlassian rate limit exceeded")
+
 	// ErrNoSite indicates that no Atlassian site URL was provided
 	ErrNoSite = errors.New("no atlassian site set")
 
@@ -367,6 +484,9 @@ var (
 	// ErrNoPriorityID indicates that a required priority ID was not provided
 	ErrNoPriorityID = errors.New("no priority id set")
 
+	// ErrNoPrioritySchemeID indicates that a required priority scheme ID was not provided
+	ErrNoPrioritySchemeID = errors.New("no priority scheme id set")
+
 	// ErrNoResolutionID indicates that a required resolution ID was not provided
 	ErrNoResolutionID = errors.New("no resolution id set")
 
@@ -540,4 +660,16 @@ var (
 
 	// ErrInvalidIssueTypeSchemeAfter represents an error indicating an invalid 'after' attribute in the issue type scheme configuration.
 	ErrInvalidIssueTypeSchemeAfter = errors.New("issue type scheme invalid 'after' attr, issue type id found in 'issueTypeIds'")
+
+	// ErrNoRetryPolicy indicates that a required retry policy was not provided
+	ErrNoRetryPolicy = errors.New("no retry policy set")
+
+	// ErrInvalidRetryPolicy indicates that the retry policy values are not consistent
+	ErrInvalidRetryPolicy = errors.New("invalid retry policy")
+
+	// ErrDryRun indicates that a write request was blocked by the dry-run middleware
+	ErrDryRun = errors.New("request blocked by the dry-run mode")
+
+	// ErrNoReplayInteraction indicates that no recorded interaction matches the replayed request
+	ErrNoReplayInteraction = errors.New("no recorded interaction matches the request")
 )
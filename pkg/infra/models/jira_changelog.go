@@ -1,5 +1,12 @@
 package models
 
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
 // IssueChangelogScheme represents the changelog of an issue in Jira.
 type IssueChangelogScheme struct {
 	StartAt    int                            `json:"startAt,omitempty"`    // The starting index of the changelog.
@@ -38,3 +45,280 @@ type IssueChangelogHistoryItemScheme struct {
 	To         string `json:"to,omitempty"`         // The new value of the field.
 	ToString   string `json:"toString,omitempty"`   // The new value of the field as a string.
 }
+
+// IssueChangelogPageScheme represents a paginated list of the change histories of an issue, the oldest first.
+type IssueChangelogPageScheme struct {
+	Self       string                         `json:"self,omitempty"`       // The URL of the page.
+	NextPage   string                         `json:"nextPage,omitempty"`   // The URL of the next page.
+	MaxResults int                            `json:"maxResults,omitempty"` // The maximum number of histories per page.
+	StartAt    int                            `json:"startAt,omitempty"`    // The index of the first history of the page.
+	Total      int                            `json:"total,omitempty"`      // The total number of histories.
+	IsLast     bool                           `json:"isLast,omitempty"`     // Indicates if the page is the last one.
+	Values     []*IssueChangelogHistoryScheme `json:"values,omitempty"`     // The histories of the page.
+}
+
+// IssueChangelogIDsPayloadScheme represents the payload used to get change histories by ID.
+type IssueChangelogIDsPayloadScheme struct {
+	ChangelogIDs []int `json:"changelogIds"` // The IDs of the change histories.
+}
+
+// IssueChangelogBulkFetchPayloadScheme represents the payload used to fetch the change histories of several issues.
+type IssueChangelogBulkFetchPayloadScheme struct {
+	IssueIDsOrKeys []string `json:"issueIdsOrKeys"`          // The IDs or the keys of the issues, up to 1000.
+	FieldIDs       []string `json:"fieldIds,omitempty"`      // The IDs of the fields to return the changes of, up to 10, every field when empty.
+	MaxResults     int      `json:"maxResults,omitempty"`    // The maximum number of histories per page, up to 10000.
+	NextPageToken  string   `json:"nextPageToken,omitempty"` // The token of the page to fetch, the first one when empty.
+}
+
+// IssueChangelogBulkFetchPageScheme represents a page of the change histories of several issues.
+type IssueChangelogBulkFetchPageScheme struct {
+	IssueChangeLogs []*IssueChangelogBulkFetchScheme `json:"issueChangeLogs,omitempty"` // The change histories, grouped by issue.
+	NextPageToken   string                           `json:"nextPageToken,omitempty"`   // The token of the next page, empty on the last page.
+}
+
+// IssueChangelogBulkFetchScheme represents the change histories of an issue returned by a bulk fetch.
+//
+// The histories of an issue can be split across several pages.
+type IssueChangelogBulkFetchScheme struct {
+	IssueID         string                         `json:"issueId,omitempty"`         // The ID of the issue.
+	ChangeHistories []*IssueChangelogHistoryScheme `json:"changeHistories,omitempty"` // The change histories of the issue.
+}
+
+// IssueFieldValueScheme represents the value of an issue field reconstructed from the changelog of the issue.
+type IssueFieldValueScheme struct {
+	Field     string                       // The name of the field.
+	FieldID   string                       // The ID of the field, empty for the changes recorded without it.
+	Value     string                       // The raw value of the field, e.g. the ID of a status, the raw values joined by ", " for the fields holding several values.
+	String    string                       // The displayed value of the field, e.g. the name of a status, the displayed values joined by ", " for the fields holding several values.
+	Values    []*IssueFieldValueItemScheme // The values of the fields holding several values, e.g. the components, in the order they were added.
+	ChangedAt time.Time                    // The time of the last change of the field, the zero time for the value set before the first change.
+}
+
+// IssueFieldValueItemScheme represents one of the values of an issue field holding several values.
+type IssueFieldValueItemScheme struct {
+	Value  string // The raw value, e.g. the ID of a component.
+	String string // The displayed value, e.g. the name of a component.
+}
+
+// issueFieldKind tells how the changes of an issue field are recorded in the changelog.
+type issueFieldKind int
+
+const (
+	issueFieldSingle issueFieldKind = iota // Each change records the previous and the new value.
+	issueFieldSet                          // Each change records a single value added or removed, e.g. the components.
+	issueFieldList                         // Each change records the previous and the new lists of values, e.g. the labels.
+)
+
+// kindOf returns how the changes of the field of the item are recorded, and the separator of the values of the lists.
+func (item *IssueChangelogHistoryItemScheme) kindOf() (issueFieldKind, string) {
+
+	switch item.FieldID {
+	case "components", "fixVersions", "versions":
+		return issueFieldSet, ""
+	case "labels":
+		return issueFieldList, " "
+	}
+
+	switch item.Field {
+	case "Component", "Fix Version", "Version":
+		return issueFieldSet, ""
+	case "labels":
+		return issueFieldList, " "
+	case "Sprint":
+		return issueFieldList, ","
+	}
+
+	return issueFieldSingle, ""
+}
+
+// IssueFieldValuesAt reconstructs the values the fields of an issue had at the time from the change histories of the issue,
+// which can be provided in any order. Use it, e.g., to find the status of an issue at the end of a sprint.
+//
+// The values are keyed by field ID, or by field name for the changes recorded without ID.
+// Only the fields changed at least once are returned, the other fields had their current value.
+// A field changed only after the time has the value it had before its first change.
+//
+// The fields holding several values, e.g. the components, the versions, the labels or the sprints, are rebuilt
+// from the values added and removed, the complete history is required to find the values set before the first change.
+func IssueFieldValuesAt(histories []*IssueChangelogHistoryScheme, at time.Time) (map[string]*IssueFieldValueScheme, error) {
+
+	type change struct {
+		created time.Time
+		item    *IssueChangelogHistoryItemScheme
+	}
+
+	changes := make([]change, 0, len(histories))
+	for _, history := range histories {
+
+		if history == nil {
+			continue
+		}
+
+		created, err := time.Parse(TimeFormat, history.Created)
+		if err != nil {
+			return nil, fmt.Errorf("jira: invalid creation time of the change history %v: %w", history.ID, err)
+		}
+
+		for _, item := range history.Items {
+			if item != nil {
+				changes = append(changes, change{created: created, item: item})
+			}
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].created.Before(changes[j].created)
+	})
+
+	// The changes are grouped by field, the fields are kept in the order of their first change.
+	var keys []string
+	fields := make(map[string][]change)
+	for _, c := range changes {
+
+		key := c.item.FieldID
+		if key == "" {
+			key = c.item.Field
+		}
+
+		if _, found := fields[key]; !found {
+			keys = append(keys, key)
+		}
+
+		fields[key] = append(fields[key], c)
+	}
+
+	values := make(map[string]*IssueFieldValueScheme, len(keys))
+	for _, key := range keys {
+
+		first := fields[key][0].item
+		value := &IssueFieldValueScheme{Field: first.Field, FieldID: first.FieldID}
+
+		var items []*IssueChangelogHistoryItemScheme
+		for _, c := range fields[key] {
+			if !c.created.After(at) {
+				items = append(items, c.item)
+				value.ChangedAt = c.created
+			}
+		}
+
+		switch kind, separator := first.kindOf(); kind {
+		case issueFieldSet:
+
+			var all []*IssueChangelogHistoryItemScheme
+			for _, c := range fields[key] {
+				all = append(all, c.item)
+			}
+
+			value.setValues(issueFieldSetAt(all, items))
+
+		case issueFieldList:
+
+			// The previous list of the first change is the list before the time when no change preceded it.
+			last := &IssueChangelogHistoryItemScheme{To: first.From, ToString: first.FromString}
+			if len(items) != 0 {
+				last = items[len(items)-1]
+			}
+
+			value.setValues(splitIssueFieldValues(last.To, last.ToString, separator))
+
+		default:
+
+			if len(items) == 0 {
+				value.Value, value.String = first.From, first.FromString
+			} else {
+				value.Value, value.String = items[len(items)-1].To, items[len(items)-1].ToString
+			}
+		}
+
+		values[key] = value
+	}
+
+	return values, nil
+}
+
+// setValues sets the values of a field holding several values, along with their joined forms.
+func (v *IssueFieldValueScheme) setValues(items []*IssueFieldValueItemScheme) {
+
+	raw := make([]string, 0, len(items))
+	displayed := make([]string, 0, len(items))
+	for _, item := range items {
+		raw = append(raw, item.Value)
+		displayed = append(displayed, item.String)
+	}
+
+	v.Values = items
+	v.Value = strings.Join(raw, ", ")
+	v.String = strings.Join(displayed, ", ")
+}
+
+// issueFieldSetAt rebuilds the values of a field recording each value added or removed, e.g. the components.
+//
+// The values removed before being added were set before the first change, they're the initial values.
+// The changes applied are the ones made at or before the time.
+func issueFieldSetAt(all, applied []*IssueChangelogHistoryItemScheme) []*IssueFieldValueItemScheme {
+
+	var values []*IssueFieldValueItemScheme
+	seen := make(map[string]bool)
+	for _, item := range all {
+
+		if item.From != "" && !seen[item.From] {
+			values = append(values, &IssueFieldValueItemScheme{Value: item.From, String: item.FromString})
+		}
+
+		seen[item.From], seen[item.To] = true, true
+	}
+
+	for _, item := range applied {
+
+		if item.From != "" {
+			for index, value := range values {
+				if value.Value == item.From {
+					values = append(values[:index], values[index+1:]...)
+					break
+				}
+			}
+		}
+
+		if item.To != "" {
+			values = append(values, &IssueFieldValueItemScheme{Value: item.To, String: item.ToString})
+		}
+	}
+
+	return values
+}
+
+// splitIssueFieldValues splits the lists of raw and displayed values recorded by a change, e.g. of the labels.
+func splitIssueFieldValues(raw, displayed, separator string) []*IssueFieldValueItemScheme {
+
+	split := func(list string) []string {
+
+		var values []string
+		for _, value := range strings.Split(list, separator) {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+
+		return values
+	}
+
+	rawValues, displayedValues := split(raw), split(displayed)
+
+	// The labels record their displayed values only.
+	if len(rawValues) == 0 {
+		rawValues = displayedValues
+	}
+
+	items := make([]*IssueFieldValueItemScheme, 0, len(rawValues))
+	for index, value := range rawValues {
+
+		item := &IssueFieldValueItemScheme{Value: value, String: value}
+		if index < len(displayedValues) {
+			item.String = displayedValues[index]
+		}
+
+		items = append(items, item)
+	}
+
+	return items
+}
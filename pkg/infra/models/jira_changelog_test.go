@@ -0,0 +1,206 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueFieldValuesAt(t *testing.T) {
+
+	histories := []*IssueChangelogHistoryScheme{
+		{
+			ID:      "10003",
+			Created: "2024-03-20T09:00:00.000+0000",
+			Items: []*IssueChangelogHistoryItemScheme{
+				{Field: "status", FieldID: "status", From: "3", FromString: "In Progress", To: "10001", ToString: "Done"},
+			},
+		},
+		{
+			ID:      "10001",
+			Created: "2024-03-10T09:00:00.000+0000",
+			Items: []*IssueChangelogHistoryItemScheme{
+				{Field: "status", FieldID: "status", From: "1", FromString: "To Do", To: "3", ToString: "In Progress"},
+			},
+		},
+		{
+			ID:      "10002",
+			Created: "2024-03-15T09:00:00.000+0000",
+			Items: []*IssueChangelogHistoryItemScheme{
+				{Field: "Sprint", From: "", FromString: "", To: "42", ToString: "Sprint 7"},
+				{Field: "assignee", FieldID: "assignee", From: "", FromString: "", To: "5b10a2844c20165700ede21g", ToString: "Mia Krystof"},
+			},
+		},
+	}
+
+	type args struct {
+		histories []*IssueChangelogHistoryScheme
+		at        time.Time
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    map[string]*IssueFieldValueScheme
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "before the first change",
+			args: args{histories: histories, at: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+			want: map[string]*IssueFieldValueScheme{
+				"status":   {Field: "status", FieldID: "status", Value: "1", String: "To Do"},
+				"Sprint":   {Field: "Sprint"},
+				"assignee": {Field: "assignee", FieldID: "assignee"},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "between the changes",
+			args: args{histories: histories, at: time.Date(2024, 3, 12, 0, 0, 0, 0, time.UTC)},
+			want: map[string]*IssueFieldValueScheme{
+				"status": {
+					Field: "status", FieldID: "status", Value: "3", String: "In Progress",
+					ChangedAt: time.Date(2024, 3, 10, 9, 0, 0, 0, time.UTC),
+				},
+				"Sprint":   {Field: "Sprint"},
+				"assignee": {Field: "assignee", FieldID: "assignee"},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "after the last change",
+			args: args{histories: histories, at: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)},
+			want: map[string]*IssueFieldValueScheme{
+				"status": {
+					Field: "status", FieldID: "status", Value: "10001", String: "Done",
+					ChangedAt: time.Date(2024, 3, 20, 9, 0, 0, 0, time.UTC),
+				},
+				"Sprint": {
+					Field: "Sprint", Value: "42", String: "Sprint 7",
+					ChangedAt: time.Date(2024, 3, 15, 9, 0, 0, 0, time.UTC),
+				},
+				"assignee": {
+					Field: "assignee", FieldID: "assignee", Value: "5b10a2844c20165700ede21g", String: "Mia Krystof",
+					ChangedAt: time.Date(2024, 3, 15, 9, 0, 0, 0, time.UTC),
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "when there are no histories",
+			args:    args{at: time.Now()},
+			want:    map[string]*IssueFieldValueScheme{},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "when the creation time is invalid",
+			args: args{
+				histories: []*IssueChangelogHistoryScheme{{ID: "10001", Created: "yesterday"}},
+				at:        time.Now(),
+			},
+			wantErr: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			got, err := IssueFieldValuesAt(tt.args.histories, tt.args.at)
+			if !tt.wantErr(t, err) {
+				return
+			}
+
+			assert.Equal(t, len(tt.want), len(got))
+			for key, want := range tt.want {
+				if assert.Contains(t, got, key) {
+					assert.Equal(t, want.Field, got[key].Field)
+					assert.Equal(t, want.FieldID, got[key].FieldID)
+					assert.Equal(t, want.Value, got[key].Value)
+					assert.Equal(t, want.String, got[key].String)
+					assert.True(t, want.ChangedAt.Equal(got[key].ChangedAt), "changed at %v, want %v", got[key].ChangedAt, want.ChangedAt)
+				}
+			}
+		})
+	}
+}
+
+func TestIssueFieldValuesAt_MultipleValues(t *testing.T) {
+
+	histories := []*IssueChangelogHistoryScheme{
+		{
+			ID:      "10002",
+			Created: "2024-03-15T09:00:00.000+0000",
+			Items: []*IssueChangelogHistoryItemScheme{
+				{Field: "Component", FieldID: "components", From: "10000", FromString: "Backend"},
+				{Field: "labels", FieldID: "labels", FromString: "customer", ToString: "customer urgent"},
+			},
+		},
+		{
+			ID:      "10001",
+			Created: "2024-03-10T09:00:00.000+0000",
+			Items: []*IssueChangelogHistoryItemScheme{
+				{Field: "Component", FieldID: "components", To: "10000", ToString: "Backend"},
+				{Field: "Component", FieldID: "components", To: "10001", ToString: "Frontend"},
+				{Field: "Fix Version", FieldID: "fixVersions", From: "10020", FromString: "1.0"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want map[string][]*IssueFieldValueItemScheme
+	}{
+		{
+			name: "before the first change",
+			at:   time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			want: map[string][]*IssueFieldValueItemScheme{
+				"components":  {},
+				"fixVersions": {{Value: "10020", String: "1.0"}},
+				"labels":      {{Value: "customer", String: "customer"}},
+			},
+		},
+		{
+			name: "after the components are added",
+			at:   time.Date(2024, 3, 12, 0, 0, 0, 0, time.UTC),
+			want: map[string][]*IssueFieldValueItemScheme{
+				"components":  {{Value: "10000", String: "Backend"}, {Value: "10001", String: "Frontend"}},
+				"fixVersions": {},
+				"labels":      {{Value: "customer", String: "customer"}},
+			},
+		},
+		{
+			name: "after a component is removed",
+			at:   time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+			want: map[string][]*IssueFieldValueItemScheme{
+				"components":  {{Value: "10001", String: "Frontend"}},
+				"fixVersions": {},
+				"labels":      {{Value: "customer", String: "customer"}, {Value: "urgent", String: "urgent"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			got, err := IssueFieldValuesAt(histories, tt.at)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, len(tt.want), len(got))
+			for key, want := range tt.want {
+				if assert.Contains(t, got, key) {
+					assert.ElementsMatch(t, want, got[key].Values, key)
+				}
+			}
+		})
+	}
+
+	got, err := IssueFieldValuesAt(histories, time.Date(2024, 3, 12, 0, 0, 0, 0, time.UTC))
+	if assert.NoError(t, err) {
+		assert.Equal(t, "10000, 10001", got["components"].Value)
+		assert.Equal(t, "Backend, Frontend", got["components"].String)
+	}
+}
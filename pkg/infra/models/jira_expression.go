@@ -0,0 +1,139 @@
+package models
+
+import "encoding/json"
+
+// The checks of the analysis of Jira expressions, each one including the previous ones.
+const (
+	ExpressionCheckSyntax     = "syntax"     // Checks the syntax of the expressions only.
+	ExpressionCheckType       = "type"       // Checks the syntax and the types of the expressions, the default check.
+	ExpressionCheckComplexity = "complexity" // Checks the syntax, the types and estimates the complexity of the expressions.
+)
+
+// ExpressionEvalPayloadScheme represents the payload used to evaluate a Jira expression.
+type ExpressionEvalPayloadScheme struct {
+	Expression string                       `json:"expression"`        // The Jira expression to evaluate.
+	Context    *ExpressionEvalContextScheme `json:"context,omitempty"` // The context variables available to the expression.
+}
+
+// ExpressionEvalContextScheme represents the context in which a Jira expression is evaluated.
+//
+// Each attribute set makes the matching variable available to the expression, e.g. the issue attribute
+// makes the issue variable available.
+type ExpressionEvalContextScheme struct {
+	Issue           *ExpressionEvalIssueScheme     `json:"issue,omitempty"`           // The issue available as issue, identified by ID or key.
+	Issues          *ExpressionEvalIssuesScheme    `json:"issues,omitempty"`          // The issues available as issues, matching a JQL query.
+	Project         *ExpressionEvalProjectScheme   `json:"project,omitempty"`         // The project available as project, identified by ID or key.
+	Sprint          int                            `json:"sprint,omitempty"`          // The ID of the sprint available as sprint.
+	Board           int                            `json:"board,omitempty"`           // The ID of the board available as board.
+	ServiceDesk     int                            `json:"serviceDesk,omitempty"`     // The ID of the service desk available as serviceDesk.
+	CustomerRequest int                            `json:"customerRequest,omitempty"` // The ID of the customer request available as customerRequest.
+	Custom          []*ExpressionCustomValueScheme `json:"custom,omitempty"`          // The custom context variables, e.g. an issue or a JSON value.
+}
+
+// ExpressionEvalIssueScheme represents an issue of the context of a Jira expression.
+type ExpressionEvalIssueScheme struct {
+	ID  int    `json:"id,omitempty"`  // The ID of the issue.
+	Key string `json:"key,omitempty"` // The key of the issue.
+}
+
+// ExpressionEvalIssuesScheme represents the issues of the context of a Jira expression.
+type ExpressionEvalIssuesScheme struct {
+	JQL *ExpressionEvalJQLScheme `json:"jql,omitempty"` // The JQL query selecting the issues.
+}
+
+// ExpressionEvalJQLScheme represents the JQL query selecting the issues of the context of a Jira expression.
+type ExpressionEvalJQLScheme struct {
+	Query      string `json:"query,omitempty"`      // The JQL query.
+	StartAt    int    `json:"startAt,omitempty"`    // The index of the first issue.
+	MaxResults int    `json:"maxResults,omitempty"` // The maximum number of issues, up to 1000.
+	Validation string `json:"validation,omitempty"` // The validation of the query: strict, warn or none.
+}
+
+// ExpressionEvalProjectScheme represents a project of the context of a Jira expression.
+type ExpressionEvalProjectScheme struct {
+	ID  int    `json:"id,omitempty"`  // The ID of the project.
+	Key string `json:"key,omitempty"` // The key of the project.
+}
+
+// ExpressionCustomValueScheme represents a custom context variable of a Jira expression.
+type ExpressionCustomValueScheme struct {
+	Type  string `json:"type"`            // The type of the variable: issue or json.
+	Key   string `json:"key,omitempty"`   // The key of the issue, for the issue variables.
+	ID    int    `json:"id,omitempty"`    // The ID of the issue, for the issue variables.
+	Value any    `json:"value,omitempty"` // The value, for the json variables.
+}
+
+// ExpressionEvalScheme represents the result of the evaluation of a Jira expression.
+type ExpressionEvalScheme struct {
+	Value json.RawMessage           `json:"value,omitempty"` // The result of the expression, decode it into the expected type.
+	Meta  *ExpressionEvalMetaScheme `json:"meta,omitempty"`  // The metadata of the evaluation.
+}
+
+// ExpressionEvalMetaScheme represents the metadata of the evaluation of a Jira expression.
+type ExpressionEvalMetaScheme struct {
+	Complexity *ExpressionComplexityScheme     `json:"complexity,omitempty"` // The complexity of the evaluation.
+	Issues     *ExpressionEvalIssuesMetaScheme `json:"issues,omitempty"`     // The metadata of the issues of the context.
+}
+
+// ExpressionComplexityScheme represents the complexity of the evaluation of a Jira expression against its limits.
+type ExpressionComplexityScheme struct {
+	Steps               *ExpressionComplexityValueScheme `json:"steps,omitempty"`               // The number of steps of the evaluation.
+	ExpensiveOperations *ExpressionComplexityValueScheme `json:"expensiveOperations,omitempty"` // The number of expensive operations, e.g. loading issues.
+	Beans               *ExpressionComplexityValueScheme `json:"beans,omitempty"`               // The number of Jira objects used, e.g. issues or users.
+	PrimitiveValues     *ExpressionComplexityValueScheme `json:"primitiveValues,omitempty"`     // The number of primitive values used.
+}
+
+// ExpressionComplexityValueScheme represents a measure of the complexity of a Jira expression.
+type ExpressionComplexityValueScheme struct {
+	Value int `json:"value"` // The measured value.
+	Limit int `json:"limit"` // The maximum value allowed.
+}
+
+// ExpressionEvalIssuesMetaScheme represents the metadata of the issues of the context of a Jira expression.
+type ExpressionEvalIssuesMetaScheme struct {
+	JQL *ExpressionEvalJQLMetaScheme `json:"jql,omitempty"` // The metadata of the JQL query.
+}
+
+// ExpressionEvalJQLMetaScheme represents the metadata of the JQL query selecting the issues of the context.
+type ExpressionEvalJQLMetaScheme struct {
+	StartAt            int      `json:"startAt,omitempty"`            // The index of the first issue.
+	MaxResults         int      `json:"maxResults,omitempty"`         // The maximum number of issues.
+	Count              int      `json:"count,omitempty"`              // The number of issues loaded.
+	TotalCount         int      `json:"totalCount,omitempty"`         // The total number of issues matching the query.
+	ValidationWarnings []string `json:"validationWarnings,omitempty"` // The warnings of the validation of the query.
+}
+
+// ExpressionAnalysePayloadScheme represents the payload used to analyse Jira expressions.
+type ExpressionAnalysePayloadScheme struct {
+	Expressions      []string          `json:"expressions"`                // The Jira expressions to analyse.
+	ContextVariables map[string]string `json:"contextVariables,omitempty"` // The types of the custom context variables, keyed by name.
+}
+
+// ExpressionAnalysisScheme represents the analysis of Jira expressions.
+type ExpressionAnalysisScheme struct {
+	Results []*ExpressionAnalysisResultScheme `json:"results,omitempty"` // The analysis of each expression, in the payload order.
+}
+
+// ExpressionAnalysisResultScheme represents the analysis of a Jira expression.
+type ExpressionAnalysisResultScheme struct {
+	Expression string                              `json:"expression,omitempty"` // The analysed expression.
+	Valid      bool                                `json:"valid"`                // Indicates if the expression passed the check.
+	Type       string                              `json:"type,omitempty"`       // The type the expression evaluates to, returned by the type check.
+	Errors     []*ExpressionAnalysisErrorScheme    `json:"errors,omitempty"`     // The errors found by the check.
+	Complexity *ExpressionAnalysisComplexityScheme `json:"complexity,omitempty"` // The estimated complexity, returned by the complexity check.
+}
+
+// ExpressionAnalysisErrorScheme represents an error found in a Jira expression.
+type ExpressionAnalysisErrorScheme struct {
+	Line       int    `json:"line,omitempty"`       // The line of the error.
+	Column     int    `json:"column,omitempty"`     // The column of the error.
+	Expression string `json:"expression,omitempty"` // The part of the expression containing the error.
+	Message    string `json:"message,omitempty"`    // The description of the error.
+	Type       string `json:"type,omitempty"`       // The type of the error: syntax, type or other.
+}
+
+// ExpressionAnalysisComplexityScheme represents the estimated complexity of a Jira expression.
+type ExpressionAnalysisComplexityScheme struct {
+	ExpensiveOperations string            `json:"expensiveOperations,omitempty"` // The formula of the number of expensive operations, e.g. "N", where the variables are the sizes of the lists.
+	Variables           map[string]string `json:"variables,omitempty"`           // The description of the variables of the formula, keyed by name.
+}
@@ -7,8 +7,200 @@ type IssueSecurityLevelsScheme struct {
 
 // IssueSecurityLevelScheme represents a security level of an issue in Jira.
 type IssueSecurityLevelScheme struct {
-	Self        string `json:"self,omitempty"`        // The URL of the security level.
-	ID          string `json:"id,omitempty"`          // The ID of the security level.
-	Description string `json:"description,omitempty"` // The description of the security level.
-	Name        string `json:"name,omitempty"`        // The name of the security level.
+	Self                  string `json:"self,omitempty"`                  // The URL of the security level.
+	ID                    string `json:"id,omitempty"`                    // The ID of the security level.
+	Description           string `json:"description,omitempty"`           // The description of the security level.
+	Name                  string `json:"name,omitempty"`                  // The name of the security level.
+	IsDefault             bool   `json:"isDefault,omitempty"`             // Indicates if the level is the default level of its scheme.
+	IssueSecuritySchemeID string `json:"issueSecuritySchemeId,omitempty"` // The ID of the scheme of the level.
+}
+
+// IssueSecuritySchemesScheme represents the list of the issue security schemes.
+type IssueSecuritySchemesScheme struct {
+	IssueSecuritySchemes []*IssueSecuritySchemeScheme `json:"issueSecuritySchemes,omitempty"` // The issue security schemes.
+}
+
+// IssueSecuritySchemeScheme represents an issue security scheme, the security levels restricting who can see the issues.
+type IssueSecuritySchemeScheme struct {
+	Self                   string                      `json:"self,omitempty"`                   // The URL of the scheme.
+	ID                     int                         `json:"id,omitempty"`                     // The ID of the scheme.
+	Name                   string                      `json:"name,omitempty"`                   // The name of the scheme.
+	Description            string                      `json:"description,omitempty"`            // The description of the scheme.
+	DefaultSecurityLevelID int                         `json:"defaultSecurityLevelId,omitempty"` // The ID of the default security level.
+	Levels                 []*IssueSecurityLevelScheme `json:"levels,omitempty"`                 // The security levels of the scheme.
+}
+
+// IssueSecuritySchemeSearchOptions represents the filters used to search the issue security schemes.
+type IssueSecuritySchemeSearchOptions struct {
+	IDs        []string // The IDs of the schemes.
+	ProjectIDs []string // The IDs of the projects associated with the schemes.
+}
+
+// IssueSecuritySchemeSearchPageScheme represents a paginated list of issue security schemes.
+type IssueSecuritySchemeSearchPageScheme struct {
+	Self       string                             `json:"self,omitempty"`       // The URL of the page.
+	NextPage   string                             `json:"nextPage,omitempty"`   // The URL of the next page.
+	MaxResults int                                `json:"maxResults,omitempty"` // The maximum number of schemes per page.
+	StartAt    int                                `json:"startAt,omitempty"`    // The index of the first scheme of the page.
+	Total      int                                `json:"total,omitempty"`      // The total number of schemes.
+	IsLast     bool                               `json:"isLast,omitempty"`     // Indicates if the page is the last one.
+	Values     []*IssueSecuritySchemeSearchScheme `json:"values,omitempty"`     // The schemes of the page.
+}
+
+// IssueSecuritySchemeSearchScheme represents an issue security scheme returned by a search.
+type IssueSecuritySchemeSearchScheme struct {
+	Self         string `json:"self,omitempty"`         // The URL of the scheme.
+	ID           string `json:"id,omitempty"`           // The ID of the scheme.
+	Name         string `json:"name,omitempty"`         // The name of the scheme.
+	Description  string `json:"description,omitempty"`  // The description of the scheme.
+	DefaultLevel int    `json:"defaultLevel,omitempty"` // The ID of the default security level.
+	ProjectIDs   []int  `json:"projectIds,omitempty"`   // The IDs of the projects associated with the scheme.
+}
+
+// IssueSecuritySchemePayloadScheme represents the payload used to create an issue security scheme.
+type IssueSecuritySchemePayloadScheme struct {
+	Name        string                             `json:"name"`                  // The name of the scheme, unique.
+	Description string                             `json:"description,omitempty"` // The description of the scheme.
+	Levels      []*IssueSecurityLevelPayloadScheme `json:"levels,omitempty"`      // The security levels of the scheme.
+}
+
+// IssueSecuritySchemeUpdatePayloadScheme represents the payload used to update an issue security scheme.
+type IssueSecuritySchemeUpdatePayloadScheme struct {
+	Name        string `json:"name,omitempty"`        // The new name of the scheme, unique.
+	Description string `json:"description,omitempty"` // The new description of the scheme.
+}
+
+// IssueSecuritySchemeCreatedScheme represents the issue security scheme created.
+type IssueSecuritySchemeCreatedScheme struct {
+	ID string `json:"id,omitempty"` // The ID of the scheme.
+}
+
+// IssueSecuritySchemeProjectPageScheme represents a paginated list of the associations of the issue security schemes with projects.
+type IssueSecuritySchemeProjectPageScheme struct {
+	Self       string                              `json:"self,omitempty"`       // The URL of the page.
+	NextPage   string                              `json:"nextPage,omitempty"`   // The URL of the next page.
+	MaxResults int                                 `json:"maxResults,omitempty"` // The maximum number of associations per page.
+	StartAt    int                                 `json:"startAt,omitempty"`    // The index of the first association of the page.
+	Total      int                                 `json:"total,omitempty"`      // The total number of associations.
+	IsLast     bool                                `json:"isLast,omitempty"`     // Indicates if the page is the last one.
+	Values     []*IssueSecuritySchemeProjectScheme `json:"values,omitempty"`     // The associations of the page.
+}
+
+// IssueSecuritySchemeProjectScheme represents the association of an issue security scheme with a project.
+type IssueSecuritySchemeProjectScheme struct {
+	IssueSecuritySchemeID string `json:"issueSecuritySchemeId,omitempty"` // The ID of the scheme.
+	ProjectID             string `json:"projectId,omitempty"`             // The ID of the project.
+}
+
+// IssueSecuritySchemeAssociatePayloadScheme represents the payload used to associate an issue security scheme with a project.
+//
+// The issues of the project with a security level are moved to the levels of the new scheme following the mappings,
+// every level of the previous scheme used by the issues must be mapped.
+type IssueSecuritySchemeAssociatePayloadScheme struct {
+	SchemeID                      string                             `json:"schemeId"`                                // The ID of the scheme, "-1" to remove the scheme of the project.
+	ProjectID                     string                             `json:"projectId"`                               // The ID of the project.
+	OldToNewSecurityLevelMappings []*IssueSecurityLevelMappingScheme `json:"oldToNewSecurityLevelMappings,omitempty"` // The mappings of the levels of the previous scheme to the levels of the new one.
+}
+
+// IssueSecurityLevelMappingScheme represents the mapping of a security level of the previous scheme of a project to a level of its new scheme.
+type IssueSecurityLevelMappingScheme struct {
+	OldLevelID string `json:"oldLevelId"` // The ID of the level of the previous scheme, "-1" for the issues without level.
+	NewLevelID string `json:"newLevelId"` // The ID of the level of the new scheme, "-1" to remove the level of the issues.
+}
+
+// IssueSecurityLevelSearchOptions represents the filters used to search the security levels.
+type IssueSecurityLevelSearchOptions struct {
+	IDs         []string // The IDs of the levels.
+	SchemeIDs   []string // The IDs of the schemes of the levels.
+	OnlyDefault bool     // Returns only the default level of each scheme.
+}
+
+// IssueSecurityLevelPageScheme represents a paginated list of security levels.
+type IssueSecurityLevelPageScheme struct {
+	Self       string                      `json:"self,omitempty"`       // The URL of the page.
+	NextPage   string                      `json:"nextPage,omitempty"`   // The URL of the next page.
+	MaxResults int                         `json:"maxResults,omitempty"` // The maximum number of levels per page.
+	StartAt    int                         `json:"startAt,omitempty"`    // The index of the first level of the page.
+	Total      int                         `json:"total,omitempty"`      // The total number of levels.
+	IsLast     bool                        `json:"isLast,omitempty"`     // Indicates if the page is the last one.
+	Values     []*IssueSecurityLevelScheme `json:"values,omitempty"`     // The levels of the page.
+}
+
+// IssueSecurityLevelsPayloadScheme represents the payload used to add security levels to an issue security scheme.
+type IssueSecurityLevelsPayloadScheme struct {
+	Levels []*IssueSecurityLevelPayloadScheme `json:"levels"` // The security levels to add.
+}
+
+// IssueSecurityLevelPayloadScheme represents a security level to add to an issue security scheme.
+type IssueSecurityLevelPayloadScheme struct {
+	Name        string                                   `json:"name"`                  // The name of the level, unique within the scheme.
+	Description string                                   `json:"description,omitempty"` // The description of the level.
+	IsDefault   bool                                     `json:"isDefault,omitempty"`   // Indicates if the level is the default level of the scheme.
+	Members     []*IssueSecurityLevelMemberPayloadScheme `json:"members,omitempty"`     // The members of the level.
+}
+
+// IssueSecurityLevelUpdatePayloadScheme represents the payload used to update a security level.
+type IssueSecurityLevelUpdatePayloadScheme struct {
+	Name        string `json:"name,omitempty"`        // The new name of the level, unique within the scheme.
+	Description string `json:"description,omitempty"` // The new description of the level.
+}
+
+// IssueSecurityLevelDefaultsPayloadScheme represents the payload used to set the default security levels of issue security schemes.
+type IssueSecurityLevelDefaultsPayloadScheme struct {
+	DefaultValues []*IssueSecurityLevelDefaultScheme `json:"defaultValues"` // The default levels, one per scheme.
+}
+
+// IssueSecurityLevelDefaultScheme represents the default security level of an issue security scheme.
+type IssueSecurityLevelDefaultScheme struct {
+	IssueSecuritySchemeID string `json:"issueSecuritySchemeId"` // The ID of the scheme.
+	DefaultLevelID        string `json:"defaultLevelId"`        // The ID of the level, "-1" to unset the default level.
+}
+
+// IssueSecurityLevelMemberSearchOptions represents the filters used to search the members of the security levels.
+type IssueSecurityLevelMemberSearchOptions struct {
+	IDs       []string // The IDs of the members.
+	SchemeIDs []string // The IDs of the schemes of the levels.
+	LevelIDs  []string // The IDs of the levels.
+	Expand    []string // The details of the holders to include, e.g. "user", "group", "projectRole" or "all".
+}
+
+// IssueSecurityLevelMemberPageScheme represents a paginated list of the members of security levels.
+type IssueSecurityLevelMemberPageScheme struct {
+	Self       string                            `json:"self,omitempty"`       // The URL of the page.
+	NextPage   string                            `json:"nextPage,omitempty"`   // The URL of the next page.
+	MaxResults int                               `json:"maxResults,omitempty"` // The maximum number of members per page.
+	StartAt    int                               `json:"startAt,omitempty"`    // The index of the first member of the page.
+	Total      int                               `json:"total,omitempty"`      // The total number of members.
+	IsLast     bool                              `json:"isLast,omitempty"`     // Indicates if the page is the last one.
+	Values     []*IssueSecurityLevelMemberScheme `json:"values,omitempty"`     // The members of the page.
+}
+
+// IssueSecurityLevelMemberScheme represents a member of a security level, the users allowed to see the issues with the level.
+type IssueSecurityLevelMemberScheme struct {
+	ID                    string                                `json:"id,omitempty"`                    // The ID of the member.
+	IssueSecurityLevelID  string                                `json:"issueSecurityLevelId,omitempty"`  // The ID of the level.
+	IssueSecuritySchemeID string                                `json:"issueSecuritySchemeId,omitempty"` // The ID of the scheme.
+	Holder                *IssueSecurityLevelMemberHolderScheme `json:"holder,omitempty"`                // The users of the member.
+}
+
+// IssueSecurityLevelMemberHolderScheme represents the users of a member of a security level.
+type IssueSecurityLevelMemberHolderScheme struct {
+	Type      string `json:"type,omitempty"`      // The type of the holder, e.g. "user", "group" or "projectRole".
+	Parameter string `json:"parameter,omitempty"` // The identifier of the holder, e.g. the account ID of the user.
+	Value     string `json:"value,omitempty"`     // The identifier of the holder, e.g. the ID of the group.
+	Expand    string `json:"expand,omitempty"`    // The details of the holder available for expansion.
+}
+
+// IssueSecurityLevelMembersPayloadScheme represents the payload used to add members to a security level.
+type IssueSecurityLevelMembersPayloadScheme struct {
+	Members []*IssueSecurityLevelMemberPayloadScheme `json:"members"` // The members to add.
+}
+
+// IssueSecurityLevelMemberPayloadScheme represents a member to add to a security level.
+//
+// The type is one of "user", "group", "projectRole", "reporter", "assignee", "projectLead", "applicationRole" or
+// "userCustomField", the parameter identifies the holder, e.g. the account ID of the user or the ID of the role.
+type IssueSecurityLevelMemberPayloadScheme struct {
+	Type      string `json:"type"`                // The type of the member.
+	Parameter string `json:"parameter,omitempty"` // The identifier of the member.
 }
@@ -8,4 +8,166 @@ type PriorityScheme struct {
 	IconURL     string `json:"iconUrl,omitempty"`     // The URL of the icon for the priority.
 	Name        string `json:"name,omitempty"`        // The name of the priority.
 	ID          string `json:"id,omitempty"`          // The ID of the priority.
+	IsDefault   bool   `json:"isDefault,omitempty"`   // Indicates if the priority is the default priority.
+}
+
+// PrioritySearchOptions represents the filters used to search the priorities.
+type PrioritySearchOptions struct {
+	IDs          []string // The IDs of the priorities.
+	ProjectIDs   []string // The IDs of the projects using the priorities.
+	PriorityName string   // The string the names of the priorities contain.
+	OnlyDefault  bool     // Returns only the default priority.
+	Expand       []string // The details to include, e.g. "schemes".
+}
+
+// PriorityPageScheme represents a paginated list of priorities.
+type PriorityPageScheme struct {
+	Self       string            `json:"self,omitempty"`       // The URL of the page.
+	NextPage   string            `json:"nextPage,omitempty"`   // The URL of the next page.
+	MaxResults int               `json:"maxResults,omitempty"` // The maximum number of priorities per page.
+	StartAt    int               `json:"startAt,omitempty"`    // The index of the first priority of the page.
+	Total      int               `json:"total,omitempty"`      // The total number of priorities.
+	IsLast     bool              `json:"isLast,omitempty"`     // Indicates if the page is the last one.
+	Values     []*PriorityScheme `json:"values,omitempty"`     // The priorities of the page.
+}
+
+// PriorityPayloadScheme represents the payload used to create or update a priority.
+type PriorityPayloadScheme struct {
+	Name        string `json:"name,omitempty"`        // The name of the priority, unique, required on creation.
+	Description string `json:"description,omitempty"` // The description of the priority.
+	StatusColor string `json:"statusColor,omitempty"` // The color of the priority in hexadecimal, e.g. "#FF0000", required on creation.
+	IconURL     string `json:"iconUrl,omitempty"`     // The URL of the icon of the priority.
+	AvatarID    int    `json:"avatarId,omitempty"`    // The ID of the avatar of the priority, replacing the icon URL.
+}
+
+// PriorityCreatedScheme represents the priority created.
+type PriorityCreatedScheme struct {
+	ID string `json:"id,omitempty"` // The ID of the priority.
+}
+
+// PriorityMovePayloadScheme represents the payload used to reorder priorities.
+//
+// The priorities are moved after the priority with the ID, or to the position, either "First" or "Last".
+type PriorityMovePayloadScheme struct {
+	IDs      []string `json:"ids"`                // The IDs of the priorities to move, in their new order.
+	After    string   `json:"after,omitempty"`    // The ID of the priority to move the priorities after.
+	Position string   `json:"position,omitempty"` // The position to move the priorities to.
+}
+
+// PrioritySchemeSearchOptions represents the filters used to search the priority schemes.
+type PrioritySchemeSearchOptions struct {
+	IDs         []string // The IDs of the schemes.
+	PriorityIDs []string // The IDs of the priorities the schemes contain.
+	SchemeName  string   // The string the names of the schemes contain.
+	OnlyDefault bool     // Returns only the default scheme.
+	OrderBy     string   // The order of the schemes, "name" or "-name".
+	Expand      []string // The details to include, e.g. "priorities" or "projects".
+}
+
+// PrioritySchemePageScheme represents a paginated list of priority schemes.
+type PrioritySchemePageScheme struct {
+	Self       string                  `json:"self,omitempty"`       // The URL of the page.
+	NextPage   string                  `json:"nextPage,omitempty"`   // The URL of the next page.
+	MaxResults int                     `json:"maxResults,omitempty"` // The maximum number of schemes per page.
+	StartAt    int                     `json:"startAt,omitempty"`    // The index of the first scheme of the page.
+	Total      int                     `json:"total,omitempty"`      // The total number of schemes.
+	IsLast     bool                    `json:"isLast,omitempty"`     // Indicates if the page is the last one.
+	Values     []*PrioritySchemeScheme `json:"values,omitempty"`     // The schemes of the page.
+}
+
+// PrioritySchemeScheme represents a priority scheme, the priorities available to the issues of its projects.
+type PrioritySchemeScheme struct {
+	Self              string                           `json:"self,omitempty"`              // The URL of the scheme.
+	ID                string                           `json:"id,omitempty"`                // The ID of the scheme.
+	Name              string                           `json:"name,omitempty"`              // The name of the scheme.
+	Description       string                           `json:"description,omitempty"`       // The description of the scheme.
+	IsDefault         bool                             `json:"isDefault,omitempty"`         // Indicates if the scheme is the default scheme.
+	DefaultPriorityID string                           `json:"defaultPriorityId,omitempty"` // The ID of the default priority of the scheme.
+	Priorities        *PriorityPageScheme              `json:"priorities,omitempty"`        // The first priorities of the scheme, when expanded.
+	Projects          *PrioritySchemeProjectPageScheme `json:"projects,omitempty"`          // The first projects of the scheme, when expanded.
+}
+
+// PrioritySchemeProjectPageScheme represents a paginated list of the projects using a priority scheme.
+type PrioritySchemeProjectPageScheme struct {
+	Self       string           `json:"self,omitempty"`       // The URL of the page.
+	NextPage   string           `json:"nextPage,omitempty"`   // The URL of the next page.
+	MaxResults int              `json:"maxResults,omitempty"` // The maximum number of projects per page.
+	StartAt    int              `json:"startAt,omitempty"`    // The index of the first project of the page.
+	Total      int              `json:"total,omitempty"`      // The total number of projects.
+	IsLast     bool             `json:"isLast,omitempty"`     // Indicates if the page is the last one.
+	Values     []*ProjectScheme `json:"values,omitempty"`     // The projects of the page.
+}
+
+// PrioritySchemeSuggestedProjectPageScheme represents a paginated list of the projects a priority scheme can be assigned to.
+type PrioritySchemeSuggestedProjectPageScheme struct {
+	Self       string           `json:"self,omitempty"`       // The URL of the page.
+	NextPage   string           `json:"nextPage,omitempty"`   // The URL of the next page.
+	MaxResults int              `json:"maxResults,omitempty"` // The maximum number of projects per page.
+	StartAt    int              `json:"startAt,omitempty"`    // The index of the first project of the page.
+	Total      int              `json:"total,omitempty"`      // The total number of projects.
+	IsLast     bool             `json:"isLast,omitempty"`     // Indicates if the page is the last one.
+	Values     []*ProjectScheme `json:"values,omitempty"`     // The projects of the page, with their ID, key, name and avatars.
+}
+
+// PrioritySchemePayloadScheme represents the payload used to create a priority scheme.
+type PrioritySchemePayloadScheme struct {
+	Name              string                        `json:"name"`                  // The name of the scheme, unique.
+	Description       string                        `json:"description,omitempty"` // The description of the scheme.
+	DefaultPriorityID int                           `json:"defaultPriorityId"`     // The ID of the default priority, one of the priorities of the scheme.
+	PriorityIDs       []int                         `json:"priorityIds"`           // The IDs of the priorities of the scheme.
+	ProjectIDs        []int                         `json:"projectIds,omitempty"`  // The IDs of the projects to assign the scheme to.
+	Mappings          *PrioritySchemeMappingsScheme `json:"mappings,omitempty"`    // The remapping of the priorities of the issues of the projects.
+}
+
+// PrioritySchemeUpdatePayloadScheme represents the payload used to update a priority scheme.
+//
+// Only the attributes set are changed, the priorities removed and the projects added may require remapping the issues.
+type PrioritySchemeUpdatePayloadScheme struct {
+	Name              string                        `json:"name,omitempty"`              // The new name of the scheme, unique.
+	Description       string                        `json:"description,omitempty"`       // The new description of the scheme.
+	DefaultPriorityID int                           `json:"defaultPriorityId,omitempty"` // The ID of the new default priority.
+	Priorities        *PrioritySchemeChangesScheme  `json:"priorities,omitempty"`        // The priorities to add to or remove from the scheme.
+	Projects          *PrioritySchemeChangesScheme  `json:"projects,omitempty"`          // The projects to assign the scheme to or unassign it from.
+	Mappings          *PrioritySchemeMappingsScheme `json:"mappings,omitempty"`          // The remapping of the priorities of the issues.
+}
+
+// PrioritySchemeChangesScheme represents the priorities or the projects added to and removed from a priority scheme.
+type PrioritySchemeChangesScheme struct {
+	Add    *PrioritySchemeIDsScheme `json:"add,omitempty"`    // The IDs added.
+	Remove *PrioritySchemeIDsScheme `json:"remove,omitempty"` // The IDs removed.
+}
+
+// PrioritySchemeIDsScheme represents the IDs of the priorities or the projects changed on a priority scheme.
+type PrioritySchemeIDsScheme struct {
+	IDs []int `json:"ids"` // The IDs of the priorities or the projects.
+}
+
+// PrioritySchemeMappingsScheme represents the remapping of the priorities of the issues moved between priority schemes.
+//
+// Both mappings are keyed by the ID of the current priority of the issues and hold the ID of their new priority.
+type PrioritySchemeMappingsScheme struct {
+	In  map[string]int `json:"in,omitempty"`  // The mapping of the issues moving into the scheme, e.g. of the projects added.
+	Out map[string]int `json:"out,omitempty"` // The mapping of the issues moving out of the scheme, e.g. using the priorities removed.
+}
+
+// PrioritySchemeCreatedScheme represents the priority scheme created.
+type PrioritySchemeCreatedScheme struct {
+	ID   string      `json:"id,omitempty"`   // The ID of the scheme.
+	Task *TaskScheme `json:"task,omitempty"` // The task remapping the issues of the projects, when any.
+}
+
+// PrioritySchemeUpdatedScheme represents the priority scheme updated.
+type PrioritySchemeUpdatedScheme struct {
+	PriorityScheme *PrioritySchemeScheme `json:"priorityScheme,omitempty"` // The scheme updated.
+	Task           *TaskScheme           `json:"task,omitempty"`           // The task remapping the issues, when any.
+}
+
+// PrioritySchemeMappingsPayloadScheme represents the payload used to get the priorities requiring a mapping
+// when changing a priority scheme, the changes are not applied.
+type PrioritySchemeMappingsPayloadScheme struct {
+	SchemeID   int                          `json:"schemeId"`             // The ID of the scheme.
+	Priorities *PrioritySchemeChangesScheme `json:"priorities,omitempty"` // The priorities to add or remove.
+	Projects   *PrioritySchemeChangesScheme `json:"projects,omitempty"`   // The projects to assign the scheme to, only the added ones are considered.
+	StartAt    int                          `json:"startAt,omitempty"`    // The index of the first priority.
+	MaxResults int                          `json:"maxResults,omitempty"` // The maximum number of priorities.
 }
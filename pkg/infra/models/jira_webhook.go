@@ -0,0 +1,121 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// webhookTimeFormats are the formats of the expiration dates of the dynamic webhooks sent as a string.
+var webhookTimeFormats = []string{"2006-01-02T15:04:05.000-0700", TimeFormat, time.RFC3339Nano}
+
+// WebhookRegistrationPayloadScheme represents the payload used to register dynamic webhooks.
+type WebhookRegistrationPayloadScheme struct {
+	URL      string                  `json:"url,omitempty"`      // The URL receiving the deliveries, it must be on the base URL of the app.
+	Webhooks []*WebhookPayloadScheme `json:"webhooks,omitempty"` // The webhooks to register.
+}
+
+// WebhookPayloadScheme represents a dynamic webhook to register.
+type WebhookPayloadScheme struct {
+	Events                  []string `json:"events,omitempty"`                  // The events sending the deliveries, e.g. "jira:issue_created".
+	JqlFilter               string   `json:"jqlFilter,omitempty"`               // The JQL query filtering the issues of the issue events, e.g. "project = DEV".
+	FieldIDsFilter          []string `json:"fieldIdsFilter,omitempty"`          // The IDs of the fields triggering the jira:issue_updated event when changed.
+	IssuePropertyKeysFilter []string `json:"issuePropertyKeysFilter,omitempty"` // The keys of the issue properties triggering the issue_property events.
+}
+
+// WebhookRegistrationResultScheme represents the result of the registration of dynamic webhooks.
+type WebhookRegistrationResultScheme struct {
+	WebhookRegistrationResult []*WebhookRegistrationScheme `json:"webhookRegistrationResult,omitempty"` // The results, in the order of the webhooks of the payload.
+}
+
+// WebhookRegistrationScheme represents the result of the registration of a dynamic webhook.
+type WebhookRegistrationScheme struct {
+	CreatedWebhookID int      `json:"createdWebhookId,omitempty"` // The ID of the webhook, when it's registered.
+	Errors           []string `json:"errors,omitempty"`           // The errors preventing the registration of the webhook.
+}
+
+// WebhookPageScheme represents a paginated list of dynamic webhooks.
+type WebhookPageScheme struct {
+	MaxResults int              `json:"maxResults,omitempty"` // The maximum number of webhooks per page.
+	StartAt    int              `json:"startAt,omitempty"`    // The index of the first webhook of the page.
+	Total      int              `json:"total,omitempty"`      // The total number of webhooks.
+	IsLast     bool             `json:"isLast,omitempty"`     // Indicates if the page is the last one.
+	Values     []*WebhookScheme `json:"values,omitempty"`     // The webhooks of the page.
+}
+
+// WebhookScheme represents a dynamic webhook registered by the app.
+type WebhookScheme struct {
+	ID                      int                          `json:"id,omitempty"`                      // The ID of the webhook.
+	Events                  []string                     `json:"events,omitempty"`                  // The events sending the deliveries.
+	JqlFilter               string                       `json:"jqlFilter,omitempty"`               // The JQL query filtering the issues of the issue events.
+	FieldIDsFilter          []string                     `json:"fieldIdsFilter,omitempty"`          // The IDs of the fields triggering the jira:issue_updated event when changed.
+	IssuePropertyKeysFilter []string                     `json:"issuePropertyKeysFilter,omitempty"` // The keys of the issue properties triggering the issue_property events.
+	ExpirationDate          *WebhookExpirationDateScheme `json:"expirationDate,omitempty"`          // The expiration time of the webhook.
+}
+
+// WebhookIDsPayloadScheme represents the payload used to delete or to refresh dynamic webhooks.
+type WebhookIDsPayloadScheme struct {
+	WebhookIDs []int `json:"webhookIds"` // The IDs of the webhooks.
+}
+
+// WebhookRefreshScheme represents the result of the refresh of dynamic webhooks.
+type WebhookRefreshScheme struct {
+	ExpirationDate *WebhookExpirationDateScheme `json:"expirationDate,omitempty"` // The new expiration time of the webhooks.
+}
+
+// FailedWebhookPageScheme represents a paginated list of the failed webhook deliveries, the oldest first.
+type FailedWebhookPageScheme struct {
+	MaxResults int                    `json:"maxResults,omitempty"` // The maximum number of failed deliveries per page.
+	Next       string                 `json:"next,omitempty"`       // The URL to the next page.
+	Values     []*FailedWebhookScheme `json:"values,omitempty"`     // The failed deliveries of the page.
+}
+
+// FailedWebhookScheme represents a webhook delivery which failed, retained for 72 hours.
+type FailedWebhookScheme struct {
+	ID          string `json:"id,omitempty"`          // The ID of the webhook.
+	Body        string `json:"body,omitempty"`        // The payload of the delivery.
+	URL         string `json:"url,omitempty"`         // The URL the delivery was sent to.
+	FailureTime int64  `json:"failureTime,omitempty"` // The time of the failure, in milliseconds since the epoch.
+}
+
+// WebhookExpirationDateScheme is a custom time type for the expiration dates of the dynamic webhooks.
+//
+// The schema of the Jira API defines them as milliseconds since the epoch,
+// while its examples return date-time strings (e.g. "2019-06-01T12:42:30.000+0000"), both are decoded.
+type WebhookExpirationDateScheme time.Time
+
+// MarshalJSON marshals the WebhookExpirationDateScheme to JSON as milliseconds since the epoch.
+func (d *WebhookExpirationDateScheme) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatInt(time.Time(*d).UnixMilli(), 10)), nil
+}
+
+// UnmarshalJSON unmarshals the WebhookExpirationDateScheme from JSON.
+//
+// It accepts:
+//   - Unix epoch millisecond numbers, also quoted (e.g. 1559392950000 or "1559392950000")
+//   - Jira and RFC 3339 date-time strings (e.g. "2019-06-01T12:42:30.000+0000" or "2019-06-01T12:42:30Z")
+func (d *WebhookExpirationDateScheme) UnmarshalJSON(data []byte) error {
+
+	raw := string(data)
+	if raw == "null" {
+		return nil
+	}
+
+	if unquoted, err := strconv.Unquote(raw); err == nil {
+		raw = unquoted
+	}
+
+	if ms, err := strconv.ParseInt(raw, 10, 64); err == nil {
+		*d = WebhookExpirationDateScheme(time.UnixMilli(ms).UTC())
+		return nil
+	}
+
+	for _, format := range webhookTimeFormats {
+		if parsed, err := time.Parse(format, raw); err == nil {
+			*d = WebhookExpirationDateScheme(parsed)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("cannot parse %v as webhook expiration date", string(data))
+}
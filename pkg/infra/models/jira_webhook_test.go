@@ -0,0 +1,99 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookExpirationDateScheme_UnmarshalJSON(t *testing.T) {
+
+	expected := time.Date(2019, 6, 1, 12, 42, 30, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		data    string
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{name: "Unix epoch milliseconds", data: `1559392950000`, wantErr: assert.NoError},
+		{name: "quoted Unix epoch milliseconds", data: `"1559392950000"`, wantErr: assert.NoError},
+		{name: "Jira date-time with milliseconds", data: `"2019-06-01T12:42:30.000+0000"`, wantErr: assert.NoError},
+		{name: "Jira date-time", data: `"2019-06-01T14:42:30+0200"`, wantErr: assert.NoError},
+		{name: "RFC 3339", data: `"2019-06-01T12:42:30Z"`, wantErr: assert.NoError},
+		{name: "invalid string", data: `"next week"`, wantErr: assert.Error},
+		{name: "invalid token", data: `true`, wantErr: assert.Error},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			var got WebhookExpirationDateScheme
+			err := got.UnmarshalJSON([]byte(tt.data))
+			if !tt.wantErr(t, err) || err != nil {
+				return
+			}
+
+			assert.True(t, expected.Equal(time.Time(got)), time.Time(got))
+		})
+	}
+}
+
+func TestWebhookExpirationDateScheme_Documented(t *testing.T) {
+
+	expected := time.Date(2019, 6, 1, 12, 42, 30, 0, time.UTC)
+
+	t.Run("when the webhooks are refreshed", func(t *testing.T) {
+
+		var result WebhookRefreshScheme
+		assert.NoError(t, json.Unmarshal([]byte(`{"expirationDate": "2019-06-01T12:42:30.000+0000"}`), &result))
+
+		if assert.NotNil(t, result.ExpirationDate) {
+			assert.True(t, expected.Equal(time.Time(*result.ExpirationDate)))
+		}
+	})
+
+	t.Run("when the webhooks are listed", func(t *testing.T) {
+
+		body := `{
+			"isLast": true,
+			"maxResults": 3,
+			"startAt": 0,
+			"total": 2,
+			"values": [
+				{
+					"events": ["jira:issue_updated", "jira:issue_created"],
+					"expirationDate": "2019-06-01T12:42:30.000+0000",
+					"fieldIdsFilter": ["summary", "customfield_10029"],
+					"id": 10000,
+					"jqlFilter": "project = PRJ"
+				},
+				{
+					"events": ["issue_property_set"],
+					"expirationDate": 1559392950000,
+					"id": 10001,
+					"issuePropertyKeysFilter": ["my-issue-property-key"],
+					"jqlFilter": "project IN (PRJ, PRJ2)"
+				}
+			]
+		}`
+
+		var page WebhookPageScheme
+		assert.NoError(t, json.Unmarshal([]byte(body), &page))
+
+		if assert.Len(t, page.Values, 2) {
+			assert.True(t, expected.Equal(time.Time(*page.Values[0].ExpirationDate)))
+			assert.True(t, expected.Equal(time.Time(*page.Values[1].ExpirationDate)))
+		}
+	})
+
+	t.Run("when the expiration date is marshaled", func(t *testing.T) {
+
+		expiration := WebhookExpirationDateScheme(expected)
+		data, err := json.Marshal(&WebhookRefreshScheme{ExpirationDate: &expiration})
+
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"expirationDate": 1559392950000}`, string(data))
+	})
+}
@@ -13,4 +13,5 @@ type ResponseScheme struct {
 	Endpoint string       // The endpoint that the request was made to.
 	Method   string       // The HTTP method used for the request.
 	Bytes    bytes.Buffer // The response body.
+	Retries  int          // The number of times the request was retried before this response.
 }
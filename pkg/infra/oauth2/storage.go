@@ -2,6 +2,7 @@ package oauth2
 
 import (
 	"context"
+	"log/slog"
 
 	"github.com/ctreminiom/go-atlassian/v2/service/common"
 )
@@ -43,6 +44,7 @@ type TokenCallback interface {
 // CompositeTokenCallback allows multiple callbacks to be executed
 type CompositeTokenCallback struct {
 	callbacks []TokenCallback
+	logger    *slog.Logger
 }
 
 // NewCompositeTokenCallback creates a new composite callback
@@ -50,12 +52,20 @@ func NewCompositeTokenCallback(callbacks ...TokenCallback) *CompositeTokenCallba
 	return &CompositeTokenCallback{callbacks: callbacks}
 }
 
+// WithLogger sets the logger reporting the failing callbacks
+func (c *CompositeTokenCallback) WithLogger(logger *slog.Logger) *CompositeTokenCallback {
+	c.logger = logger
+	return c
+}
+
 // OnTokenRefreshed calls all registered callbacks
 func (c *CompositeTokenCallback) OnTokenRefreshed(ctx context.Context, oldToken, newToken *common.OAuth2Token) error {
 	for _, callback := range c.callbacks {
 		if err := callback.OnTokenRefreshed(ctx, oldToken, newToken); err != nil {
 			// Log error but continue with other callbacks
-			// In production, you might want to use a logger here
+			if c.logger != nil {
+				c.logger.WarnContext(ctx, "oauth2 token callback failed", slog.String("error", err.Error()))
+			}
 			continue
 		}
 	}
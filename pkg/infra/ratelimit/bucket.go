@@ -0,0 +1,107 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// relaxFactor is the growth applied to a tightened rate on every successful response.
+const relaxFactor = 1.05
+
+// bucket is a token bucket refilled at the current limit.
+type bucket struct {
+	mu sync.Mutex
+
+	base   float64 // The configured requests per second.
+	limit  float64 // The current requests per second, lower than base when tightened.
+	burst  float64
+	tokens float64
+	last   time.Time
+
+	pausedUntil time.Time
+}
+
+func newBucket(rate Rate, now time.Time) *bucket {
+
+	burst := float64(max(rate.Burst, 1))
+
+	return &bucket{
+		base:   rate.Limit,
+		limit:  rate.Limit,
+		burst:  burst,
+		tokens: burst,
+		last:   now,
+	}
+}
+
+// refill adds the tokens accumulated since the last call, the caller must hold the lock.
+func (b *bucket) refill(now time.Time) {
+
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens = min(b.burst, b.tokens+elapsed*b.limit)
+		b.last = now
+	}
+}
+
+// reserve takes a token and returns how long the caller must wait before using it.
+func (b *bucket) reserve(now time.Time) time.Duration {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill(now)
+	b.tokens--
+
+	var wait time.Duration
+	if b.tokens < 0 {
+		wait = time.Duration(-b.tokens / b.limit * float64(time.Second))
+	}
+
+	if paused := b.pausedUntil.Sub(now); paused > wait {
+		wait = paused
+	}
+
+	return wait
+}
+
+// cancel gives back a token reserved by a caller that stopped waiting.
+func (b *bucket) cancel() {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens = min(b.burst, b.tokens+1)
+}
+
+// tighten halves the current limit, without going under the minimum factor of the base limit.
+func (b *bucket) tighten(now time.Time, minFactor float64) {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill(now)
+	b.limit = max(b.limit/2, b.base*minFactor)
+}
+
+// relax slowly restores the current limit to the base limit.
+func (b *bucket) relax(now time.Time) {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.limit < b.base {
+		b.refill(now)
+		b.limit = min(b.limit*relaxFactor, b.base)
+	}
+}
+
+// pause stops the bucket from handing out tokens until the given time.
+func (b *bucket) pause(until time.Time) {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if until.After(b.pausedUntil) {
+		b.pausedUntil = until
+	}
+}
@@ -0,0 +1,252 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/retry"
+	"github.com/ctreminiom/go-atlassian/v2/service/common"
+)
+
+// Endpoint classes returned by the DefaultClassifier.
+const (
+	ClassRead   = "read"
+	ClassWrite  = "write"
+	ClassSearch = "search"
+)
+
+// Limiter throttles the requests sent to the Atlassian APIs.
+//
+// A Limiter must be safe for concurrent use, so it can be shared by several clients.
+type Limiter interface {
+	// Wait blocks until the request is allowed to be sent, or the context is done.
+	Wait(ctx context.Context, request *http.Request) error
+
+	// Observe receives every response, so the limiter can adapt to the server feedback.
+	Observe(response *http.Response)
+}
+
+// Rate is the throughput allowed for a bucket of requests.
+type Rate struct {
+	Limit float64 // The number of requests per second, zero means unlimited.
+	Burst int     // The number of requests that can be sent at once.
+}
+
+// Site configures the rates of a site.
+type Site struct {
+	// Default is the rate of the site, used for the classes without a rate.
+	Default Rate
+
+	// Classes overrides the rates per endpoint class on the site.
+	Classes map[string]Rate
+}
+
+// Config configures a TokenBucket limiter.
+//
+// The rate of a site and endpoint class is the first one configured among the site class rate,
+// the class rate, the site default rate and the default rate.
+type Config struct {
+	// Default is the rate used when neither a site nor a class rate is configured.
+	Default Rate
+
+	// Sites configures the rates per site host, e.g. "ctreminiom.atlassian.net".
+	Sites map[string]Site
+
+	// Classes overrides the site default and the default rates per endpoint class, on every site.
+	// Every site gets its own bucket for every class.
+	Classes map[string]Rate
+
+	// Classifier returns the endpoint class of a request, DefaultClassifier is used when nil.
+	Classifier func(request *http.Request) string
+
+	// Adaptive halves the rate of a bucket when a 429 response or an X-RateLimit-NearLimit
+	// header is observed, and slowly restores it on the successful responses.
+	Adaptive bool
+
+	// MinFactor is the lowest fraction of the configured rate the adaptive mode can reach.
+	// It defaults to 0.1.
+	MinFactor float64
+}
+
+// DefaultClassifier classifies the search endpoints as ClassSearch,
+// the safe methods as ClassRead and everything else as ClassWrite.
+func DefaultClassifier(request *http.Request) string {
+
+	if request.URL != nil && strings.Contains(request.URL.Path, "/search") {
+		return ClassSearch
+	}
+
+	switch request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return ClassRead
+	default:
+		return ClassWrite
+	}
+}
+
+// TokenBucket is a Limiter using a token bucket per site and endpoint class.
+type TokenBucket struct {
+	config Config
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+
+	now func() time.Time
+}
+
+// New creates a TokenBucket limiter.
+func New(config Config) *TokenBucket {
+
+	if config.Classifier == nil {
+		config.Classifier = DefaultClassifier
+	}
+
+	if config.MinFactor <= 0 || config.MinFactor > 1 {
+		config.MinFactor = 0.1
+	}
+
+	return &TokenBucket{
+		config:  config,
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+// Wait blocks until a token is available on the request bucket, or the context is done.
+func (t *TokenBucket) Wait(ctx context.Context, request *http.Request) error {
+
+	b := t.bucket(request)
+	if b == nil {
+		return nil
+	}
+
+	wait := b.reserve(t.now())
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		b.cancel()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Observe adapts the request bucket to the rate limit signals of the response.
+func (t *TokenBucket) Observe(response *http.Response) {
+
+	if !t.config.Adaptive || response == nil || response.Request == nil {
+		return
+	}
+
+	b := t.bucket(response.Request)
+	if b == nil {
+		return
+	}
+
+	now := t.now()
+
+	switch {
+	case response.StatusCode == http.StatusTooManyRequests:
+		b.tighten(now, t.config.MinFactor)
+
+		if wait, ok := retry.After(response); ok {
+			b.pause(now.Add(wait))
+		}
+
+	case strings.EqualFold(response.Header.Get("X-RateLimit-NearLimit"), "true"):
+		b.tighten(now, t.config.MinFactor)
+
+	case response.StatusCode < 400:
+		b.relax(now)
+	}
+}
+
+// rate returns the rate configured for the site and class.
+func (t *TokenBucket) rate(host, class string) Rate {
+
+	site, found := t.config.Sites[host]
+
+	if rate, ok := site.Classes[class]; ok {
+		return rate
+	}
+
+	if rate, ok := t.config.Classes[class]; ok {
+		return rate
+	}
+
+	if found {
+		return site.Default
+	}
+
+	return t.config.Default
+}
+
+// bucket returns the bucket of the request, nil when the request isn't limited.
+func (t *TokenBucket) bucket(request *http.Request) *bucket {
+
+	if request == nil || request.URL == nil {
+		return nil
+	}
+
+	host, class := request.URL.Host, t.config.Classifier(request)
+	key := host + "|" + class
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if b, ok := t.buckets[key]; ok {
+		return b
+	}
+
+	rate := t.rate(host, class)
+	if rate.Limit <= 0 {
+		t.buckets[key] = nil
+		return nil
+	}
+
+	b := newBucket(rate, t.now())
+	t.buckets[key] = b
+
+	return b
+}
+
+// Client returns an HTTP client sending the requests through the limiter.
+// It returns the client unchanged when the limiter is nil.
+func Client(client common.HTTPClient, limiter Limiter) common.HTTPClient {
+
+	if limiter == nil {
+		return client
+	}
+
+	return &limitedClient{client: client, limiter: limiter}
+}
+
+type limitedClient struct {
+	client  common.HTTPClient
+	limiter Limiter
+}
+
+func (l *limitedClient) Do(request *http.Request) (*http.Response, error) {
+
+	if request != nil {
+		if err := l.limiter.Wait(request.Context(), request); err != nil {
+			return nil, err
+		}
+	}
+
+	response, err := l.client.Do(request)
+	if err == nil {
+		l.limiter.Observe(response)
+	}
+
+	return response, err
+}
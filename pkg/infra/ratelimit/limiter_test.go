@@ -0,0 +1,220 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newRequest(t *testing.T, method, rawURL string) *http.Request {
+	t.Helper()
+
+	request, err := http.NewRequest(method, rawURL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return request
+}
+
+// fakeClock returns a clock that only moves when advanced.
+func fakeClock(limiter *TokenBucket) func(time.Duration) {
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter.now = func() time.Time { return now }
+
+	return func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestDefaultClassifier(t *testing.T) {
+
+	testCases := []struct {
+		name   string
+		method string
+		url    string
+		want   string
+	}{
+		{"when the request is a read", http.MethodGet, "https://ctreminiom.atlassian.net/rest/api/3/issue/KP-1", ClassRead},
+		{"when the request is a write", http.MethodPut, "https://ctreminiom.atlassian.net/rest/api/3/issue/KP-1", ClassWrite},
+		{"when the request is a search", http.MethodPost, "https://ctreminiom.atlassian.net/rest/api/3/search/jql", ClassSearch},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, DefaultClassifier(newRequest(t, testCase.method, testCase.url)))
+		})
+	}
+}
+
+func TestTokenBucket_reserve(t *testing.T) {
+
+	limiter := New(Config{Default: Rate{Limit: 2, Burst: 2}})
+	advance := fakeClock(limiter)
+
+	request := newRequest(t, http.MethodGet, "https://ctreminiom.atlassian.net/rest/api/3/myself")
+	b := limiter.bucket(request)
+
+	// The burst is available right away.
+	assert.Equal(t, time.Duration(0), b.reserve(limiter.now()))
+	assert.Equal(t, time.Duration(0), b.reserve(limiter.now()))
+
+	// The next tokens are refilled at two per second.
+	assert.Equal(t, 500*time.Millisecond, b.reserve(limiter.now()))
+	assert.Equal(t, time.Second, b.reserve(limiter.now()))
+
+	advance(2 * time.Second)
+	assert.Equal(t, time.Duration(0), b.reserve(limiter.now()))
+}
+
+func TestTokenBucket_rates(t *testing.T) {
+
+	limiter := New(Config{
+		Default: Rate{Limit: 10, Burst: 1},
+		Sites:   map[string]Site{"slow.atlassian.net": {Default: Rate{Limit: 1, Burst: 1}}},
+		Classes: map[string]Rate{ClassSearch: {Limit: 0.5, Burst: 1}},
+	})
+
+	read := limiter.bucket(newRequest(t, http.MethodGet, "https://fast.atlassian.net/rest/api/3/myself"))
+	slow := limiter.bucket(newRequest(t, http.MethodGet, "https://slow.atlassian.net/rest/api/3/myself"))
+	search := limiter.bucket(newRequest(t, http.MethodPost, "https://slow.atlassian.net/rest/api/3/search/jql"))
+	otherSearch := limiter.bucket(newRequest(t, http.MethodPost, "https://fast.atlassian.net/rest/api/3/search/jql"))
+
+	assert.Equal(t, 10.0, read.base)
+	assert.Equal(t, 1.0, slow.base)
+	assert.Equal(t, 0.5, search.base)
+	assert.NotSame(t, search, otherSearch)
+
+	unlimited := New(Config{})
+	assert.Nil(t, unlimited.bucket(newRequest(t, http.MethodGet, "https://fast.atlassian.net/rest/api/3/myself")))
+	assert.NoError(t, unlimited.Wait(context.Background(), newRequest(t, http.MethodGet, "https://fast.atlassian.net")))
+}
+
+func TestTokenBucket_siteClassRates(t *testing.T) {
+
+	limiter := New(Config{
+		Default: Rate{Limit: 10, Burst: 1},
+		Sites: map[string]Site{
+			"slow.atlassian.net": {
+				Default: Rate{Limit: 1, Burst: 1},
+				Classes: map[string]Rate{ClassSearch: {Limit: 0.1, Burst: 1}},
+			},
+			"fast.atlassian.net": {
+				Default: Rate{Limit: 20, Burst: 1},
+				Classes: map[string]Rate{ClassSearch: {Limit: 5, Burst: 1}},
+			},
+			"other.atlassian.net": {
+				Default: Rate{Limit: 2, Burst: 1},
+			},
+		},
+		Classes: map[string]Rate{ClassSearch: {Limit: 0.5, Burst: 1}, ClassWrite: {Limit: 3, Burst: 1}},
+	})
+
+	rate := func(method, rawURL string) float64 {
+		return limiter.bucket(newRequest(t, method, rawURL)).base
+	}
+
+	assert.Equal(t, 0.1, rate(http.MethodPost, "https://slow.atlassian.net/rest/api/3/search/jql"))
+	assert.Equal(t, 5.0, rate(http.MethodPost, "https://fast.atlassian.net/rest/api/3/search/jql"))
+	assert.Equal(t, 0.5, rate(http.MethodPost, "https://other.atlassian.net/rest/api/3/search/jql"))
+	assert.Equal(t, 0.5, rate(http.MethodPost, "https://unknown.atlassian.net/rest/api/3/search/jql"))
+	assert.Equal(t, 3.0, rate(http.MethodPost, "https://slow.atlassian.net/rest/api/3/issue"))
+	assert.Equal(t, 1.0, rate(http.MethodGet, "https://slow.atlassian.net/rest/api/3/myself"))
+	assert.Equal(t, 20.0, rate(http.MethodGet, "https://fast.atlassian.net/rest/api/3/myself"))
+	assert.Equal(t, 10.0, rate(http.MethodGet, "https://unknown.atlassian.net/rest/api/3/myself"))
+}
+
+func TestTokenBucket_Observe(t *testing.T) {
+
+	limiter := New(Config{Default: Rate{Limit: 8, Burst: 1}, Adaptive: true, MinFactor: 0.25})
+	advance := fakeClock(limiter)
+
+	request := newRequest(t, http.MethodGet, "https://ctreminiom.atlassian.net/rest/api/3/myself")
+	b := limiter.bucket(request)
+
+	limiter.Observe(&http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"X-Ratelimit-Nearlimit": []string{"true"}},
+		Request:    request,
+	})
+	assert.Equal(t, 4.0, b.limit)
+
+	limiter.Observe(&http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": []string{"10"}},
+		Request:    request,
+	})
+	assert.Equal(t, 2.0, b.limit)
+
+	// The bucket is paused until the Retry-After delay is over.
+	assert.Equal(t, 10*time.Second, b.reserve(limiter.now()))
+
+	// The limit never goes under the minimum factor.
+	limiter.Observe(&http.Response{StatusCode: http.StatusTooManyRequests, Request: request})
+	assert.Equal(t, 2.0, b.limit)
+
+	// The successful responses restore the limit.
+	advance(time.Minute)
+	for i := 0; i < 100; i++ {
+		limiter.Observe(&http.Response{StatusCode: http.StatusOK, Request: request})
+	}
+	assert.Equal(t, 8.0, b.limit)
+}
+
+func TestTokenBucket_Observe_NotAdaptive(t *testing.T) {
+
+	limiter := New(Config{Default: Rate{Limit: 8, Burst: 1}})
+
+	request := newRequest(t, http.MethodGet, "https://ctreminiom.atlassian.net/rest/api/3/myself")
+	limiter.Observe(&http.Response{StatusCode: http.StatusTooManyRequests, Request: request})
+
+	assert.Equal(t, 8.0, limiter.bucket(request).limit)
+}
+
+func TestTokenBucket_Wait_ContextCancelled(t *testing.T) {
+
+	limiter := New(Config{Default: Rate{Limit: 0.001, Burst: 1}})
+	request := newRequest(t, http.MethodGet, "https://ctreminiom.atlassian.net/rest/api/3/myself")
+
+	assert.NoError(t, limiter.Wait(context.Background(), request))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := limiter.Wait(ctx, request)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+
+	// The cancelled reservation gives its token back.
+	assert.InDelta(t, 0, limiter.bucket(request).tokens, 0.01)
+}
+
+func TestClient(t *testing.T) {
+
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("X-RateLimit-NearLimit", "true")
+	}))
+	defer server.Close()
+
+	assert.Same(t, http.DefaultClient, Client(http.DefaultClient, nil))
+
+	// The limiter is shared by two clients.
+	limiter := New(Config{Default: Rate{Limit: 100, Burst: 10}, Adaptive: true})
+	first, second := Client(http.DefaultClient, limiter), Client(http.DefaultClient, limiter)
+
+	for _, client := range []interface {
+		Do(*http.Request) (*http.Response, error)
+	}{first, second} {
+		response, err := client.Do(newRequest(t, http.MethodGet, server.URL))
+		assert.NoError(t, err)
+		_ = response.Body.Close()
+	}
+
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, 25.0, limiter.bucket(newRequest(t, http.MethodGet, server.URL)).limit)
+}
@@ -0,0 +1,252 @@
+package retry
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/common"
+)
+
+// Policy describes how failed requests are retried.
+//
+// A nil *Policy is valid and disables retries, every request is sent exactly once.
+type Policy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// A value lower than 2 disables retries.
+	MaxAttempts int
+
+	// InitialBackoff is the wait time before the first retry.
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the exponential backoff and any server-provided wait time.
+	MaxBackoff time.Duration
+
+	// Multiplier is the factor applied to the backoff after every attempt.
+	Multiplier float64
+
+	// Jitter is the fraction (0-1) of the backoff that is randomized to avoid retry storms.
+	Jitter float64
+
+	// StatusCodes are the HTTP status codes considered transient.
+	StatusCodes []int
+
+	// Methods are the HTTP methods that are safe to replay after a transient failure.
+	Methods []string
+
+	// RetryRateLimited allows requests using a method outside Methods to be retried when the
+	// server answered 429 Too Many Requests, the request was rejected before being processed.
+	RetryRateLimited bool
+}
+
+// DefaultPolicy returns a Policy suited for the Atlassian Cloud rate limits.
+func DefaultPolicy() *Policy {
+	return &Policy{
+		MaxAttempts:      4,
+		InitialBackoff:   500 * time.Millisecond,
+		MaxBackoff:       30 * time.Second,
+		Multiplier:       2,
+		Jitter:           0.3,
+		StatusCodes:      []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout},
+		Methods:          []string{http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete},
+		RetryRateLimited: true,
+	}
+}
+
+// Validate checks the policy values are consistent.
+func (p *Policy) Validate() error {
+
+	if p == nil {
+		return fmt.Errorf("retry: %w", models.ErrNoRetryPolicy)
+	}
+
+	if p.MaxAttempts < 1 {
+		return fmt.Errorf("retry: %w, max attempts must be at least 1", models.ErrInvalidRetryPolicy)
+	}
+
+	if p.InitialBackoff < 0 || p.MaxBackoff < 0 || p.Multiplier < 0 {
+		return fmt.Errorf("retry: %w, backoff values cannot be negative", models.ErrInvalidRetryPolicy)
+	}
+
+	if p.Jitter < 0 || p.Jitter > 1 {
+		return fmt.Errorf("retry: %w, jitter must be between 0 and 1", models.ErrInvalidRetryPolicy)
+	}
+
+	return nil
+}
+
+// sleep waits for the given duration or until the context is done.
+// It's a variable, so the tests don't have to wait for real.
+var sleep = func(ctx context.Context, wait time.Duration) error {
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Do sends the request using the client, retrying it according to the policy.
+// It returns the last response received and the number of retries performed.
+//
+// The request body is buffered, so it can be replayed on every attempt.
+func (p *Policy) Do(client common.HTTPClient, request *http.Request) (*http.Response, int, error) {
+
+	if p == nil || p.MaxAttempts < 2 || request == nil {
+		response, err := client.Do(request)
+		return response, 0, err
+	}
+
+	if err := rewindable(request); err != nil {
+		return nil, 0, err
+	}
+
+	ctx := request.Context()
+
+	for attempt := 1; ; attempt++ {
+
+		if attempt > 1 {
+
+			request = request.Clone(ctx)
+
+			if request.GetBody != nil {
+				body, err := request.GetBody()
+				if err != nil {
+					return nil, attempt - 1, err
+				}
+
+				request.Body = body
+			}
+		}
+
+		response, err := client.Do(request)
+
+		if attempt >= p.MaxAttempts || !p.retryable(request, response, err) {
+			return response, attempt - 1, err
+		}
+
+		wait := p.backoff(attempt)
+		if response != nil {
+
+			if serverWait, ok := After(response); ok {
+				wait = serverWait
+				if p.MaxBackoff > 0 {
+					wait = min(wait, p.MaxBackoff)
+				}
+			}
+
+			// Drain the body, so the connection can be reused by the next attempt.
+			_, _ = io.Copy(io.Discard, response.Body)
+			_ = response.Body.Close()
+		}
+
+		if err := sleep(ctx, wait); err != nil {
+			return nil, attempt - 1, err
+		}
+	}
+}
+
+// retryable reports whether the outcome of an attempt should be retried.
+func (p *Policy) retryable(request *http.Request, response *http.Response, err error) bool {
+
+	if request.Context().Err() != nil {
+		return false
+	}
+
+	idempotent := slices.Contains(p.Methods, request.Method)
+
+	if err != nil {
+		return idempotent
+	}
+
+	if !slices.Contains(p.StatusCodes, response.StatusCode) {
+		return false
+	}
+
+	return idempotent || (p.RetryRateLimited && response.StatusCode == http.StatusTooManyRequests)
+}
+
+// backoff returns the jittered exponential wait time before the next attempt.
+func (p *Policy) backoff(attempt int) time.Duration {
+
+	wait := float64(p.InitialBackoff) * math.Pow(p.Multiplier, float64(attempt-1))
+
+	if p.MaxBackoff > 0 && wait > float64(p.MaxBackoff) {
+		wait = float64(p.MaxBackoff)
+	}
+
+	if p.Jitter > 0 {
+		wait -= wait * p.Jitter * rand.Float64()
+	}
+
+	return time.Duration(wait)
+}
+
+// rewindable makes sure the request body can be read again on every attempt.
+func rewindable(request *http.Request) error {
+
+	if request.Body == nil || request.Body == http.NoBody || request.GetBody != nil {
+		return nil
+	}
+
+	payload, err := io.ReadAll(request.Body)
+	if err != nil {
+		return err
+	}
+
+	_ = request.Body.Close()
+
+	request.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(payload)), nil
+	}
+
+	request.Body, _ = request.GetBody()
+
+	return nil
+}
+
+// After returns how long the server asked the client to wait before sending a new request.
+// It reads the Retry-After header, in seconds or as an HTTP date, and falls back
+// to the X-RateLimit-Reset header, as an ISO 8601 timestamp or epoch seconds.
+func After(response *http.Response) (time.Duration, bool) {
+
+	if response == nil {
+		return 0, false
+	}
+
+	if value := response.Header.Get("Retry-After"); value != "" {
+
+		if seconds, err := strconv.Atoi(value); err == nil {
+			return max(time.Duration(seconds)*time.Second, 0), true
+		}
+
+		if date, err := http.ParseTime(value); err == nil {
+			return max(time.Until(date), 0), true
+		}
+	}
+
+	if value := response.Header.Get("X-RateLimit-Reset"); value != "" {
+
+		if epoch, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return max(time.Until(time.Unix(epoch, 0)), 0), true
+		}
+
+		if date, err := time.Parse(time.RFC3339, value); err == nil {
+			return max(time.Until(date), 0), true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,286 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// sequenceServer answers the requests with the given status codes, in order.
+// The last status code is reused once the sequence is exhausted.
+func sequenceServer(t *testing.T, codes []int, headers http.Header, bodies *[]string) (*httptest.Server, *int) {
+
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		if bodies != nil {
+			payload, _ := io.ReadAll(r.Body)
+			*bodies = append(*bodies, string(payload))
+		}
+
+		code := codes[min(calls, len(codes)-1)]
+		calls++
+
+		for key, values := range headers {
+			w.Header()[key] = values
+		}
+
+		w.WriteHeader(code)
+	}))
+
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func noSleep(t *testing.T) *[]time.Duration {
+
+	var waits []time.Duration
+
+	original := sleep
+	sleep = func(ctx context.Context, wait time.Duration) error {
+		waits = append(waits, wait)
+		return ctx.Err()
+	}
+
+	t.Cleanup(func() { sleep = original })
+	return &waits
+}
+
+func TestPolicy_Do(t *testing.T) {
+
+	testCases := []struct {
+		name        string
+		policy      *Policy
+		method      string
+		codes       []int
+		headers     http.Header
+		wantCode    int
+		wantRetries int
+		wantCalls   int
+	}{
+		{
+			name:        "when the policy is nil",
+			policy:      nil,
+			method:      http.MethodGet,
+			codes:       []int{http.StatusServiceUnavailable},
+			wantCode:    http.StatusServiceUnavailable,
+			wantRetries: 0,
+			wantCalls:   1,
+		},
+		{
+			name:        "when the request eventually succeeds",
+			policy:      DefaultPolicy(),
+			method:      http.MethodGet,
+			codes:       []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK},
+			wantCode:    http.StatusOK,
+			wantRetries: 2,
+			wantCalls:   3,
+		},
+		{
+			name:        "when the attempts are exhausted",
+			policy:      DefaultPolicy(),
+			method:      http.MethodGet,
+			codes:       []int{http.StatusServiceUnavailable},
+			wantCode:    http.StatusServiceUnavailable,
+			wantRetries: 3,
+			wantCalls:   4,
+		},
+		{
+			name:        "when the status code is not transient",
+			policy:      DefaultPolicy(),
+			method:      http.MethodGet,
+			codes:       []int{http.StatusBadRequest},
+			wantCode:    http.StatusBadRequest,
+			wantRetries: 0,
+			wantCalls:   1,
+		},
+		{
+			name:        "when a non idempotent request is unavailable",
+			policy:      DefaultPolicy(),
+			method:      http.MethodPost,
+			codes:       []int{http.StatusServiceUnavailable, http.StatusOK},
+			wantCode:    http.StatusServiceUnavailable,
+			wantRetries: 0,
+			wantCalls:   1,
+		},
+		{
+			name:        "when a non idempotent request is rate limited",
+			policy:      DefaultPolicy(),
+			method:      http.MethodPost,
+			codes:       []int{http.StatusTooManyRequests, http.StatusCreated},
+			wantCode:    http.StatusCreated,
+			wantRetries: 1,
+			wantCalls:   2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			noSleep(t)
+			server, calls := sequenceServer(t, testCase.codes, testCase.headers, nil)
+
+			request, err := http.NewRequest(testCase.method, server.URL, nil)
+			assert.NoError(t, err)
+
+			response, retries, err := testCase.policy.Do(http.DefaultClient, request)
+			assert.NoError(t, err)
+
+			assert.Equal(t, testCase.wantCode, response.StatusCode)
+			assert.Equal(t, testCase.wantRetries, retries)
+			assert.Equal(t, testCase.wantCalls, *calls)
+		})
+	}
+}
+
+func TestPolicy_Do_ReplaysBody(t *testing.T) {
+
+	noSleep(t)
+
+	var bodies []string
+	server, _ := sequenceServer(t, []int{http.StatusServiceUnavailable, http.StatusOK}, nil, &bodies)
+
+	// io.NopCloser hides the concrete type, so the request is created without GetBody.
+	request, err := http.NewRequest(http.MethodPut, server.URL, io.NopCloser(strings.NewReader(`{"name":"go-atlassian"}`)))
+	assert.NoError(t, err)
+	assert.Nil(t, request.GetBody)
+
+	response, retries, err := DefaultPolicy().Do(http.DefaultClient, request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, 1, retries)
+	assert.Equal(t, []string{`{"name":"go-atlassian"}`, `{"name":"go-atlassian"}`}, bodies)
+}
+
+func TestPolicy_Do_RetryAfter(t *testing.T) {
+
+	waits := noSleep(t)
+
+	headers := http.Header{"Retry-After": []string{"7"}}
+	server, _ := sequenceServer(t, []int{http.StatusTooManyRequests, http.StatusOK}, headers, nil)
+
+	request, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	assert.NoError(t, err)
+
+	_, _, err = DefaultPolicy().Do(http.DefaultClient, request)
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Duration{7 * time.Second}, *waits)
+}
+
+func TestPolicy_Do_ContextCancelled(t *testing.T) {
+
+	server, calls := sequenceServer(t, []int{http.StatusServiceUnavailable}, nil, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	original := sleep
+	sleep = func(ctx context.Context, wait time.Duration) error {
+		cancel()
+		return ctx.Err()
+	}
+	t.Cleanup(func() { sleep = original })
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	assert.NoError(t, err)
+
+	response, retries, err := DefaultPolicy().Do(http.DefaultClient, request)
+	assert.Nil(t, response)
+	assert.Equal(t, 0, retries)
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Equal(t, 1, *calls)
+}
+
+func TestPolicy_backoff(t *testing.T) {
+
+	policy := &Policy{
+		MaxAttempts:    5,
+		InitialBackoff: time.Second,
+		MaxBackoff:     5 * time.Second,
+		Multiplier:     2,
+	}
+
+	assert.Equal(t, time.Second, policy.backoff(1))
+	assert.Equal(t, 2*time.Second, policy.backoff(2))
+	assert.Equal(t, 4*time.Second, policy.backoff(3))
+	assert.Equal(t, 5*time.Second, policy.backoff(4))
+
+	policy.Jitter = 0.5
+	for attempt := 1; attempt < 5; attempt++ {
+		wait := policy.backoff(attempt)
+		assert.GreaterOrEqual(t, wait, time.Duration(float64(min(time.Second<<(attempt-1), 5*time.Second))*0.5))
+		assert.LessOrEqual(t, wait, min(time.Second<<(attempt-1), 5*time.Second))
+	}
+}
+
+func TestPolicy_Validate(t *testing.T) {
+
+	var nilPolicy *Policy
+	assert.True(t, errors.Is(nilPolicy.Validate(), models.ErrNoRetryPolicy))
+
+	assert.NoError(t, DefaultPolicy().Validate())
+
+	assert.True(t, errors.Is((&Policy{MaxAttempts: 0}).Validate(), models.ErrInvalidRetryPolicy))
+	assert.True(t, errors.Is((&Policy{MaxAttempts: 2, InitialBackoff: -1}).Validate(), models.ErrInvalidRetryPolicy))
+	assert.True(t, errors.Is((&Policy{MaxAttempts: 2, Jitter: 2}).Validate(), models.ErrInvalidRetryPolicy))
+}
+
+func TestAfter(t *testing.T) {
+
+	future := time.Now().Add(time.Minute)
+
+	testCases := []struct {
+		name    string
+		headers http.Header
+		want    time.Duration
+		wantOK  bool
+	}{
+		{
+			name:    "when the retry-after header is in seconds",
+			headers: http.Header{"Retry-After": []string{"30"}},
+			want:    30 * time.Second,
+			wantOK:  true,
+		},
+		{
+			name:    "when the retry-after header is an http date",
+			headers: http.Header{"Retry-After": []string{future.UTC().Format(http.TimeFormat)}},
+			want:    time.Minute,
+			wantOK:  true,
+		},
+		{
+			name:    "when the rate limit reset header is an iso 8601 timestamp",
+			headers: http.Header{"X-Ratelimit-Reset": []string{future.UTC().Format(time.RFC3339)}},
+			want:    time.Minute,
+			wantOK:  true,
+		},
+		{
+			name:    "when the rate limit reset header is in epoch seconds",
+			headers: http.Header{"X-Ratelimit-Reset": []string{"1"}},
+			want:    0,
+			wantOK:  true,
+		},
+		{
+			name:    "when no header is provided",
+			headers: http.Header{},
+			wantOK:  false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got, ok := After(&http.Response{Header: testCase.headers})
+
+			assert.Equal(t, testCase.wantOK, ok)
+			assert.InDelta(t, testCase.want, got, float64(2*time.Second))
+		})
+	}
+}
@@ -0,0 +1,175 @@
+// Package route turns the request paths into low cardinality route templates,
+// e.g. /rest/api/3/issue/KP-1/comment/10000 becomes /rest/api/3/issue/{issueIdOrKey}/comment/{commentId}.
+//
+// The templates are used to name the spans, the metrics and the log records,
+// so a path segment is replaced when it follows a known resource collection, unless it's a known
+// operation such as search, or when it looks like an identifier: a number, a UUID, an issue key or an account ID.
+package route
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// parameters maps the resource collections to the name of the parameter following them.
+// A collection mapped to several names is followed by several parameters.
+var parameters = map[string][]string{
+	// Jira, Jira Agile and Jira Service Management
+	"issue":          {"issueIdOrKey"},
+	"project":        {"projectIdOrKey"},
+	"epic":           {"epicIdOrKey"},
+	"board":          {"boardId"},
+	"sprint":         {"sprintId"},
+	"field":          {"fieldId"},
+	"properties":     {"propertyKey"},
+	"servicedesk":    {"serviceDeskId"},
+	"request":        {"issueIdOrKey"},
+	"customfield":    {"fieldId"},
+	"workflowscheme": {"workflowSchemeId"},
+
+	// Confluence
+	"space":  {"spaceKey"},
+	"spaces": {"spaceId"},
+	"pages":  {"pageId"},
+
+	// Assets
+	"workspace": {"workspaceId"},
+
+	// Bitbucket
+	"workspaces":   {"workspace"},
+	"repositories": {"workspace", "repo_slug"},
+	"projects":     {"project_key"},
+	"branches":     {"name"},
+	"tags":         {"name"},
+	"src":          {"commit", "path"},
+	"users":        {"userId"},
+
+	// Admin and SCIM
+	"orgs":      {"orgId"},
+	"directory": {"directoryId"},
+	"Users":     {"userId"},
+	"Groups":    {"groupId"},
+}
+
+// literals are the operations and the sub-collections following a resource collection in place of an identifier,
+// e.g. /rest/api/3/project/search or /rest/api/3/issue/createmeta, which must not be replaced.
+var literals = map[string]bool{
+	"archive":    true,
+	"available":  true,
+	"bulk":       true,
+	"bulkfetch":  true,
+	"createmeta": true,
+	"editmeta":   true,
+	"none":       true,
+	"picker":     true,
+	"project":    true,
+	"properties": true,
+	"recent":     true,
+	"search":     true,
+	"type":       true,
+	"unarchive":  true,
+}
+
+// wildcards are the parameters spanning the remaining segments of the path,
+// e.g. the path of a file in /2.0/repositories/{workspace}/{repo_slug}/src/{commit}/{path}.
+var wildcards = map[string]bool{
+	"path": true,
+}
+
+// versionParents are the segments followed by an API version, which must not be replaced.
+var versionParents = map[string]bool{
+	"api":   true,
+	"agile": true,
+}
+
+var (
+	numberPattern    = regexp.MustCompile(`^\d+$`)
+	uuidPattern      = regexp.MustCompile(`^\{?[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}\}?$`)
+	issueKeyPattern  = regexp.MustCompile(`^[A-Z][A-Z0-9_]+-\d+$`)
+	accountIDPattern = regexp.MustCompile(`^([0-9a-f]{24}|\d+:[0-9a-fA-F-]{36})$`)
+	hashPattern      = regexp.MustCompile(`^[0-9a-fA-F]{12,}$`)
+	customFieldID    = regexp.MustCompile(`^customfield_\d+$`)
+)
+
+// Template returns the route template of a request path, or of an absolute URL.
+// The query string is removed.
+func Template(path string) string {
+
+	if u, err := url.Parse(path); err == nil {
+		path = u.EscapedPath()
+	}
+
+	leading := strings.HasPrefix(path, "/")
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	var pending []string
+	for index, segment := range segments {
+
+		previous := ""
+		if index > 0 {
+			previous = segments[index-1]
+		}
+
+		if len(pending) > 0 {
+
+			if !literals[segment] {
+
+				if wildcards[pending[0]] {
+					segments = append(segments[:index], "{"+pending[0]+"}")
+					break
+				}
+
+				segments[index] = "{" + pending[0] + "}"
+				pending = pending[1:]
+				continue
+			}
+
+			// The literal is an operation of the collection, the parameters expected don't follow.
+			pending = nil
+		}
+
+		if names, ok := parameters[segment]; ok {
+			pending = append([]string{}, names...)
+			continue
+		}
+
+		if identifier(segment) && !versionParents[previous] {
+			segments[index] = "{" + name(previous) + "}"
+		}
+	}
+
+	template := strings.Join(segments, "/")
+	if leading {
+		template = "/" + template
+	}
+
+	return template
+}
+
+// identifier reports whether the segment looks like a resource identifier.
+func identifier(segment string) bool {
+	return numberPattern.MatchString(segment) ||
+		uuidPattern.MatchString(segment) ||
+		issueKeyPattern.MatchString(segment) ||
+		accountIDPattern.MatchString(segment) ||
+		hashPattern.MatchString(segment) ||
+		customFieldID.MatchString(segment)
+}
+
+// name returns the parameter name of an identifier following the given collection,
+// e.g. "commentId" after "comment" or "versionId" after "versions".
+func name(collection string) string {
+
+	collection = strings.NewReplacer("-", "", "_", "").Replace(strings.ToLower(collection))
+
+	if !strings.HasSuffix(collection, "ss") && !strings.HasSuffix(collection, "us") {
+		collection = strings.TrimSuffix(collection, "s")
+	}
+
+	if collection == "" {
+		return "id"
+	}
+
+	return collection + "Id"
+}
@@ -0,0 +1,54 @@
+package route
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplate(t *testing.T) {
+
+	testCases := []struct {
+		name string
+		path string
+		want string
+	}{
+		{"when the path is an issue comment", "/rest/api/3/issue/KP-1/comment/10000", "/rest/api/3/issue/{issueIdOrKey}/comment/{commentId}"},
+		{"when the path has a query", "rest/api/2/project/KP/versions?startAt=0", "rest/api/2/project/{projectIdOrKey}/versions"},
+		{"when the path is an absolute url", "https://ctreminiom.atlassian.net/rest/agile/1.0/board/12/sprint", "/rest/agile/1.0/board/{boardId}/sprint"},
+		{"when the path is an issue property", "/rest/api/3/issue/10001/properties/support", "/rest/api/3/issue/{issueIdOrKey}/properties/{propertyKey}"},
+		{"when the path is a custom field context", "/rest/api/3/field/customfield_10002/context/10100/option", "/rest/api/3/field/{fieldId}/context/{contextId}/option"},
+		{"when the path is a confluence page", "/wiki/api/v2/pages/65538/children", "/wiki/api/v2/pages/{pageId}/children"},
+		{"when the path is a confluence space", "/wiki/rest/api/space/DUMMY/content", "/wiki/rest/api/space/{spaceKey}/content"},
+		{"when the path is a bitbucket repository", "/2.0/repositories/atlassian/go-atlassian/pullrequests/1", "/2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pullrequestId}"},
+		{"when the path is an assets object", "/jsm/assets/workspace/g2778e1d-939d-581d-c8e2-9d5g59de456b/v1/object/1", "/jsm/assets/workspace/{workspaceId}/v1/object/{objectId}"},
+		{"when the path is an admin user", "/users/5b10ac8d82e05b22cc7d4ef5/manage/lifecycle/enable", "/users/{userId}/manage/lifecycle/enable"},
+		{"when the path is a scim user", "/scim/directory/d6a7e1a9-2bd8-4ec4-8b0c-3a5e4a36d1ec/Users/5b10ac8d82e05b22cc7d4ef5", "/scim/directory/{directoryId}/Users/{userId}"},
+		{"when the path is a service desk queue", "rest/servicedeskapi/servicedesk/1/queue/2", "rest/servicedeskapi/servicedesk/{serviceDeskId}/queue/{queueId}"},
+		{"when the path has no identifiers", "/rest/api/3/myself", "/rest/api/3/myself"},
+		{"when the path is a status", "/rest/api/3/status/10000", "/rest/api/3/status/{statusId}"},
+		{"when the path is a project search", "/rest/api/3/project/search", "/rest/api/3/project/search"},
+		{"when the path is the issue create metadata", "/rest/api/3/issue/createmeta", "/rest/api/3/issue/createmeta"},
+		{"when the path is the create metadata of a project", "/rest/api/3/issue/createmeta/KP/issuetypes/10001", "/rest/api/3/issue/createmeta/KP/issuetypes/{issuetypeId}"},
+		{"when the path is a field search", "/rest/api/3/field/search", "/rest/api/3/field/search"},
+		{"when the path is a bulk issue creation", "/rest/api/3/issue/bulk", "/rest/api/3/issue/bulk"},
+		{"when the path is the issue picker", "/rest/api/3/issue/picker", "/rest/api/3/issue/picker"},
+		{"when the path is a bulk issue property", "/rest/api/3/issue/properties/support", "/rest/api/3/issue/properties/{propertyKey}"},
+		{"when the path is the recent projects", "/rest/api/3/project/recent", "/rest/api/3/project/recent"},
+		{"when the path is the issues without epic", "/rest/agile/1.0/epic/none/issue", "/rest/agile/1.0/epic/none/issue"},
+		{"when the path is a bitbucket branch", "/2.0/repositories/atlassian/go-atlassian/refs/branches/feature%2Flogin", "/2.0/repositories/{workspace}/{repo_slug}/refs/branches/{name}"},
+		{"when the path is a bitbucket tag", "/2.0/repositories/atlassian/go-atlassian/refs/tags/v2.4.0", "/2.0/repositories/{workspace}/{repo_slug}/refs/tags/{name}"},
+		{"when the path is a bitbucket source file", "/2.0/repositories/atlassian/go-atlassian/src/main/docs/getting%20started/README.md", "/2.0/repositories/{workspace}/{repo_slug}/src/{commit}/{path}"},
+		{"when the path is the bitbucket source root", "/2.0/repositories/atlassian/go-atlassian/src/main/", "/2.0/repositories/{workspace}/{repo_slug}/src/{commit}"},
+		{"when the path is a bitbucket project", "/2.0/workspaces/atlassian/projects/PRJ/default-reviewers", "/2.0/workspaces/{workspace}/projects/{project_key}/default-reviewers"},
+		{"when the path is the workflow scheme of projects", "/rest/api/3/workflowscheme/project?projectId=10000", "/rest/api/3/workflowscheme/project"},
+		{"when the path is a workflow scheme", "/rest/api/3/workflowscheme/10000/default", "/rest/api/3/workflowscheme/{workflowSchemeId}/default"},
+		{"when the path is the projects available for a priority scheme", "/rest/api/3/priorityscheme/projects/available", "/rest/api/3/priorityscheme/projects/available"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, Template(testCase.path))
+		})
+	}
+}
@@ -0,0 +1,191 @@
+// Package telemetry instruments the requests sent by the Atlassian clients with
+// OpenTelemetry spans and metrics.
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/route"
+)
+
+// ScopeName is the instrumentation scope of the tracers and meters.
+const ScopeName = "github.com/ctreminiom/go-atlassian/v2"
+
+// Attribute keys recorded on the spans and metrics.
+const (
+	ProductKey    = attribute.Key("atlassian.product")
+	MethodKey     = attribute.Key("http.request.method")
+	RouteKey      = attribute.Key("http.route")
+	StatusCodeKey = attribute.Key("http.response.status_code")
+	RetriesKey    = attribute.Key("http.request.resend_count")
+	ServerKey     = attribute.Key("server.address")
+	ErrorTypeKey  = attribute.Key("error.type")
+)
+
+// Metric names.
+const (
+	DurationMetric = "atlassian.client.request.duration"
+	ErrorsMetric   = "atlassian.client.request.errors"
+)
+
+// Instrumentation creates a span and records the metrics of every request of a product client.
+// A nil *Instrumentation is valid and records nothing.
+type Instrumentation struct {
+	product string
+
+	tracer   trace.Tracer
+	duration metric.Float64Histogram
+	errors   metric.Int64Counter
+}
+
+// New creates an Instrumentation for the product, e.g. "jira" or "bitbucket".
+// Nothing is recorded until a tracer or meter provider is set.
+func New(product string) *Instrumentation {
+	return &Instrumentation{product: product}
+}
+
+// SetTracerProvider sets the provider of the tracer creating the request spans.
+func (i *Instrumentation) SetTracerProvider(provider trace.TracerProvider) error {
+
+	if provider == nil {
+		return fmt.Errorf("tracer provider cannot be nil")
+	}
+
+	i.tracer = provider.Tracer(ScopeName)
+	return nil
+}
+
+// SetMeterProvider sets the provider of the meter recording the latency histogram and the error counter.
+func (i *Instrumentation) SetMeterProvider(provider metric.MeterProvider) error {
+
+	if provider == nil {
+		return fmt.Errorf("meter provider cannot be nil")
+	}
+
+	meter := provider.Meter(ScopeName)
+
+	duration, err := meter.Float64Histogram(
+		DurationMetric,
+		metric.WithDescription("The duration of the requests sent to the Atlassian APIs, retries included."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return err
+	}
+
+	errs, err := meter.Int64Counter(
+		ErrorsMetric,
+		metric.WithDescription("The number of requests that failed or received an error status code."),
+		metric.WithUnit("{request}"),
+	)
+	if err != nil {
+		return err
+	}
+
+	i.duration, i.errors = duration, errs
+	return nil
+}
+
+// Start starts the span of the request and returns the request carrying the span context.
+// The returned Call must be ended once the response is received.
+func (i *Instrumentation) Start(request *http.Request) (*http.Request, *Call) {
+
+	if i == nil || request == nil || (i.tracer == nil && i.duration == nil) {
+		return request, nil
+	}
+
+	templated := route.Template(request.URL.Path)
+	attributes := []attribute.KeyValue{
+		ProductKey.String(i.product),
+		MethodKey.String(request.Method),
+		RouteKey.String(templated),
+		ServerKey.String(request.URL.Hostname()),
+	}
+
+	call := &Call{instrumentation: i, attributes: attributes, start: time.Now()}
+
+	if i.tracer != nil {
+
+		var ctx context.Context
+		ctx, call.span = i.tracer.Start(request.Context(), request.Method+" "+templated,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(attributes...),
+		)
+
+		request = request.WithContext(ctx)
+	}
+
+	return request, call
+}
+
+// Call is an instrumented request in flight.
+// A nil *Call is valid and records nothing.
+type Call struct {
+	instrumentation *Instrumentation
+	attributes      []attribute.KeyValue
+	span            trace.Span
+	start           time.Time
+}
+
+// End ends the span and records the metrics of the request.
+func (c *Call) End(response *http.Response, retries int, err error) {
+
+	if c == nil {
+		return
+	}
+
+	ctx := context.Background()
+	if c.span != nil {
+		ctx = trace.ContextWithSpan(ctx, c.span)
+	}
+
+	attributes := c.attributes
+	errorType := ""
+
+	switch {
+	case err != nil:
+		errorType = fmt.Sprintf("%T", err)
+	case response != nil:
+		attributes = append(attributes, StatusCodeKey.Int(response.StatusCode))
+
+		if response.StatusCode >= http.StatusBadRequest {
+			errorType = fmt.Sprint(response.StatusCode)
+		}
+	}
+
+	if errorType != "" {
+		attributes = append(attributes, ErrorTypeKey.String(errorType))
+	}
+
+	if c.span != nil {
+		c.span.SetAttributes(attributes...)
+		c.span.SetAttributes(RetriesKey.Int(retries))
+
+		if err != nil {
+			c.span.RecordError(err)
+			c.span.SetStatus(codes.Error, err.Error())
+		} else if errorType != "" {
+			c.span.SetStatus(codes.Error, http.StatusText(response.StatusCode))
+		}
+
+		c.span.End()
+	}
+
+	if c.instrumentation.duration != nil {
+
+		set := metric.WithAttributes(attributes...)
+		c.instrumentation.duration.Record(ctx, time.Since(c.start).Seconds(), set)
+
+		if errorType != "" {
+			c.instrumentation.errors.Add(ctx, 1, set)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func newInstrumentation(t *testing.T) (*Instrumentation, *tracetest.SpanRecorder, *sdkmetric.ManualReader) {
+	t.Helper()
+
+	recorder := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+
+	instrumentation := New("jira")
+	assert.NoError(t, instrumentation.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))))
+	assert.NoError(t, instrumentation.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))))
+
+	return instrumentation, recorder, reader
+}
+
+func collect(t *testing.T, reader *sdkmetric.ManualReader) map[string]metricdata.Metrics {
+	t.Helper()
+
+	var data metricdata.ResourceMetrics
+	assert.NoError(t, reader.Collect(context.Background(), &data))
+
+	metrics := make(map[string]metricdata.Metrics)
+	for _, scope := range data.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			metrics[m.Name] = m
+		}
+	}
+
+	return metrics
+}
+
+func TestInstrumentation(t *testing.T) {
+
+	instrumentation, recorder, reader := newInstrumentation(t)
+
+	request, err := http.NewRequest(http.MethodGet, "https://ctreminiom.atlassian.net/rest/api/3/issue/KP-1?fields=summary", nil)
+	assert.NoError(t, err)
+
+	instrumented, call := instrumentation.Start(request)
+	assert.True(t, trace.SpanContextFromContext(instrumented.Context()).IsValid())
+
+	call.End(&http.Response{StatusCode: http.StatusNotFound}, 2, nil)
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 1)
+	assert.Equal(t, "GET /rest/api/3/issue/{issueIdOrKey}", spans[0].Name())
+	assert.Equal(t, trace.SpanKindClient, spans[0].SpanKind())
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+
+	attributes := attribute.NewSet(spans[0].Attributes()...)
+	for key, want := range map[attribute.Key]attribute.Value{
+		ProductKey:    attribute.StringValue("jira"),
+		MethodKey:     attribute.StringValue(http.MethodGet),
+		RouteKey:      attribute.StringValue("/rest/api/3/issue/{issueIdOrKey}"),
+		StatusCodeKey: attribute.IntValue(http.StatusNotFound),
+		RetriesKey:    attribute.IntValue(2),
+	} {
+		got, ok := attributes.Value(key)
+		assert.True(t, ok, "missing attribute %v", key)
+		assert.Equal(t, want, got)
+	}
+
+	metrics := collect(t, reader)
+
+	duration, ok := metrics[DurationMetric].Data.(metricdata.Histogram[float64])
+	assert.True(t, ok)
+	assert.Len(t, duration.DataPoints, 1)
+	assert.Equal(t, uint64(1), duration.DataPoints[0].Count)
+
+	errs, ok := metrics[ErrorsMetric].Data.(metricdata.Sum[int64])
+	assert.True(t, ok)
+	assert.Len(t, errs.DataPoints, 1)
+	assert.Equal(t, int64(1), errs.DataPoints[0].Value)
+
+	product, _ := errs.DataPoints[0].Attributes.Value(ProductKey)
+	assert.Equal(t, "jira", product.AsString())
+}
+
+func TestInstrumentation_TransportError(t *testing.T) {
+
+	instrumentation, recorder, reader := newInstrumentation(t)
+
+	request, err := http.NewRequest(http.MethodPost, "https://ctreminiom.atlassian.net/rest/api/3/issue", nil)
+	assert.NoError(t, err)
+
+	_, call := instrumentation.Start(request)
+	call.End(nil, 0, errors.New("connection refused"))
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 1)
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	assert.Len(t, spans[0].Events(), 1)
+
+	errs := collect(t, reader)[ErrorsMetric].Data.(metricdata.Sum[int64])
+	assert.Equal(t, int64(1), errs.DataPoints[0].Value)
+}
+
+func TestInstrumentation_Disabled(t *testing.T) {
+
+	request, err := http.NewRequest(http.MethodGet, "https://ctreminiom.atlassian.net/rest/api/3/myself", nil)
+	assert.NoError(t, err)
+
+	var nilInstrumentation *Instrumentation
+	got, call := nilInstrumentation.Start(request)
+	assert.Same(t, request, got)
+	assert.Nil(t, call)
+
+	got, call = New("jira").Start(request)
+	assert.Same(t, request, got)
+	assert.Nil(t, call)
+
+	got, call = New("jira").Start(nil)
+	assert.Nil(t, got)
+	assert.Nil(t, call)
+
+	// Ending a nil call is a no-op.
+	call.End(nil, 0, nil)
+
+	assert.Error(t, New("jira").SetTracerProvider(nil))
+	assert.Error(t, New("jira").SetMeterProvider(nil))
+}
@@ -0,0 +1,242 @@
+// Package webhook receives the webhook deliveries of the Atlassian products.
+//
+// The Handler validates the X-Hub-Signature of the deliveries and dispatches them to the handlers registered
+// for their event, the product packages build their deliveries and decode the typed events on top of it.
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+const (
+	// maxPayloadSize is the maximum size of a delivery payload read by the handler.
+	maxPayloadSize = 10 << 20
+
+	// signaturePrefix is the prefix of the X-Hub-Signature header, naming the HMAC hash function.
+	signaturePrefix = "sha256="
+)
+
+// Option configures a Handler.
+type Option func(*options)
+
+// options are the settings shared by the handlers of every delivery type.
+type options struct {
+	unsigned bool
+	verifier func(request *http.Request, payload []byte) error
+	onError  func(request *http.Request, err error)
+}
+
+// WithErrorHandler sets the function called with the errors the handler responds with,
+// to log the invalid deliveries and the failures of the registered handlers.
+func WithErrorHandler(fn func(request *http.Request, err error)) Option {
+	return func(o *options) {
+		o.onError = fn
+	}
+}
+
+// WithoutSignatureVerification disables the validation of the X-Hub-Signature of the deliveries,
+// for the webhooks created without a secret. Any client reaching the handler can then forge a delivery,
+// only use it behind another authentication, e.g. a private network or a verifying proxy.
+func WithoutSignatureVerification() Option {
+	return func(o *options) {
+		o.unsigned = true
+	}
+}
+
+// WithVerifier sets the function authenticating the deliveries instead of the validation of their X-Hub-Signature,
+// e.g. to verify the JWT sent by the products with the deliveries of the webhooks registered by an app.
+// The deliveries it returns an error for are rejected with 401.
+func WithVerifier(fn func(request *http.Request, payload []byte) error) Option {
+	return func(o *options) {
+		o.verifier = fn
+	}
+}
+
+// Parser builds the delivery of a request from its headers and its verified payload,
+// it returns the name of the event the delivery is dispatched for.
+type Parser[D any] func(request *http.Request, payload []byte) (delivery D, event string, err error)
+
+// Handler receives the webhook deliveries of a product and dispatches them to the registered handlers.
+//
+// The handlers are called sequentially in their registration order, until one of them returns an error.
+// The handler responds with 204 once the delivery is handled, even when no handler is registered for its event,
+// with 500 when a registered handler fails and with a 4xx status when the delivery is invalid.
+type Handler[D any] struct {
+	product string
+	secret  []byte
+	parse   Parser[D]
+	options
+
+	mu       sync.RWMutex
+	routes   map[string][]func(context.Context, D) error
+	fallback []func(context.Context, D) error
+}
+
+// NewHandler returns a Handler validating the deliveries of the product signed with the secret of the webhook.
+//
+// The secret is required, unless the WithVerifier or the WithoutSignatureVerification option is provided.
+func NewHandler[D any](product, secret string, parse Parser[D], opts ...Option) (*Handler[D], error) {
+
+	h := &Handler[D]{
+		product: product,
+		secret:  []byte(secret),
+		parse:   parse,
+		routes:  make(map[string][]func(context.Context, D) error),
+	}
+
+	for _, opt := range opts {
+		opt(&h.options)
+	}
+
+	if len(h.secret) == 0 && h.verifier == nil && !h.unsigned {
+		return nil, fmt.Errorf("%v: webhook: %w", product, model.ErrNoWebhookSecret)
+	}
+
+	return h, nil
+}
+
+// OnEvent registers a handler receiving the deliveries of the events,
+// of every event when no event is provided.
+func (h *Handler[D]) OnEvent(fn func(ctx context.Context, delivery D) error, events ...string) {
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(events) == 0 {
+		h.fallback = append(h.fallback, fn)
+		return
+	}
+
+	for _, event := range events {
+		h.routes[event] = append(h.routes[event], fn)
+	}
+}
+
+// ServeHTTP validates the delivery of the request and dispatches it to the registered handlers.
+func (h *Handler[D]) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		h.fail(w, r, http.StatusMethodNotAllowed, fmt.Errorf("%v: webhook: method %v not allowed", h.product, r.Method))
+		return
+	}
+
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxPayloadSize))
+	if err != nil {
+
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			h.fail(w, r, http.StatusRequestEntityTooLarge, err)
+			return
+		}
+
+		h.fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.verify(r, payload); err != nil {
+		h.fail(w, r, http.StatusUnauthorized, err)
+		return
+	}
+
+	delivery, event, err := h.parse(r, payload)
+	if err != nil {
+		h.fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.Dispatch(r.Context(), event, delivery); err != nil {
+
+		if errors.Is(err, model.ErrNoWebhookEventKey) || errors.Is(err, model.ErrInvalidWebhookPayload) {
+			h.fail(w, r, http.StatusBadRequest, err)
+			return
+		}
+
+		h.fail(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Dispatch calls the handlers registered for the event with the delivery.
+func (h *Handler[D]) Dispatch(ctx context.Context, event string, delivery D) error {
+
+	if event == "" {
+		return fmt.Errorf("%v: webhook: %w", h.product, model.ErrNoWebhookEventKey)
+	}
+
+	h.mu.RLock()
+	handlers := append(append([]func(context.Context, D) error{}, h.routes[event]...), h.fallback...)
+	h.mu.RUnlock()
+
+	for _, handler := range handlers {
+		if err := handler(ctx, delivery); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// verify authenticates the delivery with the verifier, or checks the signature of its payload,
+// unless the verification is disabled.
+func (h *Handler[D]) verify(r *http.Request, payload []byte) error {
+
+	switch {
+	case h.verifier != nil:
+		return h.verifier(r, payload)
+	case h.unsigned:
+		return nil
+	}
+
+	digest, found := strings.CutPrefix(r.Header.Get("X-Hub-Signature"), signaturePrefix)
+	if !found {
+		return fmt.Errorf("%v: webhook: %w", h.product, model.ErrInvalidWebhookSignature)
+	}
+
+	expected, err := hex.DecodeString(digest)
+	if err != nil {
+		return fmt.Errorf("%v: webhook: %w", h.product, model.ErrInvalidWebhookSignature)
+	}
+
+	mac := hmac.New(sha256.New, h.secret)
+	mac.Write(payload)
+
+	if !hmac.Equal(mac.Sum(nil), expected) {
+		return fmt.Errorf("%v: webhook: %w", h.product, model.ErrInvalidWebhookSignature)
+	}
+
+	return nil
+}
+
+// fail responds with the status and reports the error to the error handler.
+func (h *Handler[D]) fail(w http.ResponseWriter, r *http.Request, status int, err error) {
+
+	if h.onError != nil {
+		h.onError(r, err)
+	}
+
+	http.Error(w, http.StatusText(status), status)
+}
+
+// Signature returns the X-Hub-Signature header value of a payload signed with the secret,
+// use it to test the handlers with signed deliveries.
+func Signature(secret string, payload []byte) string {
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,188 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// parseHeader builds the deliveries of the tests, the payload dispatched for the event of the X-Event header.
+func parseHeader(r *http.Request, payload []byte) (string, string, error) {
+
+	if string(payload) == "{" {
+		return "", "", fmt.Errorf("test: webhook: %w", model.ErrInvalidWebhookPayload)
+	}
+
+	return string(payload), r.Header.Get("X-Event"), nil
+}
+
+func newRequest(signature, event, payload string) *http.Request {
+
+	request := httptest.NewRequest(http.MethodPost, "/events", strings.NewReader(payload))
+	request.Header.Set("X-Event", event)
+	request.Header.Set("X-Hub-Signature", signature)
+
+	return request
+}
+
+func TestNewHandler(t *testing.T) {
+
+	t.Run("when the secret is not provided", func(t *testing.T) {
+
+		handler, err := NewHandler("test", "", parseHeader)
+
+		assert.Nil(t, handler)
+		assert.True(t, errors.Is(err, model.ErrNoWebhookSecret))
+		assert.EqualError(t, err, "test: webhook: no webhook secret set")
+	})
+
+	t.Run("when the signature verification is disabled", func(t *testing.T) {
+
+		handler, err := NewHandler("test", "", parseHeader, WithoutSignatureVerification())
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, newRequest("", "created", "{}"))
+
+		assert.Equal(t, http.StatusNoContent, recorder.Code)
+	})
+
+	t.Run("when the deliveries are authenticated by a verifier", func(t *testing.T) {
+
+		handler, err := NewHandler("test", "", parseHeader, WithVerifier(func(request *http.Request, payload []byte) error {
+			if request.Header.Get("Authorization") != "Bearer token" {
+				return fmt.Errorf("test: webhook: %w", model.ErrInvalidWebhookSignature)
+			}
+
+			return nil
+		}))
+		assert.NoError(t, err)
+
+		authenticated := newRequest("", "created", "{}")
+		authenticated.Header.Set("Authorization", "Bearer token")
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, authenticated)
+		assert.Equal(t, http.StatusNoContent, recorder.Code)
+
+		recorder = httptest.NewRecorder()
+		handler.ServeHTTP(recorder, newRequest(Signature("s3cr3t", []byte("{}")), "created", "{}"))
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	})
+}
+
+func TestHandler_ServeHTTP(t *testing.T) {
+
+	testCases := []struct {
+		name      string
+		signature string
+		event     string
+		payload   string
+		status    int
+		err       error
+	}{
+		{
+			name:      "when the delivery is signed",
+			signature: Signature("s3cr3t", []byte("{}")),
+			event:     "created",
+			payload:   "{}",
+			status:    http.StatusNoContent,
+		},
+		{
+			name:      "when the signature does not match",
+			signature: Signature("another-secret", []byte("{}")),
+			event:     "created",
+			payload:   "{}",
+			status:    http.StatusUnauthorized,
+			err:       model.ErrInvalidWebhookSignature,
+		},
+		{
+			name:      "when the signature has no hash function",
+			signature: strings.TrimPrefix(Signature("s3cr3t", []byte("{}")), signaturePrefix),
+			event:     "created",
+			payload:   "{}",
+			status:    http.StatusUnauthorized,
+			err:       model.ErrInvalidWebhookSignature,
+		},
+		{
+			name:      "when the signature is not hexadecimal",
+			signature: signaturePrefix + "not-hexadecimal",
+			event:     "created",
+			payload:   "{}",
+			status:    http.StatusUnauthorized,
+			err:       model.ErrInvalidWebhookSignature,
+		},
+		{
+			name:      "when the delivery cannot be parsed",
+			signature: Signature("s3cr3t", []byte("{")),
+			event:     "created",
+			payload:   "{",
+			status:    http.StatusBadRequest,
+			err:       model.ErrInvalidWebhookPayload,
+		},
+		{
+			name:      "when the event is not provided",
+			signature: Signature("s3cr3t", []byte("{}")),
+			payload:   "{}",
+			status:    http.StatusBadRequest,
+			err:       model.ErrNoWebhookEventKey,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			var reported error
+			handler, err := NewHandler("test", "s3cr3t", parseHeader, WithErrorHandler(func(request *http.Request, err error) {
+				reported = err
+			}))
+			assert.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, newRequest(testCase.signature, testCase.event, testCase.payload))
+
+			assert.Equal(t, testCase.status, recorder.Code)
+
+			if testCase.err != nil {
+				assert.True(t, errors.Is(reported, testCase.err))
+			} else {
+				assert.NoError(t, reported)
+			}
+		})
+	}
+}
+
+func TestHandler_Dispatch(t *testing.T) {
+
+	handler, err := NewHandler("test", "s3cr3t", parseHeader)
+	assert.NoError(t, err)
+
+	var calls []string
+	handler.OnEvent(func(ctx context.Context, delivery string) error {
+		calls = append(calls, "created:"+delivery)
+		return nil
+	}, "created")
+
+	handler.OnEvent(func(ctx context.Context, delivery string) error {
+		calls = append(calls, "any:"+delivery)
+		return nil
+	})
+
+	assert.NoError(t, handler.Dispatch(context.Background(), "created", "1"))
+	assert.NoError(t, handler.Dispatch(context.Background(), "deleted", "2"))
+	assert.Equal(t, []string{"created:1", "any:1", "any:2"}, calls)
+
+	failure := errors.New("queue unavailable")
+	handler.OnEvent(func(ctx context.Context, delivery string) error { return failure }, "deleted")
+
+	assert.ErrorIs(t, handler.Dispatch(context.Background(), "deleted", "3"), failure)
+	assert.Equal(t, []string{"created:1", "any:1", "any:2"}, calls)
+}
@@ -0,0 +1,665 @@
+package jirafake
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// sprintDateLayouts are the layouts accepted by the sprint dates.
+var sprintDateLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.000-0700",
+	model.TimeFormat,
+	"2006-01-02",
+}
+
+// issuePage is a page of issues returned by the agile endpoints, the descriptions are rendered as plain text.
+type issuePage struct {
+	StartAt    int                      `json:"startAt"`
+	MaxResults int                      `json:"maxResults"`
+	Total      int                      `json:"total"`
+	Issues     []map[string]interface{} `json:"issues"`
+}
+
+// findBoard returns the board with the ID, the caller must hold the lock.
+func (s *Server) findBoard(boardID int) *model.BoardScheme {
+
+	for _, board := range s.boards {
+		if board.ID == boardID {
+			return board
+		}
+	}
+
+	return nil
+}
+
+// findSprint returns the sprint with the ID, the caller must hold the lock.
+func (s *Server) findSprint(sprintID int) *model.SprintScheme {
+
+	for _, sprint := range s.sprints {
+		if sprint.ID == sprintID {
+			return sprint
+		}
+	}
+
+	return nil
+}
+
+// lookupBoard returns the board of the request path, or writes a not found error.
+// The caller must hold the lock.
+func (s *Server) lookupBoard(w http.ResponseWriter, r *http.Request) *model.BoardScheme {
+
+	boardID, _ := strconv.Atoi(r.PathValue("boardId"))
+
+	board := s.findBoard(boardID)
+	if board == nil {
+		writeError(w, http.StatusNotFound, "The requested board cannot be viewed because it either does not exist or you do not have permission to view it.")
+	}
+
+	return board
+}
+
+// lookupSprint returns the sprint of the request path, or writes a not found error.
+// The caller must hold the lock.
+func (s *Server) lookupSprint(w http.ResponseWriter, r *http.Request) *model.SprintScheme {
+
+	sprintID, _ := strconv.Atoi(r.PathValue("sprintId"))
+
+	sprint := s.findSprint(sprintID)
+	if sprint == nil {
+		writeError(w, http.StatusNotFound, "Sprint does not exist or you do not have permission to view it.")
+	}
+
+	return sprint
+}
+
+func (s *Server) getBoards(w http.ResponseWriter, r *http.Request) {
+
+	params := r.URL.Query()
+	startAt, maxResults := page(r)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var matched []*model.BoardScheme
+	for _, board := range s.boards {
+
+		if kind := params.Get("type"); kind != "" && !containsFold(strings.Split(kind, ","), board.Type) {
+			continue
+		}
+
+		if name := params.Get("name"); name != "" && !strings.Contains(strings.ToLower(board.Name), strings.ToLower(name)) {
+			continue
+		}
+
+		if project := params.Get("projectKeyOrId"); project != "" &&
+			(board.Location == nil || (!strings.EqualFold(board.Location.ProjectKey, project) && strconv.Itoa(board.Location.ProjectID) != project)) {
+			continue
+		}
+
+		matched = append(matched, board)
+	}
+
+	from, to := window(len(matched), startAt, maxResults)
+
+	writeJSON(w, http.StatusOK, &model.BoardPageScheme{
+		StartAt:    startAt,
+		MaxResults: maxResults,
+		Total:      len(matched),
+		IsLast:     to == len(matched),
+		Values:     append([]*model.BoardScheme{}, matched[from:to]...),
+	})
+}
+
+func (s *Server) createBoard(w http.ResponseWriter, r *http.Request) {
+
+	payload := new(model.BoardPayloadScheme)
+	if !decode(w, r, payload) {
+		return
+	}
+
+	if payload.Name == "" {
+		writeFieldError(w, "name", "The board name is required.")
+		return
+	}
+
+	if payload.Type != "scrum" && payload.Type != "kanban" {
+		writeFieldError(w, "type", "The board type must be either scrum or kanban.")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	board := &model.BoardScheme{Name: payload.Name, Type: payload.Type}
+
+	if payload.Location != nil && payload.Location.ProjectKeyOrID != "" {
+
+		project := s.findProject(payload.Location.ProjectKeyOrID, payload.Location.ProjectKeyOrID)
+		if project == nil {
+			writeFieldError(w, "location", "The project "+payload.Location.ProjectKeyOrID+" does not exist.")
+			return
+		}
+
+		projectID, _ := strconv.Atoi(project.ID)
+
+		board.Location = &model.BoardLocationScheme{
+			ProjectID:      projectID,
+			DisplayName:    fmt.Sprintf("%v (%v)", project.Name, project.Key),
+			ProjectName:    project.Name,
+			ProjectKey:     project.Key,
+			ProjectTypeKey: project.ProjectTypeKey,
+			Name:           fmt.Sprintf("%v (%v)", project.Name, project.Key),
+		}
+	}
+
+	board.ID = s.id()
+	board.Self = s.URL + "/rest/agile/1.0/board/" + strconv.Itoa(board.ID)
+	s.boards = append(s.boards, board)
+
+	writeJSON(w, http.StatusCreated, board)
+}
+
+func (s *Server) getBoard(w http.ResponseWriter, r *http.Request) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if board := s.lookupBoard(w, r); board != nil {
+		writeJSON(w, http.StatusOK, board)
+	}
+}
+
+// deleteBoard deletes the board and its sprints, the issues of the sprints are moved to the backlog.
+func (s *Server) deleteBoard(w http.ResponseWriter, r *http.Request) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	board := s.lookupBoard(w, r)
+	if board == nil {
+		return
+	}
+
+	var boards []*model.BoardScheme
+	for _, candidate := range s.boards {
+		if candidate != board {
+			boards = append(boards, candidate)
+		}
+	}
+
+	var sprints []*model.SprintScheme
+	for _, sprint := range s.sprints {
+
+		if sprint.OriginBoardID != board.ID {
+			sprints = append(sprints, sprint)
+			continue
+		}
+
+		for _, stored := range s.issues {
+			if stored.sprintID == sprint.ID {
+				stored.sprintID = 0
+			}
+		}
+	}
+
+	s.boards, s.sprints = boards, sprints
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) getBoardIssues(w http.ResponseWriter, r *http.Request) {
+
+	s.writeIssuePage(w, r, func(board *model.BoardScheme, _ *issue) bool {
+		return true
+	})
+}
+
+// getBoardBacklog returns the issues of the board which aren't in an active or future sprint.
+func (s *Server) getBoardBacklog(w http.ResponseWriter, r *http.Request) {
+
+	s.writeIssuePage(w, r, func(_ *model.BoardScheme, stored *issue) bool {
+		sprint := s.findSprint(stored.sprintID)
+		return sprint == nil || sprint.State == "closed"
+	})
+}
+
+func (s *Server) getBoardSprintIssues(w http.ResponseWriter, r *http.Request) {
+
+	sprintID, _ := strconv.Atoi(r.PathValue("sprintId"))
+
+	s.writeIssuePage(w, r, func(_ *model.BoardScheme, stored *issue) bool {
+		return stored.sprintID == sprintID
+	})
+}
+
+// writeIssuePage writes a page of the board issues kept by the filter and matching the jql parameter.
+func (s *Server) writeIssuePage(w http.ResponseWriter, r *http.Request, filter func(*model.BoardScheme, *issue) bool) {
+
+	params := r.URL.Query()
+	startAt, maxResults := page(r)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	board := s.lookupBoard(w, r)
+	if board == nil {
+		return
+	}
+
+	matched, err := s.search(params.Get("jql"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Error in the JQL Query: "+err.Error())
+		return
+	}
+
+	var kept []*issue
+	for _, stored := range matched {
+
+		if board.Location != nil && stored.scheme.Fields.Project.Key != board.Location.ProjectKey {
+			continue
+		}
+
+		if filter(board, stored) {
+			kept = append(kept, stored)
+		}
+	}
+
+	var fields []string
+	if value := params.Get("fields"); value != "" {
+		fields = strings.Split(value, ",")
+	}
+
+	from, to := window(len(kept), startAt, maxResults)
+	result := &issuePage{StartAt: startAt, MaxResults: maxResults, Total: len(kept), Issues: []map[string]interface{}{}}
+
+	for _, stored := range kept[from:to] {
+
+		rendered, err := stored.render()
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// The agile API returns the description using the wiki markup instead of ADF.
+		fields := selectFields(rendered["fields"].(map[string]interface{}), fields)
+		if _, ok := fields["description"]; ok {
+			fields["description"] = plainText(stored.scheme.Fields.Description)
+		}
+
+		rendered["fields"] = fields
+		result.Issues = append(result.Issues, rendered)
+	}
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (s *Server) getBoardSprints(w http.ResponseWriter, r *http.Request) {
+
+	startAt, maxResults := page(r)
+
+	var states []string
+	if value := r.URL.Query().Get("state"); value != "" {
+		states = strings.Split(value, ",")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	board := s.lookupBoard(w, r)
+	if board == nil {
+		return
+	}
+
+	if board.Type != "scrum" {
+		writeError(w, http.StatusBadRequest, "The board does not support sprints")
+		return
+	}
+
+	var matched []*model.BoardSprintScheme
+	for _, sprint := range s.sprints {
+		if sprint.OriginBoardID == board.ID && (len(states) == 0 || containsFold(states, sprint.State)) {
+			matched = append(matched, (*model.BoardSprintScheme)(sprint))
+		}
+	}
+
+	from, to := window(len(matched), startAt, maxResults)
+
+	writeJSON(w, http.StatusOK, &model.BoardSprintPageScheme{
+		StartAt:    startAt,
+		MaxResults: maxResults,
+		Total:      len(matched),
+		IsLast:     to == len(matched),
+		Values:     matched[from:to],
+	})
+}
+
+// applySprint applies the non-empty values of the payload on the sprint.
+func applySprint(sprint *model.SprintScheme, payload *model.SprintPayloadScheme) error {
+
+	if payload.Name != "" {
+		sprint.Name = payload.Name
+	}
+
+	if payload.Goal != "" {
+		sprint.Goal = payload.Goal
+	}
+
+	for _, date := range []struct {
+		value  string
+		target *time.Time
+	}{
+		{payload.StartDate, &sprint.StartDate},
+		{payload.EndDate, &sprint.EndDate},
+	} {
+
+		if date.value == "" {
+			continue
+		}
+
+		parsed, err := parseSprintDate(date.value)
+		if err != nil {
+			return err
+		}
+
+		*date.target = parsed
+	}
+
+	return nil
+}
+
+// parseSprintDate parses a sprint date using the accepted layouts.
+func parseSprintDate(value string) (time.Time, error) {
+
+	for _, layout := range sprintDateLayouts {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("the date '%v' is not valid", value)
+}
+
+// transitionSprint moves the sprint to the state, the sprints are started once and closed once.
+func (s *Server) transitionSprint(sprint *model.SprintScheme, state string) error {
+
+	state = strings.ToLower(state)
+	if state == "" || state == sprint.State {
+		return nil
+	}
+
+	switch {
+	case state == "active" && sprint.State == "future":
+		if sprint.StartDate.IsZero() || sprint.EndDate.IsZero() {
+			return fmt.Errorf("the sprint must have a start date and an end date to be started")
+		}
+
+	case state == "closed" && sprint.State == "active":
+		sprint.CompleteDate = s.now()
+
+	default:
+		return fmt.Errorf("the sprint can't be moved from the state %v to the state %v", sprint.State, state)
+	}
+
+	sprint.State = state
+	return nil
+}
+
+func (s *Server) createSprint(w http.ResponseWriter, r *http.Request) {
+
+	payload := new(model.SprintPayloadScheme)
+	if !decode(w, r, payload) {
+		return
+	}
+
+	if payload.Name == "" {
+		writeFieldError(w, "name", "The sprint name is required.")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if board := s.findBoard(payload.OriginBoardID); board == nil || board.Type != "scrum" {
+		writeFieldError(w, "originBoardId", "The board does not exist or does not support sprints.")
+		return
+	}
+
+	sprint := &model.SprintScheme{State: "future", OriginBoardID: payload.OriginBoardID}
+	if err := applySprint(sprint, payload); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	sprint.ID = s.id()
+	sprint.Self = s.URL + "/rest/agile/1.0/sprint/" + strconv.Itoa(sprint.ID)
+	s.sprints = append(s.sprints, sprint)
+
+	writeJSON(w, http.StatusCreated, sprint)
+}
+
+func (s *Server) getSprint(w http.ResponseWriter, r *http.Request) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if sprint := s.lookupSprint(w, r); sprint != nil {
+		writeJSON(w, http.StatusOK, sprint)
+	}
+}
+
+// updateSprint replaces the sprint values, the omitted values are cleared.
+func (s *Server) updateSprint(w http.ResponseWriter, r *http.Request) {
+
+	payload := new(model.SprintPayloadScheme)
+	if !decode(w, r, payload) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sprint := s.lookupSprint(w, r)
+	if sprint == nil {
+		return
+	}
+
+	updated := &model.SprintScheme{
+		ID:            sprint.ID,
+		Self:          sprint.Self,
+		State:         sprint.State,
+		OriginBoardID: sprint.OriginBoardID,
+		CompleteDate:  sprint.CompleteDate,
+	}
+
+	if err := applySprint(updated, payload); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := s.transitionSprint(updated, payload.State); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	*sprint = *updated
+	writeJSON(w, http.StatusOK, sprint)
+}
+
+// partiallyUpdateSprint updates the sprint values present in the payload, e.g. to start or close the sprint.
+func (s *Server) partiallyUpdateSprint(w http.ResponseWriter, r *http.Request) {
+
+	payload := new(model.SprintPayloadScheme)
+	if !decode(w, r, payload) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sprint := s.lookupSprint(w, r)
+	if sprint == nil {
+		return
+	}
+
+	updated := *sprint
+	if err := applySprint(&updated, payload); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := s.transitionSprint(&updated, payload.State); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	*sprint = updated
+	writeJSON(w, http.StatusOK, sprint)
+}
+
+// deleteSprint deletes the sprint, its issues are moved to the backlog.
+func (s *Server) deleteSprint(w http.ResponseWriter, r *http.Request) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sprint := s.lookupSprint(w, r)
+	if sprint == nil {
+		return
+	}
+
+	if sprint.State == "active" {
+		writeError(w, http.StatusBadRequest, "The active sprints can't be deleted.")
+		return
+	}
+
+	var sprints []*model.SprintScheme
+	for _, candidate := range s.sprints {
+		if candidate != sprint {
+			sprints = append(sprints, candidate)
+		}
+	}
+
+	for _, stored := range s.issues {
+		if stored.sprintID == sprint.ID {
+			stored.sprintID = 0
+		}
+	}
+
+	s.sprints = sprints
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) getSprintIssues(w http.ResponseWriter, r *http.Request) {
+
+	params := r.URL.Query()
+	startAt, maxResults := page(r)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sprint := s.lookupSprint(w, r)
+	if sprint == nil {
+		return
+	}
+
+	matched, err := s.search(params.Get("jql"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Error in the JQL Query: "+err.Error())
+		return
+	}
+
+	var kept []*issue
+	for _, stored := range matched {
+		if stored.sprintID == sprint.ID {
+			kept = append(kept, stored)
+		}
+	}
+
+	var fields []string
+	if value := params.Get("fields"); value != "" {
+		fields = strings.Split(value, ",")
+	}
+
+	from, to := window(len(kept), startAt, maxResults)
+	result := &issuePage{StartAt: startAt, MaxResults: maxResults, Total: len(kept), Issues: []map[string]interface{}{}}
+
+	for _, stored := range kept[from:to] {
+
+		rendered, err := stored.render()
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rendered["fields"] = selectFields(rendered["fields"].(map[string]interface{}), fields)
+		result.Issues = append(result.Issues, rendered)
+	}
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+// moveIssuesToSprint moves the issues to an open sprint.
+func (s *Server) moveIssuesToSprint(w http.ResponseWriter, r *http.Request) {
+
+	payload := new(model.SprintMovePayloadScheme)
+	if !decode(w, r, payload) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sprint := s.lookupSprint(w, r)
+	if sprint == nil {
+		return
+	}
+
+	if sprint.State == "closed" {
+		writeError(w, http.StatusBadRequest, "Issues can't be moved to a closed sprint.")
+		return
+	}
+
+	s.moveIssues(w, payload.Issues, sprint.ID)
+}
+
+// moveIssuesToBacklog moves the issues out of their sprints.
+func (s *Server) moveIssuesToBacklog(w http.ResponseWriter, r *http.Request) {
+
+	payload := new(model.SprintMovePayloadScheme)
+	if !decode(w, r, payload) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.moveIssues(w, payload.Issues, 0)
+}
+
+// moveIssues moves the issues to the sprint, or to the backlog when the sprint ID is zero.
+// The issues are only moved when every issue exists. The caller must hold the lock.
+func (s *Server) moveIssues(w http.ResponseWriter, issueIDsOrKeys []string, sprintID int) {
+
+	var moved []*issue
+	for _, issueIDOrKey := range issueIDsOrKeys {
+
+		stored := s.findIssue(issueIDOrKey)
+		if stored == nil {
+			writeError(w, http.StatusBadRequest, "Issue does not exist or you do not have permission to see it: "+issueIDOrKey)
+			return
+		}
+
+		moved = append(moved, stored)
+	}
+
+	for _, stored := range moved {
+		stored.sprintID = sprintID
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,580 @@
+package jirafake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// The statuses of the workflow used by every project, each of them can be reached from any other.
+var (
+	StatusToDo = &model.StatusScheme{
+		ID:             "10000",
+		Name:           "To Do",
+		StatusCategory: &model.StatusCategoryScheme{ID: 2, Key: "new", Name: "To Do", ColorName: "blue-gray"},
+	}
+
+	StatusInProgress = &model.StatusScheme{
+		ID:             "3",
+		Name:           "In Progress",
+		StatusCategory: &model.StatusCategoryScheme{ID: 4, Key: "indeterminate", Name: "In Progress", ColorName: "yellow"},
+	}
+
+	StatusDone = &model.StatusScheme{
+		ID:             "10001",
+		Name:           "Done",
+		StatusCategory: &model.StatusCategoryScheme{ID: 3, Key: "done", Name: "Done", ColorName: "green"},
+	}
+)
+
+// transitions are the global transitions of the workflow, keyed by ID.
+var transitions = []*model.IssueTransitionScheme{
+	{ID: "11", Name: "To Do", To: StatusToDo, IsGlobal: true, IsAvailable: true},
+	{ID: "21", Name: "In Progress", To: StatusInProgress, IsGlobal: true, IsAvailable: true},
+	{ID: "31", Name: "Done", To: StatusDone, IsGlobal: true, IsAvailable: true},
+}
+
+// issue is a stored issue, the custom fields are kept apart from the typed fields.
+type issue struct {
+	scheme   *model.IssueScheme
+	custom   map[string]interface{}
+	comments []*model.IssueCommentScheme
+	sprintID int
+	number   int
+}
+
+// issuePayload is the payload of the create, update and transition requests.
+type issuePayload struct {
+	Fields map[string]json.RawMessage              `json:"fields,omitempty"`
+	Update map[string][]map[string]json.RawMessage `json:"update,omitempty"`
+}
+
+// findIssue returns the issue with the ID or key, the caller must hold the lock.
+func (s *Server) findIssue(issueIDOrKey string) *issue {
+
+	for _, stored := range s.issues {
+		if stored.scheme.ID == issueIDOrKey || strings.EqualFold(stored.scheme.Key, issueIDOrKey) {
+			return stored
+		}
+	}
+
+	return nil
+}
+
+// lookupIssue returns the issue of the request path, or writes a not found error.
+// The caller must hold the lock.
+func (s *Server) lookupIssue(w http.ResponseWriter, r *http.Request) *issue {
+
+	stored := s.findIssue(r.PathValue("issueIdOrKey"))
+	if stored == nil {
+		writeError(w, http.StatusNotFound, "Issue does not exist or you do not have permission to see it.")
+	}
+
+	return stored
+}
+
+// render returns the issue as returned by the REST API, the custom fields merged with the typed ones.
+func (i *issue) render() (map[string]interface{}, error) {
+
+	rendered, err := i.scheme.ToMap()
+	if err != nil {
+		return nil, err
+	}
+
+	fields, _ := rendered["fields"].(map[string]interface{})
+	if fields == nil {
+		fields = make(map[string]interface{})
+		rendered["fields"] = fields
+	}
+
+	for key, value := range i.custom {
+		fields[key] = value
+	}
+
+	return rendered, nil
+}
+
+// apply overlays the fields and the update operations of the payload on the issue.
+func (i *issue) apply(payload *issuePayload) error {
+
+	current, err := json.Marshal(i.scheme.Fields)
+	if err != nil {
+		return err
+	}
+
+	fields := make(map[string]interface{})
+	if err := json.Unmarshal(current, &fields); err != nil {
+		return err
+	}
+
+	for key, raw := range payload.Fields {
+
+		var value interface{}
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return err
+		}
+
+		if strings.HasPrefix(key, "customfield_") {
+			i.custom[key] = value
+			continue
+		}
+
+		fields[key] = value
+	}
+
+	for key, operations := range payload.Update {
+
+		target := fields
+		if strings.HasPrefix(key, "customfield_") {
+			target = i.custom
+		}
+
+		for _, operation := range operations {
+			for verb, raw := range operation {
+
+				var value interface{}
+				if err := json.Unmarshal(raw, &value); err != nil {
+					return err
+				}
+
+				if err := operate(target, key, verb, value); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	updated, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+
+	typed := new(model.IssueFieldsScheme)
+	if err := json.Unmarshal(updated, typed); err != nil {
+		return err
+	}
+
+	// The system fields can't be edited.
+	typed.Status, typed.Created, typed.Creator = i.scheme.Fields.Status, i.scheme.Fields.Created, i.scheme.Fields.Creator
+	typed.Resolution, typed.Resolutiondate = i.scheme.Fields.Resolution, i.scheme.Fields.Resolutiondate
+	typed.Comment = i.scheme.Fields.Comment
+
+	i.scheme.Fields = typed
+	return nil
+}
+
+// operate applies an update operation, e.g. {"add": "label"}, on a field.
+func operate(fields map[string]interface{}, key, verb string, value interface{}) error {
+
+	switch verb {
+	case "set":
+		fields[key] = value
+
+	case "add":
+		values, _ := fields[key].([]interface{})
+		fields[key] = append(values, value)
+
+	case "remove":
+		values, _ := fields[key].([]interface{})
+
+		var kept []interface{}
+		for _, item := range values {
+			if !reflect.DeepEqual(item, value) {
+				kept = append(kept, item)
+			}
+		}
+
+		fields[key] = kept
+
+	default:
+		return fmt.Errorf("the operation %q is not supported", verb)
+	}
+
+	return nil
+}
+
+func (s *Server) createIssue(w http.ResponseWriter, r *http.Request) {
+
+	payload := new(issuePayload)
+	if !decode(w, r, payload) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := &issue{
+		scheme: &model.IssueScheme{Fields: &model.IssueFieldsScheme{}},
+		custom: make(map[string]interface{}),
+	}
+
+	if err := stored.apply(payload); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	fields := stored.scheme.Fields
+	if fields.Project == nil {
+		writeFieldError(w, "project", "Specify a valid project ID or key")
+		return
+	}
+
+	project := s.findProject(fields.Project.ID, fields.Project.Key)
+	if project == nil {
+		writeFieldError(w, "project", "Specify a valid project ID or key")
+		return
+	}
+
+	if fields.Summary == "" {
+		writeFieldError(w, "summary", "You must specify a summary of the issue.")
+		return
+	}
+
+	if fields.IssueType == nil || (fields.IssueType.ID == "" && fields.IssueType.Name == "") {
+		writeFieldError(w, "issuetype", "Specify an issue type")
+		return
+	}
+
+	if fields.IssueType.Name == "" {
+		fields.IssueType.Name = fields.IssueType.ID
+	}
+
+	now := model.DateTimeScheme(s.now())
+
+	s.nextKeys[project.Key]++
+	stored.number = s.nextKeys[project.Key]
+
+	id := strconv.Itoa(s.id())
+	stored.scheme.ID = id
+	stored.scheme.Key = fmt.Sprintf("%v-%v", project.Key, stored.number)
+	stored.scheme.Self = s.URL + "/rest/api/3/issue/" + id
+
+	fields.Project = &model.ProjectScheme{ID: project.ID, Key: project.Key, Name: project.Name, Self: project.Self}
+	fields.Status = StatusToDo
+	fields.Creator = CurrentUser
+	fields.Created, fields.Updated = &now, &now
+
+	if fields.Reporter == nil {
+		fields.Reporter = CurrentUser
+	}
+
+	s.issues = append(s.issues, stored)
+
+	writeJSON(w, http.StatusCreated, &model.IssueResponseScheme{ID: stored.scheme.ID, Key: stored.scheme.Key, Self: stored.scheme.Self})
+}
+
+func (s *Server) getIssue(w http.ResponseWriter, r *http.Request) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := s.lookupIssue(w, r)
+	if stored == nil {
+		return
+	}
+
+	rendered, err := stored.render()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, rendered)
+}
+
+func (s *Server) updateIssue(w http.ResponseWriter, r *http.Request) {
+
+	payload := new(issuePayload)
+	if !decode(w, r, payload) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := s.lookupIssue(w, r)
+	if stored == nil {
+		return
+	}
+
+	project := stored.scheme.Fields.Project
+	if err := stored.apply(payload); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	now := model.DateTimeScheme(s.now())
+	stored.scheme.Fields.Project = project
+	stored.scheme.Fields.Updated = &now
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteIssue(w http.ResponseWriter, r *http.Request) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := s.lookupIssue(w, r)
+	if stored == nil {
+		return
+	}
+
+	for index, candidate := range s.issues {
+		if candidate == stored {
+			s.issues = append(s.issues[:index], s.issues[index+1:]...)
+			break
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) assignIssue(w http.ResponseWriter, r *http.Request) {
+
+	payload := new(struct {
+		AccountID string `json:"accountId"`
+	})
+
+	if !decode(w, r, payload) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := s.lookupIssue(w, r)
+	if stored == nil {
+		return
+	}
+
+	stored.scheme.Fields.Assignee = nil
+	if payload.AccountID != "" {
+		stored.scheme.Fields.Assignee = &model.UserScheme{AccountID: payload.AccountID}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) getTransitions(w http.ResponseWriter, r *http.Request) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if stored := s.lookupIssue(w, r); stored == nil {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &model.IssueTransitionsScheme{Transitions: transitions})
+}
+
+func (s *Server) doTransition(w http.ResponseWriter, r *http.Request) {
+
+	payload := new(struct {
+		issuePayload
+		Transition struct {
+			ID string `json:"id"`
+		} `json:"transition"`
+	})
+
+	if !decode(w, r, payload) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := s.lookupIssue(w, r)
+	if stored == nil {
+		return
+	}
+
+	var transition *model.IssueTransitionScheme
+	for _, candidate := range transitions {
+		if candidate.ID == payload.Transition.ID {
+			transition = candidate
+		}
+	}
+
+	if transition == nil {
+		writeFieldError(w, "transition", "Transition id '"+payload.Transition.ID+"' is not valid for this issue.")
+		return
+	}
+
+	if len(payload.Fields) > 0 || len(payload.Update) > 0 {
+		if err := stored.apply(&payload.issuePayload); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	now := model.DateTimeScheme(s.now())
+	fields := stored.scheme.Fields
+	fields.Status, fields.Updated = transition.To, &now
+	fields.Resolution, fields.Resolutiondate = nil, nil
+
+	if transition.To == StatusDone {
+		fields.Resolution = &model.ResolutionScheme{ID: "10000", Name: "Done"}
+		fields.Resolutiondate = &now
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// findComment returns the comment with the ID.
+func (i *issue) findComment(commentID string) (int, *model.IssueCommentScheme) {
+
+	for index, comment := range i.comments {
+		if comment.ID == commentID {
+			return index, comment
+		}
+	}
+
+	return -1, nil
+}
+
+func (s *Server) getComments(w http.ResponseWriter, r *http.Request) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := s.lookupIssue(w, r)
+	if stored == nil {
+		return
+	}
+
+	comments := append([]*model.IssueCommentScheme{}, stored.comments...)
+	if r.URL.Query().Get("orderBy") == "-created" {
+		for left, right := 0, len(comments)-1; left < right; left, right = left+1, right-1 {
+			comments[left], comments[right] = comments[right], comments[left]
+		}
+	}
+
+	startAt, maxResults := page(r)
+	from, to := window(len(comments), startAt, maxResults)
+
+	writeJSON(w, http.StatusOK, &model.IssueCommentPageScheme{
+		StartAt:    startAt,
+		MaxResults: maxResults,
+		Total:      len(comments),
+		Comments:   comments[from:to],
+	})
+}
+
+func (s *Server) addComment(w http.ResponseWriter, r *http.Request) {
+
+	payload := new(model.CommentPayloadScheme)
+	if !decode(w, r, payload) {
+		return
+	}
+
+	if payload.Body == nil {
+		writeFieldError(w, "comment", "Comment body can not be empty!")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := s.lookupIssue(w, r)
+	if stored == nil {
+		return
+	}
+
+	now := s.now().Format(model.TimeFormat)
+	id := strconv.Itoa(s.id())
+
+	comment := &model.IssueCommentScheme{
+		Self:         stored.scheme.Self + "/comment/" + id,
+		ID:           id,
+		Author:       CurrentUser,
+		UpdateAuthor: CurrentUser,
+		Body:         payload.Body,
+		Visibility:   payload.Visibility,
+		Created:      now,
+		Updated:      now,
+	}
+
+	stored.comments = append(stored.comments, comment)
+
+	writeJSON(w, http.StatusCreated, comment)
+}
+
+func (s *Server) getComment(w http.ResponseWriter, r *http.Request) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := s.lookupIssue(w, r)
+	if stored == nil {
+		return
+	}
+
+	_, comment := stored.findComment(r.PathValue("commentId"))
+	if comment == nil {
+		writeError(w, http.StatusNotFound, "Can not find a comment for the id: "+r.PathValue("commentId")+".")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, comment)
+}
+
+func (s *Server) updateComment(w http.ResponseWriter, r *http.Request) {
+
+	payload := new(model.CommentPayloadScheme)
+	if !decode(w, r, payload) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := s.lookupIssue(w, r)
+	if stored == nil {
+		return
+	}
+
+	_, comment := stored.findComment(r.PathValue("commentId"))
+	if comment == nil {
+		writeError(w, http.StatusNotFound, "Can not find a comment for the id: "+r.PathValue("commentId")+".")
+		return
+	}
+
+	if payload.Body != nil {
+		comment.Body = payload.Body
+	}
+
+	if payload.Visibility != nil {
+		comment.Visibility = payload.Visibility
+	}
+
+	comment.UpdateAuthor = CurrentUser
+	comment.Updated = s.now().Format(model.TimeFormat)
+
+	writeJSON(w, http.StatusOK, comment)
+}
+
+func (s *Server) deleteComment(w http.ResponseWriter, r *http.Request) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := s.lookupIssue(w, r)
+	if stored == nil {
+		return
+	}
+
+	index, comment := stored.findComment(r.PathValue("commentId"))
+	if comment == nil {
+		writeError(w, http.StatusNotFound, "Can not find a comment for the id: "+r.PathValue("commentId")+".")
+		return
+	}
+
+	stored.comments = append(stored.comments[:index], stored.comments[index+1:]...)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,266 @@
+package jirafake
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ctreminiom/go-atlassian/v2/jira/agile"
+	v3 "github.com/ctreminiom/go-atlassian/v2/jira/v3"
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+func newClients(t *testing.T) (*Server, *v3.Client, *agile.Client) {
+	t.Helper()
+
+	server := New()
+	t.Cleanup(server.Close)
+
+	instance, err := v3.New(server.Client(), server.URL)
+	assert.NoError(t, err)
+
+	agileInstance, err := agile.New(server.Client(), server.URL)
+	assert.NoError(t, err)
+
+	return server, instance, agileInstance
+}
+
+func createIssue(t *testing.T, instance *v3.Client, summary string, labels []string, customFields *model.CustomFields) string {
+	t.Helper()
+
+	issue, response, err := instance.Issue.Create(context.Background(), &model.IssueScheme{
+		Fields: &model.IssueFieldsScheme{
+			Project:   &model.ProjectScheme{Key: "KP"},
+			IssueType: &model.IssueTypeScheme{Name: "Task"},
+			Summary:   summary,
+			Labels:    labels,
+		},
+	}, customFields)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, response.Code)
+
+	return issue.Key
+}
+
+func TestServer_Issues(t *testing.T) {
+
+	ctx := context.Background()
+	_, instance, _ := newClients(t)
+
+	project, _, err := instance.Project.Create(ctx, &model.ProjectPayloadScheme{Key: "KP", Name: "Kanban Project", ProjectTypeKey: "software"})
+	assert.NoError(t, err)
+	assert.Equal(t, "KP", project.Key)
+
+	_, _, err = instance.Project.Create(ctx, &model.ProjectPayloadScheme{Key: "KP", Name: "Duplicated"})
+	assert.Error(t, err)
+
+	customFields := new(model.CustomFields)
+	assert.NoError(t, customFields.Text("customfield_10010", "Custom value"))
+
+	key := createIssue(t, instance, "Fake issue", []string{"backend"}, customFields)
+	assert.Equal(t, "KP-1", key)
+
+	issue, _, err := instance.Issue.Get(ctx, key, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "Fake issue", issue.Fields.Summary)
+	assert.Equal(t, "To Do", issue.Fields.Status.Name)
+	assert.Equal(t, CurrentUser.AccountID, issue.Fields.Reporter.AccountID)
+
+	result, _, err := instance.Issue.Search.Post(ctx, `cf[10010] ~ "custom"`, nil, nil, 0, 50, "")
+	assert.NoError(t, err)
+	assert.Len(t, result.Issues, 1)
+
+	// Update the summary and add a label.
+	operations := new(model.UpdateOperations)
+	assert.NoError(t, operations.AddArrayOperation("labels", map[string]string{"frontend": "add", "backend": "remove"}))
+
+	_, err = instance.Issue.Update(ctx, key, false, &model.IssueScheme{Fields: &model.IssueFieldsScheme{Summary: "Updated issue"}}, nil, operations)
+	assert.NoError(t, err)
+
+	issue, _, err = instance.Issue.Get(ctx, key, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "Updated issue", issue.Fields.Summary)
+	assert.Equal(t, []string{"frontend"}, issue.Fields.Labels)
+
+	// Transition the issue to Done.
+	transitions, _, err := instance.Issue.Transitions(ctx, key)
+	assert.NoError(t, err)
+	assert.Len(t, transitions.Transitions, 3)
+
+	_, err = instance.Issue.Move(ctx, key, "31", nil)
+	assert.NoError(t, err)
+
+	issue, _, err = instance.Issue.Get(ctx, key, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "Done", issue.Fields.Status.Name)
+	assert.Equal(t, "Done", issue.Fields.Resolution.Name)
+
+	// Comment the issue.
+	comment, _, err := instance.Issue.Comment.Add(ctx, key, &model.CommentPayloadScheme{
+		Body: &model.CommentNodeScheme{Version: 1, Type: "doc"},
+	}, nil)
+	assert.NoError(t, err)
+
+	comments, _, err := instance.Issue.Comment.Gets(ctx, key, "", nil, 0, 50)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, comments.Total)
+
+	_, err = instance.Issue.Comment.Delete(ctx, key, comment.ID)
+	assert.NoError(t, err)
+
+	_, _, err = instance.Issue.Comment.Get(ctx, key, comment.ID)
+	assert.Error(t, err)
+
+	// Delete the issue.
+	_, err = instance.Issue.Delete(ctx, key, false)
+	assert.NoError(t, err)
+
+	_, response, err := instance.Issue.Get(ctx, key, nil, nil)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, response.Code)
+}
+
+func TestServer_Search(t *testing.T) {
+
+	ctx := context.Background()
+	_, instance, _ := newClients(t)
+
+	_, _, err := instance.Project.Create(ctx, &model.ProjectPayloadScheme{Key: "KP", Name: "Kanban Project"})
+	assert.NoError(t, err)
+
+	for _, summary := range []string{"First issue", "Second issue", "Third issue"} {
+		createIssue(t, instance, summary, []string{"fake"}, nil)
+	}
+
+	_, err = instance.Issue.Move(ctx, "KP-2", "21", nil)
+	assert.NoError(t, err)
+
+	_, err = instance.Issue.Assign(ctx, "KP-3", CurrentUser.AccountID)
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name    string
+		jql     string
+		want    []string
+		wantErr bool
+	}{
+		{"when the query is empty", "", []string{"KP-1", "KP-2", "KP-3"}, false},
+		{"when the query uses the project", "project = KP ORDER BY key DESC", []string{"KP-3", "KP-2", "KP-1"}, false},
+		{"when the query uses the status", `status = "In Progress"`, []string{"KP-2"}, false},
+		{"when the query uses a status category", "statusCategory != Done AND status != 'In Progress'", []string{"KP-1", "KP-3"}, false},
+		{"when the query uses a function", "assignee = currentUser()", []string{"KP-3"}, false},
+		{"when the query uses is empty", "assignee is EMPTY order by summary", []string{"KP-1", "KP-2"}, false},
+		{"when the query uses a text search", `summary ~ "sec*" OR key in (KP-1)`, []string{"KP-1", "KP-2"}, false},
+		{"when the query uses not", "labels = fake and not (key = KP-1 or key = KP-3)", []string{"KP-2"}, false},
+		{"when the field does not exist", "unknown = value", nil, true},
+		{"when the query is not valid", "project = KP AND", nil, true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			result, response, err := instance.Issue.Search.Post(ctx, testCase.jql, []string{"summary"}, nil, 0, 50, "")
+			if testCase.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, http.StatusBadRequest, response.Code)
+				return
+			}
+
+			assert.NoError(t, err)
+
+			var keys []string
+			for _, issue := range result.Issues {
+				keys = append(keys, issue.Key)
+			}
+
+			assert.Equal(t, testCase.want, keys)
+		})
+	}
+
+	// The token based pagination returns every issue.
+	var keys []string
+	for issue, err := range instance.Issue.Search.All(ctx, "project = KP", []string{"summary"}) {
+		assert.NoError(t, err)
+		keys = append(keys, issue.Key)
+	}
+
+	assert.Equal(t, []string{"KP-1", "KP-2", "KP-3"}, keys)
+
+	count, _, err := instance.Issue.Search.ApproximateCount(ctx, "status = 'To Do'")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count.Count)
+}
+
+func TestServer_Agile(t *testing.T) {
+
+	ctx := context.Background()
+	_, instance, agileInstance := newClients(t)
+
+	_, _, err := instance.Project.Create(ctx, &model.ProjectPayloadScheme{Key: "KP", Name: "Scrum Project"})
+	assert.NoError(t, err)
+
+	for _, summary := range []string{"First issue", "Second issue"} {
+		createIssue(t, instance, summary, nil, nil)
+	}
+
+	board, _, err := agileInstance.Board.Create(ctx, &model.BoardPayloadScheme{
+		Name:     "Scrum Board",
+		Type:     "scrum",
+		Location: &model.BoardPayloadLocationScheme{Type: "project", ProjectKeyOrID: "KP"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "KP", board.Location.ProjectKey)
+
+	boards, _, err := agileInstance.Board.Gets(ctx, &model.GetBoardsOptions{ProjectKeyOrID: "KP"}, 0, 50)
+	assert.NoError(t, err)
+	assert.Len(t, boards.Values, 1)
+
+	sprint, _, err := agileInstance.Sprint.Create(ctx, &model.SprintPayloadScheme{
+		Name:          "Sprint 1",
+		StartDate:     time.Now().Format(time.RFC3339),
+		EndDate:       time.Now().Add(14 * 24 * time.Hour).Format(time.RFC3339),
+		OriginBoardID: board.ID,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "future", sprint.State)
+
+	_, err = agileInstance.Sprint.Move(ctx, sprint.ID, &model.SprintMovePayloadScheme{Issues: []string{"KP-1"}})
+	assert.NoError(t, err)
+
+	_, err = agileInstance.Sprint.Start(ctx, sprint.ID)
+	assert.NoError(t, err)
+
+	sprints, _, err := agileInstance.Board.Sprints(ctx, board.ID, 0, 50, []string{"active"})
+	assert.NoError(t, err)
+	assert.Len(t, sprints.Values, 1)
+
+	issues, _, err := agileInstance.Sprint.Issues(ctx, sprint.ID, nil, 0, 50)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, issues.Total)
+	assert.Equal(t, "KP-1", issues.Issues[0].Key)
+
+	backlog, _, err := agileInstance.Board.Backlog(ctx, board.ID, nil, 0, 50)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, backlog.Total)
+	assert.Equal(t, "KP-2", backlog.Issues[0].Key)
+
+	result, _, err := instance.Issue.Search.Post(ctx, "sprint in openSprints()", nil, nil, 0, 50, "")
+	assert.NoError(t, err)
+	assert.Len(t, result.Issues, 1)
+
+	_, err = agileInstance.Sprint.Close(ctx, sprint.ID)
+	assert.NoError(t, err)
+
+	sprint, _, err = agileInstance.Sprint.Get(ctx, sprint.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "closed", sprint.State)
+	assert.False(t, sprint.CompleteDate.IsZero())
+
+	backlog, _, err = agileInstance.Board.Backlog(ctx, board.ID, nil, 0, 50)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, backlog.Total)
+}
@@ -0,0 +1,748 @@
+package jirafake
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// The fake supports a JQL subset:
+//
+//   - the AND, OR and NOT keywords, and the parentheses.
+//   - the =, !=, ~, !~, in, not in, is empty and is not empty operators.
+//   - the project, key, id, issuetype, status, statusCategory, assignee, reporter, labels, summary, text,
+//     priority, sprint and custom fields (cf[10010] or customfield_10010).
+//   - the currentUser(), openSprints(), closedSprints() and futureSprints() functions.
+//   - the ORDER BY clause using the key, id, created, updated, summary, status or priority fields.
+
+// predicate reports whether an issue matches a clause, the caller must hold the lock.
+type predicate func(s *Server, i *issue) bool
+
+// ordering is a field of the ORDER BY clause.
+type ordering struct {
+	field      string
+	descending bool
+}
+
+// query is a parsed JQL query.
+type query struct {
+	where predicate
+	order []ordering
+}
+
+// search returns the issues matching the query, sorted by the ORDER BY clause.
+// The caller must hold the lock.
+func (s *Server) search(jql string) ([]*issue, error) {
+
+	parsed, err := parseJQL(jql)
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []*issue
+	for _, stored := range s.issues {
+		if parsed.where == nil || parsed.where(s, stored) {
+			matched = append(matched, stored)
+		}
+	}
+
+	sort.SliceStable(matched, func(left, right int) bool {
+
+		for _, order := range parsed.order {
+
+			compared := compare(order.field, matched[left], matched[right])
+			if compared == 0 {
+				continue
+			}
+
+			if order.descending {
+				return compared > 0
+			}
+
+			return compared < 0
+		}
+
+		return false
+	})
+
+	return matched, nil
+}
+
+// compare compares two issues using an ORDER BY field.
+func compare(field string, left, right *issue) int {
+
+	switch field {
+	case "key", "issuekey", "id":
+		if cmp := strings.Compare(left.scheme.Fields.Project.Key, right.scheme.Fields.Project.Key); cmp != 0 {
+			return cmp
+		}
+
+		return left.number - right.number
+
+	case "created":
+		return timeOf(left.scheme.Fields.Created).Compare(timeOf(right.scheme.Fields.Created))
+
+	case "updated":
+		return timeOf(left.scheme.Fields.Updated).Compare(timeOf(right.scheme.Fields.Updated))
+
+	case "summary":
+		return strings.Compare(strings.ToLower(left.scheme.Fields.Summary), strings.ToLower(right.scheme.Fields.Summary))
+	}
+
+	return strings.Compare(strings.Join(values(nil, left, field), ","), strings.Join(values(nil, right, field), ","))
+}
+
+// timeOf returns the time of a date-time field, the zero time when it's not set.
+func timeOf(value *model.DateTimeScheme) time.Time {
+
+	if value == nil {
+		return time.Time{}
+	}
+
+	return time.Time(*value)
+}
+
+// plainText returns the text of an ADF document.
+func plainText(node *model.CommentNodeScheme) string {
+
+	if node == nil {
+		return ""
+	}
+
+	text := node.Text
+	for _, child := range node.Content {
+		if childText := plainText(child); childText != "" {
+			text = strings.TrimSpace(text + " " + childText)
+		}
+	}
+
+	return text
+}
+
+type tokenKind int
+
+const (
+	tokenWord tokenKind = iota
+	tokenString
+	tokenOperator
+	tokenEnd
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+}
+
+// tokenize splits a JQL query into words, quoted strings and operators.
+func tokenize(jql string) ([]token, error) {
+
+	var tokens []token
+	runes := []rune(jql)
+
+	for position := 0; position < len(runes); {
+
+		character := runes[position]
+
+		switch {
+		case unicode.IsSpace(character):
+			position++
+
+		case character == '"' || character == '\'':
+			end := position + 1
+			var value strings.Builder
+
+			for ; end < len(runes) && runes[end] != character; end++ {
+				if runes[end] == '\\' && end+1 < len(runes) {
+					end++
+				}
+
+				value.WriteRune(runes[end])
+			}
+
+			if end == len(runes) {
+				return nil, fmt.Errorf("the quoted string at position %v is not closed", position)
+			}
+
+			tokens = append(tokens, token{tokenString, value.String()})
+			position = end + 1
+
+		case strings.ContainsRune("(),=", character):
+			tokens = append(tokens, token{tokenOperator, string(character)})
+			position++
+
+		case character == '!' || character == '~':
+			if character == '!' && position+1 < len(runes) && (runes[position+1] == '=' || runes[position+1] == '~') {
+				tokens = append(tokens, token{tokenOperator, string(runes[position : position+2])})
+				position += 2
+				continue
+			}
+
+			if character == '~' {
+				tokens = append(tokens, token{tokenOperator, "~"})
+				position++
+				continue
+			}
+
+			return nil, fmt.Errorf("the character '%c' at position %v is not expected", character, position)
+
+		default:
+			end := position
+			for end < len(runes) && !unicode.IsSpace(runes[end]) && !strings.ContainsRune("(),=!~\"'", runes[end]) {
+				end++
+			}
+
+			tokens = append(tokens, token{tokenWord, string(runes[position:end])})
+			position = end
+		}
+	}
+
+	return append(tokens, token{kind: tokenEnd}), nil
+}
+
+// parser is a recursive descent parser of the JQL subset.
+type parser struct {
+	tokens   []token
+	position int
+}
+
+// parseJQL parses a JQL query, an empty query matches every issue.
+func parseJQL(jql string) (*query, error) {
+
+	tokens, err := tokenize(jql)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	parsed := new(query)
+
+	if !p.keyword("order") && p.peek().kind != tokenEnd {
+		if parsed.where, err = p.or(); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.keyword("order") {
+
+		p.next()
+		if !p.keyword("by") {
+			return nil, fmt.Errorf("expecting 'by' after 'order'")
+		}
+
+		p.next()
+
+		for {
+			field := p.next()
+			if field.kind != tokenWord && field.kind != tokenString {
+				return nil, fmt.Errorf("expecting a field name in the ORDER BY clause")
+			}
+
+			order := ordering{field: strings.ToLower(field.value)}
+
+			switch {
+			case p.keyword("asc"):
+				p.next()
+			case p.keyword("desc"):
+				order.descending = true
+				p.next()
+			}
+
+			parsed.order = append(parsed.order, order)
+
+			if !p.operator(",") {
+				break
+			}
+
+			p.next()
+		}
+	}
+
+	if p.peek().kind != tokenEnd {
+		return nil, fmt.Errorf("expecting either 'OR' or 'AND' but got '%v'", p.peek().value)
+	}
+
+	return parsed, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.position]
+}
+
+func (p *parser) next() token {
+
+	current := p.tokens[p.position]
+	if current.kind != tokenEnd {
+		p.position++
+	}
+
+	return current
+}
+
+// keyword reports whether the next token is the unquoted keyword, case-insensitively.
+func (p *parser) keyword(keyword string) bool {
+	current := p.peek()
+	return current.kind == tokenWord && strings.EqualFold(current.value, keyword)
+}
+
+// operator reports whether the next token is the operator.
+func (p *parser) operator(operator string) bool {
+	current := p.peek()
+	return current.kind == tokenOperator && current.value == operator
+}
+
+func (p *parser) or() (predicate, error) {
+
+	left, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.keyword("or") {
+
+		p.next()
+
+		right, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+
+		left = func(first, second predicate) predicate {
+			return func(s *Server, i *issue) bool { return first(s, i) || second(s, i) }
+		}(left, right)
+	}
+
+	return left, nil
+}
+
+func (p *parser) and() (predicate, error) {
+
+	left, err := p.not()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.keyword("and") {
+
+		p.next()
+
+		right, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+
+		left = func(first, second predicate) predicate {
+			return func(s *Server, i *issue) bool { return first(s, i) && second(s, i) }
+		}(left, right)
+	}
+
+	return left, nil
+}
+
+func (p *parser) not() (predicate, error) {
+
+	if p.keyword("not") {
+
+		p.next()
+
+		negated, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+
+		return func(s *Server, i *issue) bool { return !negated(s, i) }, nil
+	}
+
+	if p.operator("(") {
+
+		p.next()
+
+		grouped, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+
+		if !p.operator(")") {
+			return nil, fmt.Errorf("expecting ')' but got '%v'", p.peek().value)
+		}
+
+		p.next()
+		return grouped, nil
+	}
+
+	return p.clause()
+}
+
+// clause parses a "field operator value" clause.
+func (p *parser) clause() (predicate, error) {
+
+	name := p.next()
+	if name.kind != tokenWord && name.kind != tokenString {
+		return nil, fmt.Errorf("expecting a field name but got '%v'", name.value)
+	}
+
+	field, err := fieldOf(name.value)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case p.keyword("is"):
+
+		p.next()
+
+		negated := p.keyword("not")
+		if negated {
+			p.next()
+		}
+
+		if !p.keyword("empty") && !p.keyword("null") {
+			return nil, fmt.Errorf("expecting 'EMPTY' or 'NULL' after 'is' but got '%v'", p.peek().value)
+		}
+
+		p.next()
+
+		return func(s *Server, i *issue) bool {
+			return (len(values(s, i, field)) == 0) != negated
+		}, nil
+
+	case p.keyword("in"), p.keyword("not"):
+
+		negated := p.keyword("not")
+		p.next()
+
+		if negated {
+			if !p.keyword("in") {
+				return nil, fmt.Errorf("expecting 'in' after 'not' but got '%v'", p.peek().value)
+			}
+
+			p.next()
+		}
+
+		operands, err := p.list()
+		if err != nil {
+			return nil, err
+		}
+
+		return equality(field, operands, negated), nil
+
+	case p.peek().kind == tokenOperator:
+
+		operator := p.next().value
+
+		value, err := p.operand()
+		if err != nil {
+			return nil, err
+		}
+
+		switch operator {
+		case "=":
+			return equality(field, []operand{value}, false), nil
+		case "!=":
+			return equality(field, []operand{value}, true), nil
+		case "~":
+			return contains(field, value, false), nil
+		case "!~":
+			return contains(field, value, true), nil
+		}
+
+		return nil, fmt.Errorf("the operator '%v' is not supported", operator)
+	}
+
+	return nil, fmt.Errorf("expecting an operator after the field '%v' but got '%v'", name.value, p.peek().value)
+}
+
+// operand is a literal value or a function call resolved when the query is evaluated.
+type operand func(s *Server) []string
+
+// operand parses a value or a function call.
+func (p *parser) operand() (operand, error) {
+
+	value := p.next()
+
+	switch value.kind {
+	case tokenString:
+		return func(*Server) []string { return []string{value.value} }, nil
+
+	case tokenWord:
+		if !p.operator("(") {
+			return func(*Server) []string { return []string{value.value} }, nil
+		}
+
+		p.next()
+		if !p.operator(")") {
+			return nil, fmt.Errorf("the arguments of the function '%v' are not supported", value.value)
+		}
+
+		p.next()
+		return function(value.value)
+	}
+
+	return nil, fmt.Errorf("expecting a value but got '%v'", value.value)
+}
+
+// list parses a parenthesized list of values.
+func (p *parser) list() ([]operand, error) {
+
+	if !p.operator("(") {
+		value, err := p.operand()
+		return []operand{value}, err
+	}
+
+	p.next()
+
+	var operands []operand
+	for {
+		value, err := p.operand()
+		if err != nil {
+			return nil, err
+		}
+
+		operands = append(operands, value)
+
+		if p.operator(")") {
+			p.next()
+			return operands, nil
+		}
+
+		if !p.operator(",") {
+			return nil, fmt.Errorf("expecting ',' or ')' but got '%v'", p.peek().value)
+		}
+
+		p.next()
+	}
+}
+
+// function returns the operand of a JQL function.
+func function(name string) (operand, error) {
+
+	switch strings.ToLower(name) {
+	case "currentuser":
+		return func(*Server) []string { return []string{CurrentUser.AccountID} }, nil
+
+	case "opensprints", "closedsprints", "futuresprints":
+		state := map[string]string{"opensprints": "active", "closedsprints": "closed", "futuresprints": "future"}[strings.ToLower(name)]
+
+		return func(s *Server) []string {
+
+			var ids []string
+			for _, sprint := range s.sprints {
+				if sprint.State == state {
+					ids = append(ids, strconv.Itoa(sprint.ID))
+				}
+			}
+
+			return ids
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unable to find JQL function '%v()'", name)
+}
+
+// equality matches the issues having a field value equal to one of the operands.
+// The negated form doesn't match the issues without value, as Jira does.
+func equality(field string, operands []operand, negated bool) predicate {
+
+	return func(s *Server, i *issue) bool {
+
+		fieldValues := values(s, i, field)
+		if negated && len(fieldValues) == 0 {
+			return false
+		}
+
+		for _, operand := range operands {
+			for _, expected := range operand(s) {
+				for _, value := range fieldValues {
+					if strings.EqualFold(value, expected) {
+						return !negated
+					}
+				}
+			}
+		}
+
+		return negated
+	}
+}
+
+// contains matches the issues having a field value containing the operand, case-insensitively.
+// The trailing wildcard of the operand is ignored.
+func contains(field string, value operand, negated bool) predicate {
+
+	return func(s *Server, i *issue) bool {
+
+		for _, expected := range value(s) {
+
+			expected = strings.ToLower(strings.TrimSuffix(expected, "*"))
+			for _, candidate := range values(s, i, field) {
+				if strings.Contains(strings.ToLower(candidate), expected) {
+					return !negated
+				}
+			}
+		}
+
+		return negated
+	}
+}
+
+// fieldOf returns the canonical name of a JQL field.
+func fieldOf(name string) (string, error) {
+
+	lowered := strings.ToLower(name)
+
+	switch lowered {
+	case "project", "key", "id", "issuetype", "status", "statuscategory", "assignee", "reporter", "creator",
+		"labels", "summary", "description", "text", "priority", "sprint", "resolution":
+		return lowered, nil
+	case "issuekey":
+		return "key", nil
+	case "issue":
+		return "id", nil
+	case "type":
+		return "issuetype", nil
+	case "label":
+		return "labels", nil
+	}
+
+	if strings.HasPrefix(lowered, "cf[") && strings.HasSuffix(lowered, "]") {
+		return "customfield_" + lowered[3:len(lowered)-1], nil
+	}
+
+	if strings.HasPrefix(lowered, "customfield_") {
+		return lowered, nil
+	}
+
+	return "", fmt.Errorf("field '%v' does not exist or you do not have permission to view it", name)
+}
+
+// values returns the values of an issue field compared by the JQL operators,
+// e.g. both the name and the ID of the status. The server is only required by the sprint field.
+func values(s *Server, i *issue, field string) []string {
+
+	fields := i.scheme.Fields
+
+	switch field {
+	case "project":
+		if fields.Project != nil {
+			return []string{fields.Project.Key, fields.Project.ID, fields.Project.Name}
+		}
+
+	case "key":
+		return []string{i.scheme.Key}
+
+	case "id":
+		return []string{i.scheme.ID, i.scheme.Key}
+
+	case "issuetype":
+		if fields.IssueType != nil {
+			return []string{fields.IssueType.Name, fields.IssueType.ID}
+		}
+
+	case "status":
+		if fields.Status != nil {
+			return []string{fields.Status.Name, fields.Status.ID}
+		}
+
+	case "statuscategory":
+		if fields.Status != nil && fields.Status.StatusCategory != nil {
+			return []string{fields.Status.StatusCategory.Name, fields.Status.StatusCategory.Key, strconv.Itoa(fields.Status.StatusCategory.ID)}
+		}
+
+	case "assignee":
+		if fields.Assignee != nil {
+			return []string{fields.Assignee.AccountID}
+		}
+
+	case "reporter":
+		if fields.Reporter != nil {
+			return []string{fields.Reporter.AccountID}
+		}
+
+	case "creator":
+		if fields.Creator != nil {
+			return []string{fields.Creator.AccountID}
+		}
+
+	case "labels":
+		return fields.Labels
+
+	case "summary":
+		if fields.Summary != "" {
+			return []string{fields.Summary}
+		}
+
+	case "description":
+		if text := plainText(fields.Description); text != "" {
+			return []string{text}
+		}
+
+	case "text":
+		return append(values(s, i, "summary"), values(s, i, "description")...)
+
+	case "priority":
+		if fields.Priority != nil {
+			return []string{fields.Priority.Name, fields.Priority.ID}
+		}
+
+	case "resolution":
+		if fields.Resolution != nil {
+			return []string{fields.Resolution.Name, fields.Resolution.ID}
+		}
+
+	case "sprint":
+		if s == nil || i.sprintID == 0 {
+			return nil
+		}
+
+		if sprint := s.findSprint(i.sprintID); sprint != nil {
+			return []string{strconv.Itoa(sprint.ID), sprint.Name}
+		}
+
+	default:
+		return flatten(i.custom[field])
+	}
+
+	return nil
+}
+
+// flatten returns the comparable values of a custom field value,
+// the options, users and objects are compared using their value, name, key, ID or account ID.
+func flatten(value interface{}) []string {
+
+	switch typed := value.(type) {
+	case nil:
+		return nil
+
+	case string:
+		return []string{typed}
+
+	case float64:
+		return []string{strconv.FormatFloat(typed, 'f', -1, 64)}
+
+	case bool:
+		return []string{strconv.FormatBool(typed)}
+
+	case []interface{}:
+		var flattened []string
+		for _, item := range typed {
+			flattened = append(flattened, flatten(item)...)
+		}
+
+		return flattened
+
+	case map[string]interface{}:
+		var flattened []string
+		for _, key := range []string{"value", "name", "key", "id", "accountId"} {
+			if item, ok := typed[key]; ok {
+				flattened = append(flattened, flatten(item)...)
+			}
+		}
+
+		return flattened
+	}
+
+	return []string{fmt.Sprint(value)}
+}
@@ -0,0 +1,234 @@
+package jirafake
+
+import (
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+var projectKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]{1,9}$`)
+
+// IssueTypes are the issue types available in every project.
+var IssueTypes = []*model.IssueTypeScheme{
+	{ID: "10001", Name: "Task"},
+	{ID: "10002", Name: "Bug"},
+	{ID: "10003", Name: "Story"},
+	{ID: "10004", Name: "Epic"},
+	{ID: "10005", Name: "Sub-task", Subtask: true},
+}
+
+// findProject returns the project with the ID or key, the caller must hold the lock.
+func (s *Server) findProject(id, key string) *model.ProjectScheme {
+
+	for _, project := range s.projects {
+		if (id != "" && project.ID == id) || (key != "" && strings.EqualFold(project.Key, key)) {
+			return project
+		}
+	}
+
+	return nil
+}
+
+// lookupProject returns the project of the request path, or writes a not found error.
+// The caller must hold the lock.
+func (s *Server) lookupProject(w http.ResponseWriter, r *http.Request) *model.ProjectScheme {
+
+	projectIDOrKey := r.PathValue("projectIdOrKey")
+
+	project := s.findProject(projectIDOrKey, projectIDOrKey)
+	if project == nil {
+		writeError(w, http.StatusNotFound, "No project could be found with key '"+projectIDOrKey+"'.")
+	}
+
+	return project
+}
+
+func (s *Server) createProject(w http.ResponseWriter, r *http.Request) {
+
+	payload := new(model.ProjectPayloadScheme)
+	if !decode(w, r, payload) {
+		return
+	}
+
+	if !projectKeyPattern.MatchString(payload.Key) {
+		writeFieldError(w, "projectKey", "Project keys must start with an uppercase letter, followed by one or more uppercase alphanumeric characters.")
+		return
+	}
+
+	if payload.Name == "" {
+		writeFieldError(w, "projectName", "You must specify a valid project name.")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.findProject("", payload.Key) != nil {
+		writeFieldError(w, "projectKey", "Project '"+payload.Key+"' uses this project key.")
+		return
+	}
+
+	id := s.id()
+
+	project := &model.ProjectScheme{
+		Self:           s.URL + "/rest/api/3/project/" + strconv.Itoa(id),
+		ID:             strconv.Itoa(id),
+		Key:            payload.Key,
+		Name:           payload.Name,
+		Description:    payload.Description,
+		URL:            payload.URL,
+		AssigneeType:   payload.AssigneeType,
+		ProjectTypeKey: payload.ProjectTypeKey,
+		Style:          "classic",
+		Lead:           &model.UserScheme{AccountID: payload.LeadAccountID},
+		IssueTypes:     IssueTypes,
+	}
+
+	if payload.LeadAccountID == "" || payload.LeadAccountID == CurrentUser.AccountID {
+		project.Lead = CurrentUser
+	}
+
+	s.projects = append(s.projects, project)
+
+	writeJSON(w, http.StatusCreated, &model.NewProjectCreatedScheme{Self: project.Self, ID: id, Key: project.Key})
+}
+
+func (s *Server) searchProjects(w http.ResponseWriter, r *http.Request) {
+
+	params := r.URL.Query()
+	startAt, maxResults := page(r)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var matched []*model.ProjectScheme
+	for _, project := range s.projects {
+
+		if text := strings.ToLower(params.Get("query")); text != "" &&
+			!strings.Contains(strings.ToLower(project.Key), text) && !strings.Contains(strings.ToLower(project.Name), text) {
+			continue
+		}
+
+		if keys := params["keys"]; len(keys) > 0 && !containsFold(keys, project.Key) {
+			continue
+		}
+
+		if ids := params["id"]; len(ids) > 0 && !containsFold(ids, project.ID) {
+			continue
+		}
+
+		if types := params.Get("typeKey"); types != "" && !containsFold(strings.Split(types, ","), project.ProjectTypeKey) {
+			continue
+		}
+
+		matched = append(matched, project)
+	}
+
+	from, to := window(len(matched), startAt, maxResults)
+
+	writeJSON(w, http.StatusOK, &model.ProjectSearchScheme{
+		Self:       s.URL + r.URL.String(),
+		StartAt:    startAt,
+		MaxResults: maxResults,
+		Total:      len(matched),
+		IsLast:     to == len(matched),
+		Values:     matched[from:to],
+	})
+}
+
+func (s *Server) getProject(w http.ResponseWriter, r *http.Request) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if project := s.lookupProject(w, r); project != nil {
+		writeJSON(w, http.StatusOK, project)
+	}
+}
+
+func (s *Server) updateProject(w http.ResponseWriter, r *http.Request) {
+
+	payload := new(model.ProjectUpdateScheme)
+	if !decode(w, r, payload) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	project := s.lookupProject(w, r)
+	if project == nil {
+		return
+	}
+
+	if payload.Key != "" && !strings.EqualFold(payload.Key, project.Key) {
+		writeFieldError(w, "projectKey", "The project key can't be changed by the fake.")
+		return
+	}
+
+	if payload.Name != "" {
+		project.Name = payload.Name
+	}
+
+	if payload.Description != "" {
+		project.Description = payload.Description
+	}
+
+	if payload.URL != "" {
+		project.URL = payload.URL
+	}
+
+	if payload.AssigneeType != "" {
+		project.AssigneeType = payload.AssigneeType
+	}
+
+	if payload.LeadAccountID != "" {
+		project.Lead = &model.UserScheme{AccountID: payload.LeadAccountID}
+	}
+
+	writeJSON(w, http.StatusOK, project)
+}
+
+// deleteProject deletes the project and its issues.
+func (s *Server) deleteProject(w http.ResponseWriter, r *http.Request) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	project := s.lookupProject(w, r)
+	if project == nil {
+		return
+	}
+
+	var projects []*model.ProjectScheme
+	for _, candidate := range s.projects {
+		if candidate != project {
+			projects = append(projects, candidate)
+		}
+	}
+
+	var issues []*issue
+	for _, stored := range s.issues {
+		if stored.scheme.Fields.Project.ID != project.ID {
+			issues = append(issues, stored)
+		}
+	}
+
+	s.projects, s.issues = projects, issues
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// containsFold reports whether the values contain the value, case-insensitively.
+func containsFold(values []string, value string) bool {
+
+	for _, candidate := range values {
+		if strings.EqualFold(candidate, value) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,167 @@
+package jirafake
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// searchPage is a page of issues returned by the search endpoints, the issues include their custom fields.
+type searchPage struct {
+	StartAt       int                      `json:"startAt"`
+	MaxResults    int                      `json:"maxResults"`
+	Total         int                      `json:"total"`
+	Issues        []map[string]interface{} `json:"issues"`
+	NextPageToken string                   `json:"nextPageToken,omitempty"`
+}
+
+// searchPayload is the payload of the search endpoints.
+type searchPayload struct {
+	JQL           string   `json:"jql"`
+	StartAt       int      `json:"startAt"`
+	MaxResults    int      `json:"maxResults"`
+	Fields        []string `json:"fields"`
+	NextPageToken string   `json:"nextPageToken"`
+}
+
+func (s *Server) searchGet(w http.ResponseWriter, r *http.Request) {
+
+	startAt, maxResults := page(r)
+
+	var fields []string
+	if value := r.URL.Query().Get("fields"); value != "" {
+		fields = strings.Split(value, ",")
+	}
+
+	s.writeSearch(w, r.URL.Query().Get("jql"), startAt, maxResults, fields, false)
+}
+
+func (s *Server) searchPost(w http.ResponseWriter, r *http.Request) {
+
+	payload := new(searchPayload)
+	if !decode(w, r, payload) {
+		return
+	}
+
+	startAt, maxResults := bounds(payload.StartAt, payload.MaxResults)
+	s.writeSearch(w, payload.JQL, startAt, maxResults, payload.Fields, false)
+}
+
+// searchJQL searches the issues using the token based pagination, the tokens are the offsets of the pages.
+func (s *Server) searchJQL(w http.ResponseWriter, r *http.Request) {
+
+	payload := new(searchPayload)
+	if !decode(w, r, payload) {
+		return
+	}
+
+	var startAt int
+	if payload.NextPageToken != "" {
+
+		offset, err := strconv.Atoi(payload.NextPageToken)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "The nextPageToken is not valid.")
+			return
+		}
+
+		startAt = offset
+	}
+
+	startAt, maxResults := bounds(startAt, payload.MaxResults)
+	s.writeSearch(w, payload.JQL, startAt, maxResults, payload.Fields, true)
+}
+
+func (s *Server) approximateCount(w http.ResponseWriter, r *http.Request) {
+
+	payload := new(searchPayload)
+	if !decode(w, r, payload) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	matched, err := s.search(payload.JQL)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Error in the JQL Query: "+err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]int{"count": len(matched)})
+}
+
+// writeSearch writes a page of the issues matching the query, limited to the requested fields.
+// The token based pages set the next page token instead of the total.
+func (s *Server) writeSearch(w http.ResponseWriter, jql string, startAt, maxResults int, fields []string, tokens bool) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	matched, err := s.search(jql)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Error in the JQL Query: "+err.Error())
+		return
+	}
+
+	from, to := window(len(matched), startAt, maxResults)
+
+	result := &searchPage{StartAt: startAt, MaxResults: maxResults, Total: len(matched), Issues: []map[string]interface{}{}}
+	if tokens {
+		result.Total = 0
+		if to < len(matched) {
+			result.NextPageToken = strconv.Itoa(to)
+		}
+	}
+
+	for _, stored := range matched[from:to] {
+
+		rendered, err := stored.render()
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		if rendered["fields"] != nil {
+			rendered["fields"] = selectFields(rendered["fields"].(map[string]interface{}), fields)
+		}
+
+		result.Issues = append(result.Issues, rendered)
+	}
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+// selectFields returns the requested fields, every field is returned when none is requested
+// or when *all or *navigable is requested. The fields prefixed by a minus sign are excluded.
+func selectFields(fields map[string]interface{}, requested []string) map[string]interface{} {
+
+	all := len(requested) == 0
+	included, excluded := make(map[string]bool), make(map[string]bool)
+
+	for _, name := range requested {
+
+		name = strings.TrimSpace(name)
+
+		switch {
+		case name == "*all" || name == "*navigable":
+			all = true
+		case strings.HasPrefix(name, "-"):
+			excluded[name[1:]] = true
+		default:
+			included[name] = true
+		}
+	}
+
+	if len(included) == 0 && len(excluded) > 0 {
+		all = true
+	}
+
+	selected := make(map[string]interface{})
+	for name, value := range fields {
+		if (all || included[name]) && !excluded[name] {
+			selected[name] = value
+		}
+	}
+
+	return selected
+}
@@ -0,0 +1,188 @@
+// Package jirafake provides an in-memory, stateful fake of the Jira Cloud REST APIs,
+// so the code written against the jira/v3 and jira/agile clients can be tested end to end without a cloud site.
+//
+// The fake implements the core /rest/api/3 and /rest/agile/1.0 endpoints: the issues, their transitions
+// and comments, the search using a JQL subset, the projects, the boards and the sprints.
+// The payloads are the models structs used by the clients.
+//
+//	server := jirafake.New()
+//	defer server.Close()
+//
+//	instance, err := v3.New(server.Client(), server.URL)
+package jirafake
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// CurrentUser is the user authenticated on the fake, the author of the comments and the reporter of the issues.
+var CurrentUser = &model.UserScheme{
+	AccountID:    "5b10ac8d82e05b22cc7d4ef5",
+	AccountType:  "atlassian",
+	DisplayName:  "Fake User",
+	EmailAddress: "fake.user@example.com",
+	Active:       true,
+}
+
+// Server is the fake Jira site.
+type Server struct {
+	*httptest.Server
+
+	mu sync.Mutex
+
+	projects []*model.ProjectScheme
+	issues   []*issue
+	boards   []*model.BoardScheme
+	sprints  []*model.SprintScheme
+
+	nextID   int
+	nextKeys map[string]int
+
+	now func() time.Time
+}
+
+// New starts a fake Jira site, it must be closed once the test is done.
+func New() *Server {
+
+	s := &Server{
+		nextID:   10000,
+		nextKeys: make(map[string]int),
+		now:      time.Now,
+	}
+
+	mux := http.NewServeMux()
+	s.routes(mux)
+	s.Server = httptest.NewServer(mux)
+
+	return s
+}
+
+func (s *Server) routes(mux *http.ServeMux) {
+
+	mux.HandleFunc("GET /rest/api/3/myself", s.myself)
+
+	mux.HandleFunc("POST /rest/api/3/issue", s.createIssue)
+	mux.HandleFunc("GET /rest/api/3/issue/{issueIdOrKey}", s.getIssue)
+	mux.HandleFunc("PUT /rest/api/3/issue/{issueIdOrKey}", s.updateIssue)
+	mux.HandleFunc("DELETE /rest/api/3/issue/{issueIdOrKey}", s.deleteIssue)
+	mux.HandleFunc("PUT /rest/api/3/issue/{issueIdOrKey}/assignee", s.assignIssue)
+	mux.HandleFunc("GET /rest/api/3/issue/{issueIdOrKey}/transitions", s.getTransitions)
+	mux.HandleFunc("POST /rest/api/3/issue/{issueIdOrKey}/transitions", s.doTransition)
+
+	mux.HandleFunc("GET /rest/api/3/issue/{issueIdOrKey}/comment", s.getComments)
+	mux.HandleFunc("POST /rest/api/3/issue/{issueIdOrKey}/comment", s.addComment)
+	mux.HandleFunc("GET /rest/api/3/issue/{issueIdOrKey}/comment/{commentId}", s.getComment)
+	mux.HandleFunc("PUT /rest/api/3/issue/{issueIdOrKey}/comment/{commentId}", s.updateComment)
+	mux.HandleFunc("DELETE /rest/api/3/issue/{issueIdOrKey}/comment/{commentId}", s.deleteComment)
+
+	mux.HandleFunc("GET /rest/api/3/search", s.searchGet)
+	mux.HandleFunc("POST /rest/api/3/search", s.searchPost)
+	mux.HandleFunc("POST /rest/api/3/search/jql", s.searchJQL)
+	mux.HandleFunc("POST /rest/api/3/search/approximate-count", s.approximateCount)
+
+	mux.HandleFunc("POST /rest/api/3/project", s.createProject)
+	mux.HandleFunc("GET /rest/api/3/project/search", s.searchProjects)
+	mux.HandleFunc("GET /rest/api/3/project/{projectIdOrKey}", s.getProject)
+	mux.HandleFunc("PUT /rest/api/3/project/{projectIdOrKey}", s.updateProject)
+	mux.HandleFunc("DELETE /rest/api/3/project/{projectIdOrKey}", s.deleteProject)
+
+	mux.HandleFunc("GET /rest/agile/1.0/board", s.getBoards)
+	mux.HandleFunc("POST /rest/agile/1.0/board", s.createBoard)
+	mux.HandleFunc("GET /rest/agile/1.0/board/{boardId}", s.getBoard)
+	mux.HandleFunc("DELETE /rest/agile/1.0/board/{boardId}", s.deleteBoard)
+	mux.HandleFunc("GET /rest/agile/1.0/board/{boardId}/issue", s.getBoardIssues)
+	mux.HandleFunc("GET /rest/agile/1.0/board/{boardId}/backlog", s.getBoardBacklog)
+	mux.HandleFunc("GET /rest/agile/1.0/board/{boardId}/sprint", s.getBoardSprints)
+	mux.HandleFunc("GET /rest/agile/1.0/board/{boardId}/sprint/{sprintId}/issue", s.getBoardSprintIssues)
+
+	mux.HandleFunc("POST /rest/agile/1.0/sprint", s.createSprint)
+	mux.HandleFunc("GET /rest/agile/1.0/sprint/{sprintId}", s.getSprint)
+	mux.HandleFunc("PUT /rest/agile/1.0/sprint/{sprintId}", s.updateSprint)
+	mux.HandleFunc("POST /rest/agile/1.0/sprint/{sprintId}", s.partiallyUpdateSprint)
+	mux.HandleFunc("DELETE /rest/agile/1.0/sprint/{sprintId}", s.deleteSprint)
+	mux.HandleFunc("GET /rest/agile/1.0/sprint/{sprintId}/issue", s.getSprintIssues)
+	mux.HandleFunc("POST /rest/agile/1.0/sprint/{sprintId}/issue", s.moveIssuesToSprint)
+	mux.HandleFunc("POST /rest/agile/1.0/backlog/issue", s.moveIssuesToBacklog)
+}
+
+func (s *Server) myself(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, CurrentUser)
+}
+
+// id returns a new numeric ID, the caller must hold the lock.
+func (s *Server) id() int {
+	s.nextID++
+	return s.nextID
+}
+
+// page returns the startAt and maxResults query parameters, maxResults defaults to 50.
+func page(r *http.Request) (startAt, maxResults int) {
+
+	startAt, _ = strconv.Atoi(r.URL.Query().Get("startAt"))
+	maxResults, _ = strconv.Atoi(r.URL.Query().Get("maxResults"))
+
+	return bounds(startAt, maxResults)
+}
+
+// bounds normalizes the pagination parameters.
+func bounds(startAt, maxResults int) (int, int) {
+
+	if startAt < 0 {
+		startAt = 0
+	}
+
+	if maxResults <= 0 {
+		maxResults = 50
+	}
+
+	return startAt, maxResults
+}
+
+// window returns the slice bounds of a page.
+func window(total, startAt, maxResults int) (int, int) {
+	from := min(startAt, total)
+	return from, min(from+maxResults, total)
+}
+
+func writeJSON(w http.ResponseWriter, status int, payload interface{}) {
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if payload != nil {
+		_ = json.NewEncoder(w).Encode(payload)
+	}
+}
+
+// writeError writes an error using the Jira error collection format.
+func writeError(w http.ResponseWriter, status int, messages ...string) {
+	writeJSON(w, status, map[string]interface{}{
+		"errorMessages": messages,
+		"errors":        map[string]string{},
+	})
+}
+
+// writeFieldError writes a field error using the Jira error collection format.
+func writeFieldError(w http.ResponseWriter, field, message string) {
+	writeJSON(w, http.StatusBadRequest, map[string]interface{}{
+		"errorMessages": []string{},
+		"errors":        map[string]string{field: message},
+	})
+}
+
+func decode(w http.ResponseWriter, r *http.Request, payload interface{}) bool {
+
+	if err := json.NewDecoder(r.Body).Decode(payload); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request payload: "+err.Error())
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,213 @@
+// Package paginate provides iterators over the paginated Atlassian endpoints.
+//
+// The Atlassian APIs use three pagination styles:
+//   - offset: the startAt/maxResults parameters, e.g. Jira Project.Search or Agile Board.Issues.
+//   - cursor: an opaque cursor found on the next link, e.g. Confluence v2 Page.Gets.
+//   - token: the nextPageToken attribute, e.g. Jira Issue.Search.SearchJQL.
+//
+// Every helper returns an iter.Seq2 yielding the items one by one and fetching the pages lazily.
+// The iteration stops on the first error, which is yielded once, or when the context is done.
+package paginate
+
+import (
+	"context"
+	"iter"
+	"net/url"
+)
+
+// DefaultPageSize is the number of items requested per page when no page size is configured.
+const DefaultPageSize = 50
+
+// Page is a page of items returned by a paginated endpoint.
+type Page[T any] struct {
+	Items  []T    // The items of the page.
+	Total  int    // The total number of items, zero when the endpoint doesn't provide it.
+	IsLast bool   // Whether the endpoint reported this page as the last one.
+	Next   string // The cursor or token of the next page, used by the cursor and token pagination.
+}
+
+// Progress describes the state of an iteration after a page has been fetched.
+type Progress struct {
+	Page    int // The number of pages fetched so far, starting at 1.
+	Fetched int // The number of items fetched so far.
+	Total   int // The total number of items, zero when the endpoint doesn't provide it.
+}
+
+// Option configures an iteration.
+type Option func(*config)
+
+type config struct {
+	pageSize int
+	progress func(Progress)
+}
+
+// WithPageSize sets the number of items requested per page.
+func WithPageSize(size int) Option {
+	return func(c *config) {
+		if size > 0 {
+			c.pageSize = size
+		}
+	}
+}
+
+// WithProgress registers a callback invoked after every page is fetched.
+func WithProgress(callback func(Progress)) Option {
+	return func(c *config) {
+		c.progress = callback
+	}
+}
+
+func newConfig(options []Option) *config {
+
+	c := &config{pageSize: DefaultPageSize}
+	for _, option := range options {
+		option(c)
+	}
+
+	return c
+}
+
+// OffsetFetcher fetches the page starting at the startAt index.
+type OffsetFetcher[T any] func(ctx context.Context, startAt, maxResults int) (*Page[T], error)
+
+// CursorFetcher fetches the page identified by the cursor, an empty cursor is the first page.
+type CursorFetcher[T any] func(ctx context.Context, cursor string, limit int) (*Page[T], error)
+
+// Offset iterates over an endpoint paginated with the startAt/maxResults parameters.
+//
+// The iteration ends when a page is empty, is reported as the last one, or when the total is reached.
+func Offset[T any](ctx context.Context, fetch OffsetFetcher[T], options ...Option) iter.Seq2[T, error] {
+
+	c := newConfig(options)
+
+	return func(yield func(T, error) bool) {
+
+		progress := Progress{}
+
+		for startAt := 0; ; {
+
+			page, ok := fetchPage(ctx, yield, func() (*Page[T], error) {
+				return fetch(ctx, startAt, c.pageSize)
+			})
+			if !ok {
+				return
+			}
+
+			if !emit(ctx, c, &progress, page, yield) {
+				return
+			}
+
+			startAt += len(page.Items)
+
+			if len(page.Items) == 0 || page.IsLast || (page.Total > 0 && startAt >= page.Total) {
+				return
+			}
+		}
+	}
+}
+
+// Cursor iterates over an endpoint paginated with an opaque cursor.
+//
+// The iteration ends when a page has no next cursor, or when the next cursor doesn't change.
+func Cursor[T any](ctx context.Context, fetch CursorFetcher[T], options ...Option) iter.Seq2[T, error] {
+
+	c := newConfig(options)
+
+	return func(yield func(T, error) bool) {
+
+		progress := Progress{}
+
+		for cursor := ""; ; {
+
+			page, ok := fetchPage(ctx, yield, func() (*Page[T], error) {
+				return fetch(ctx, cursor, c.pageSize)
+			})
+			if !ok {
+				return
+			}
+
+			if !emit(ctx, c, &progress, page, yield) {
+				return
+			}
+
+			if page.Next == "" || page.Next == cursor || page.IsLast {
+				return
+			}
+
+			cursor = page.Next
+		}
+	}
+}
+
+// Token iterates over an endpoint paginated with the nextPageToken attribute.
+// The token pagination behaves like the cursor pagination, the token is the cursor.
+func Token[T any](ctx context.Context, fetch CursorFetcher[T], options ...Option) iter.Seq2[T, error] {
+	return Cursor(ctx, fetch, options...)
+}
+
+// CursorFromLink extracts the cursor query parameter from a next link,
+// like the _links.next attribute returned by the Confluence v2 endpoints.
+func CursorFromLink(link string) string {
+
+	if link == "" {
+		return ""
+	}
+
+	u, err := url.Parse(link)
+	if err != nil {
+		return ""
+	}
+
+	return u.Query().Get("cursor")
+}
+
+// fetchPage fetches a page, yielding the context or the fetch error when it fails.
+func fetchPage[T any](ctx context.Context, yield func(T, error) bool, fetch func() (*Page[T], error)) (*Page[T], bool) {
+
+	var zero T
+
+	if err := ctx.Err(); err != nil {
+		yield(zero, err)
+		return nil, false
+	}
+
+	page, err := fetch()
+	if err != nil {
+		yield(zero, err)
+		return nil, false
+	}
+
+	if page == nil {
+		return &Page[T]{}, true
+	}
+
+	return page, true
+}
+
+// emit reports the progress and yields the items of the page.
+// It returns false when the iteration must stop.
+func emit[T any](ctx context.Context, c *config, progress *Progress, page *Page[T], yield func(T, error) bool) bool {
+
+	progress.Page++
+	progress.Fetched += len(page.Items)
+	progress.Total = page.Total
+
+	if c.progress != nil {
+		c.progress(*progress)
+	}
+
+	for _, item := range page.Items {
+
+		if err := ctx.Err(); err != nil {
+			var zero T
+			yield(zero, err)
+			return false
+		}
+
+		if !yield(item, nil) {
+			return false
+		}
+	}
+
+	return true
+}
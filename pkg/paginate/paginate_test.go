@@ -0,0 +1,214 @@
+package paginate
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func collect[T any](t *testing.T, seq func(func(T, error) bool)) ([]T, error) {
+	t.Helper()
+
+	var items []T
+	for item, err := range seq {
+		if err != nil {
+			return items, err
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func numbers(from, to int) []int {
+	var items []int
+	for i := from; i < to; i++ {
+		items = append(items, i)
+	}
+	return items
+}
+
+func TestOffset(t *testing.T) {
+
+	errFetch := errors.New("fetch failed")
+
+	testCases := []struct {
+		name       string
+		fetch      OffsetFetcher[int]
+		options    []Option
+		want       []int
+		wantErr    error
+		wantCalls  int
+		wantReport []Progress
+	}{
+		{
+			name: "when the total is reached",
+			fetch: func(ctx context.Context, startAt, maxResults int) (*Page[int], error) {
+				return &Page[int]{Items: numbers(startAt, min(startAt+maxResults, 5)), Total: 5}, nil
+			},
+			options:    []Option{WithPageSize(2)},
+			want:       numbers(0, 5),
+			wantCalls:  3,
+			wantReport: []Progress{{Page: 1, Fetched: 2, Total: 5}, {Page: 2, Fetched: 4, Total: 5}, {Page: 3, Fetched: 5, Total: 5}},
+		},
+		{
+			name: "when the page is reported as the last one",
+			fetch: func(ctx context.Context, startAt, maxResults int) (*Page[int], error) {
+				return &Page[int]{Items: numbers(startAt, startAt+maxResults), IsLast: startAt >= 3}, nil
+			},
+			options:   []Option{WithPageSize(3)},
+			want:      numbers(0, 6),
+			wantCalls: 2,
+		},
+		{
+			name: "when the page is empty",
+			fetch: func(ctx context.Context, startAt, maxResults int) (*Page[int], error) {
+				if startAt >= 4 {
+					return &Page[int]{}, nil
+				}
+				return &Page[int]{Items: numbers(startAt, startAt+maxResults)}, nil
+			},
+			options:   []Option{WithPageSize(2)},
+			want:      numbers(0, 4),
+			wantCalls: 3,
+		},
+		{
+			name: "when the fetch fails",
+			fetch: func(ctx context.Context, startAt, maxResults int) (*Page[int], error) {
+				if startAt > 0 {
+					return nil, errFetch
+				}
+				return &Page[int]{Items: numbers(0, maxResults), Total: 10}, nil
+			},
+			options:   []Option{WithPageSize(2)},
+			want:      numbers(0, 2),
+			wantErr:   errFetch,
+			wantCalls: 2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			calls := 0
+			fetch := func(ctx context.Context, startAt, maxResults int) (*Page[int], error) {
+				calls++
+				return testCase.fetch(ctx, startAt, maxResults)
+			}
+
+			var reports []Progress
+			options := append(testCase.options, WithProgress(func(progress Progress) {
+				reports = append(reports, progress)
+			}))
+
+			got, err := collect(t, Offset(context.Background(), fetch, options...))
+
+			assert.Equal(t, testCase.want, got)
+			assert.Equal(t, testCase.wantCalls, calls)
+			assert.True(t, errors.Is(err, testCase.wantErr), "expected error: %v, got: %v", testCase.wantErr, err)
+
+			if testCase.wantReport != nil {
+				assert.Equal(t, testCase.wantReport, reports)
+			}
+		})
+	}
+}
+
+func TestCursor(t *testing.T) {
+
+	pages := map[string]*Page[int]{
+		"":   {Items: numbers(0, 2), Next: "c1"},
+		"c1": {Items: numbers(2, 4), Next: "c2"},
+		"c2": {Items: numbers(4, 5)},
+	}
+
+	var cursors []string
+	var limits []int
+	fetch := func(ctx context.Context, cursor string, limit int) (*Page[int], error) {
+		cursors = append(cursors, cursor)
+		limits = append(limits, limit)
+		return pages[cursor], nil
+	}
+
+	got, err := collect(t, Cursor(context.Background(), fetch))
+	assert.NoError(t, err)
+	assert.Equal(t, numbers(0, 5), got)
+	assert.Equal(t, []string{"", "c1", "c2"}, cursors)
+	assert.Equal(t, []int{DefaultPageSize, DefaultPageSize, DefaultPageSize}, limits)
+}
+
+func TestToken_RepeatedToken(t *testing.T) {
+
+	calls := 0
+	fetch := func(ctx context.Context, token string, limit int) (*Page[string], error) {
+		calls++
+		return &Page[string]{Items: []string{"KP-" + strconv.Itoa(calls)}, Next: "same"}, nil
+	}
+
+	got, err := collect(t, Token(context.Background(), fetch))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"KP-1", "KP-2"}, got)
+	assert.Equal(t, 2, calls)
+}
+
+func TestCursor_Break(t *testing.T) {
+
+	calls := 0
+	fetch := func(ctx context.Context, cursor string, limit int) (*Page[int], error) {
+		calls++
+		return &Page[int]{Items: numbers(0, limit), Next: "next-" + strconv.Itoa(calls)}, nil
+	}
+
+	var got []int
+	for item, err := range Cursor(context.Background(), fetch, WithPageSize(3)) {
+		assert.NoError(t, err)
+
+		got = append(got, item)
+		if len(got) == 4 {
+			break
+		}
+	}
+
+	assert.Equal(t, []int{0, 1, 2, 0}, got)
+	assert.Equal(t, 2, calls)
+}
+
+func TestOffset_ContextCancelled(t *testing.T) {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	calls := 0
+	fetch := func(ctx context.Context, startAt, maxResults int) (*Page[int], error) {
+		calls++
+		return &Page[int]{Items: numbers(startAt, startAt+maxResults), Total: 100}, nil
+	}
+
+	var got []int
+	var gotErr error
+	for item, err := range Offset(ctx, fetch, WithPageSize(2)) {
+		if err != nil {
+			gotErr = err
+			break
+		}
+
+		got = append(got, item)
+		cancel()
+	}
+
+	assert.Equal(t, []int{0}, got)
+	assert.True(t, errors.Is(gotErr, context.Canceled))
+	assert.Equal(t, 1, calls)
+}
+
+func TestCursorFromLink(t *testing.T) {
+
+	assert.Equal(t, "eyJpZCI6IjEyMyJ9", CursorFromLink("/wiki/api/v2/pages?cursor=eyJpZCI6IjEyMyJ9&limit=25"))
+	assert.Equal(t, "", CursorFromLink("/wiki/api/v2/pages?limit=25"))
+	assert.Equal(t, "", CursorFromLink(""))
+	assert.Equal(t, "", CursorFromLink("://invalid"))
+}
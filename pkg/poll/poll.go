@@ -0,0 +1,42 @@
+// Package poll waits for the asynchronous Atlassian operations to finish,
+// e.g. a Jira long-running task or a Bitbucket pipeline.
+package poll
+
+import (
+	"context"
+	"time"
+)
+
+// Fetcher fetches the current state of the polled resource.
+type Fetcher[T any] func(ctx context.Context) (T, error)
+
+// Until fetches the resource every interval until done reports it as finished, and returns it.
+//
+// The first fetch happens immediately. When a fetch fails or the context is done,
+// it returns the error along with the last resource fetched, the zero value when none was.
+// The interval must be positive.
+func Until[T any](ctx context.Context, interval time.Duration, fetch Fetcher[T], done func(T) bool) (T, error) {
+
+	var last T
+	for {
+
+		current, err := fetch(ctx)
+		if err != nil {
+			return last, err
+		}
+
+		if done(current) {
+			return current, nil
+		}
+
+		last = current
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return last, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
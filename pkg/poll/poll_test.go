@@ -0,0 +1,84 @@
+package poll
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUntil(t *testing.T) {
+
+	errFetch := errors.New("fetch failed")
+	finished := func(state int) bool { return state >= 3 }
+
+	t.Run("when the resource finishes", func(t *testing.T) {
+
+		calls := 0
+		state, err := Until(context.Background(), time.Millisecond, func(ctx context.Context) (int, error) {
+			calls++
+			return calls, nil
+		}, finished)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, state)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("when the resource is already finished", func(t *testing.T) {
+
+		calls := 0
+		state, err := Until(context.Background(), time.Hour, func(ctx context.Context) (int, error) {
+			calls++
+			return 5, nil
+		}, finished)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 5, state)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("when a fetch fails", func(t *testing.T) {
+
+		calls := 0
+		state, err := Until(context.Background(), time.Millisecond, func(ctx context.Context) (int, error) {
+			calls++
+			if calls == 2 {
+				return 0, errFetch
+			}
+
+			return calls, nil
+		}, finished)
+
+		assert.ErrorIs(t, err, errFetch)
+		assert.Equal(t, 1, state)
+	})
+
+	t.Run("when the first fetch fails", func(t *testing.T) {
+
+		state, err := Until(context.Background(), time.Millisecond, func(ctx context.Context) (int, error) {
+			return 2, errFetch
+		}, finished)
+
+		assert.ErrorIs(t, err, errFetch)
+		assert.Equal(t, 0, state)
+	})
+
+	t.Run("when the context is done while waiting", func(t *testing.T) {
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		calls := 0
+		state, err := Until(ctx, time.Hour, func(ctx context.Context) (int, error) {
+			calls++
+			return calls, nil
+		}, finished)
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, 1, state)
+		assert.Equal(t, 1, calls)
+	})
+}
@@ -0,0 +1,94 @@
+package replay
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// cassetteVersion is the version of the cassette format written by the recorder.
+const cassetteVersion = 1
+
+// Cassette is the list of the interactions recorded in a file.
+type Cassette struct {
+	Version      int            `json:"version" yaml:"version"`
+	Interactions []*Interaction `json:"interactions" yaml:"interactions"`
+}
+
+// Interaction is a request and the response it received.
+type Interaction struct {
+	Request  *Request  `json:"request" yaml:"request"`
+	Response *Response `json:"response" yaml:"response"`
+}
+
+// Request is a recorded request.
+type Request struct {
+	Method  string      `json:"method" yaml:"method"`
+	URL     string      `json:"url" yaml:"url"`
+	Headers http.Header `json:"headers,omitempty" yaml:"headers,omitempty"`
+	Body    string      `json:"body,omitempty" yaml:"body,omitempty"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	StatusCode int         `json:"status_code" yaml:"status_code"`
+	Headers    http.Header `json:"headers,omitempty" yaml:"headers,omitempty"`
+	Body       string      `json:"body,omitempty" yaml:"body,omitempty"`
+}
+
+// isJSON reports whether the cassette file uses the JSON format, the other files use YAML.
+func isJSON(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), ".json")
+}
+
+// Load reads a cassette file, the ".json" files are decoded as JSON and the other files as YAML.
+func Load(path string) (*Cassette, error) {
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cassette := &Cassette{}
+	if isJSON(path) {
+		err = json.Unmarshal(content, cassette)
+	} else {
+		err = yaml.Unmarshal(content, cassette)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("replay: unable to decode the cassette %v: %w", path, err)
+	}
+
+	return cassette, nil
+}
+
+// Save writes the cassette file, creating its directory if needed.
+func (c *Cassette) Save(path string) error {
+
+	var (
+		content []byte
+		err     error
+	)
+
+	if isJSON(path) {
+		content, err = json.MarshalIndent(c, "", "  ")
+	} else {
+		content, err = yaml.Marshal(c)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, content, 0o644)
+}
@@ -0,0 +1,148 @@
+package replay
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"reflect"
+)
+
+// Matcher reports whether a request matches a recorded request.
+// The body of the request is provided already read.
+type Matcher func(request *http.Request, body []byte, recorded *Request) bool
+
+// MatchMethod matches the requests using the same method.
+func MatchMethod(request *http.Request, _ []byte, recorded *Request) bool {
+	return request.Method == recorded.Method
+}
+
+// MatchPath matches the requests sent to the same path, the host is ignored.
+func MatchPath(request *http.Request, _ []byte, recorded *Request) bool {
+
+	u, err := url.Parse(recorded.URL)
+	if err != nil {
+		return false
+	}
+
+	return request.URL.Path == u.Path
+}
+
+// MatchQuery matches the requests using the same query parameters, in any order.
+// The scrubbed parameters of the recorded request match any value.
+func MatchQuery(request *http.Request, _ []byte, recorded *Request) bool {
+
+	u, err := url.Parse(recorded.URL)
+	if err != nil {
+		return false
+	}
+
+	got, want := request.URL.Query(), u.Query()
+	if len(got) != len(want) {
+		return false
+	}
+
+	for key, values := range want {
+		if len(values) == 1 && values[0] == Scrubbed {
+			if _, ok := got[key]; ok {
+				continue
+			}
+		}
+
+		if !reflect.DeepEqual(got[key], values) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MatchBody matches the requests sending the same body.
+// The JSON and form bodies are compared semantically, so the formatting and the field order are ignored,
+// and their scrubbed values match any value.
+func MatchBody(_ *http.Request, body []byte, recorded *Request) bool {
+
+	want := []byte(recorded.Body)
+	if bytes.Equal(bytes.TrimSpace(body), bytes.TrimSpace(want)) {
+		return true
+	}
+
+	var got, expected interface{}
+	if json.Unmarshal(body, &got) == nil && json.Unmarshal(want, &expected) == nil {
+		return equalJSON(got, expected)
+	}
+
+	gotForm, err := url.ParseQuery(string(body))
+	if err != nil {
+		return false
+	}
+
+	expectedForm, err := url.ParseQuery(string(want))
+	if err != nil || len(gotForm) != len(expectedForm) {
+		return false
+	}
+
+	for key, values := range expectedForm {
+		if !(len(values) == 1 && values[0] == Scrubbed) && !reflect.DeepEqual(gotForm[key], values) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// equalJSON compares two decoded JSON values, a scrubbed expected value matches any value.
+func equalJSON(got, expected interface{}) bool {
+
+	switch typed := expected.(type) {
+	case string:
+		if typed == Scrubbed {
+			return true
+		}
+
+	case map[string]interface{}:
+		object, ok := got.(map[string]interface{})
+		if !ok || len(object) != len(typed) {
+			return false
+		}
+
+		for key, value := range typed {
+			if field, ok := object[key]; !ok || !equalJSON(field, value) {
+				return false
+			}
+		}
+
+		return true
+
+	case []interface{}:
+		array, ok := got.([]interface{})
+		if !ok || len(array) != len(typed) {
+			return false
+		}
+
+		for index := range typed {
+			if !equalJSON(array[index], typed[index]) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return reflect.DeepEqual(got, expected)
+}
+
+// DefaultMatchers are the matchers used when none is provided.
+var DefaultMatchers = []Matcher{MatchMethod, MatchPath, MatchQuery, MatchBody}
+
+// matches reports whether the request matches the recorded request for every matcher.
+func matches(matchers []Matcher, request *http.Request, body []byte, recorded *Request) bool {
+
+	for _, matcher := range matchers {
+		if !matcher(request, body, recorded) {
+			return false
+		}
+	}
+
+	return true
+}
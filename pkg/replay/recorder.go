@@ -0,0 +1,251 @@
+// Package replay records the HTTP interactions of the Atlassian clients into cassettes and replays them,
+// so the code built on go-atlassian can be tested without reaching a real site.
+//
+// The Recorder is an http.RoundTripper, its Client can be used with every client constructor:
+//
+//	recorder, err := replay.New("testdata/issue_get.yaml", replay.ModeReplay)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	defer recorder.Stop()
+//
+//	instance, err := v3.New(recorder.Client(), "https://ctreminiom.atlassian.net")
+package replay
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/logging"
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// Mode is the mode of a Recorder.
+type Mode int
+
+const (
+	// ModeReplay serves the recorded interactions and never reaches the network.
+	ModeReplay Mode = iota
+
+	// ModeRecord sends the requests to the real site and records the interactions,
+	// the cassette is written when the recorder is stopped.
+	ModeRecord
+)
+
+// Scrubbed replaces the credentials in the recorded interactions.
+const Scrubbed = logging.Redacted
+
+// Option configures a Recorder.
+type Option func(*Recorder)
+
+// WithTransport sets the transport sending the requests in record mode, http.DefaultTransport is used by default.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(r *Recorder) {
+		r.transport = transport
+	}
+}
+
+// WithMatchers replaces the matchers used to find the recorded interaction of a request.
+func WithMatchers(matchers ...Matcher) Option {
+	return func(r *Recorder) {
+		r.matchers = matchers
+	}
+}
+
+// WithScrubbedHeaders adds headers to scrub from the recorded interactions,
+// on top of the Authorization, Proxy-Authorization, Cookie and Set-Cookie headers.
+func WithScrubbedHeaders(headers ...string) Option {
+	return func(r *Recorder) {
+		for _, header := range headers {
+			r.scrubbed = append(r.scrubbed, http.CanonicalHeaderKey(header))
+		}
+	}
+}
+
+// Recorder is an http.RoundTripper recording or replaying the interactions of a cassette.
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+	matchers  []Matcher
+	scrubbed  []string
+
+	mu       sync.Mutex
+	cassette *Cassette
+	used     []bool
+}
+
+// New creates a Recorder using the cassette file, ".json" files use JSON and the other files use YAML.
+// In replay mode, the cassette must exist.
+func New(path string, mode Mode, options ...Option) (*Recorder, error) {
+
+	recorder := &Recorder{
+		path:      path,
+		mode:      mode,
+		transport: http.DefaultTransport,
+		matchers:  DefaultMatchers,
+		cassette:  &Cassette{Version: cassetteVersion},
+	}
+
+	for _, option := range options {
+		option(recorder)
+	}
+
+	if mode == ModeReplay {
+
+		cassette, err := Load(path)
+		if err != nil {
+			return nil, err
+		}
+
+		recorder.cassette = cassette
+		recorder.used = make([]bool, len(cassette.Interactions))
+	}
+
+	return recorder, nil
+}
+
+// Client returns an HTTP client using the recorder as transport.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip records or replays the request, according to the recorder mode.
+func (r *Recorder) RoundTrip(request *http.Request) (*http.Response, error) {
+
+	body, err := readBody(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.mode == ModeReplay {
+		return r.replay(request, body)
+	}
+
+	return r.record(request, body)
+}
+
+// Stop writes the cassette in record mode, it does nothing in replay mode.
+func (r *Recorder) Stop() error {
+
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.cassette.Save(r.path)
+}
+
+// replay serves the first unused interaction matching the request.
+func (r *Recorder) replay(request *http.Request, body []byte) (*http.Response, error) {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for index, interaction := range r.cassette.Interactions {
+
+		if r.used[index] || !matches(r.matchers, request, body, interaction.Request) {
+			continue
+		}
+
+		r.used[index] = true
+		recorded := interaction.Response
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+			StatusCode:    recorded.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        recorded.Headers.Clone(),
+			Body:          io.NopCloser(strings.NewReader(recorded.Body)),
+			ContentLength: int64(len(recorded.Body)),
+			Request:       request,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("replay: %w: %v %v", model.ErrNoReplayInteraction, request.Method, request.URL)
+}
+
+// record sends the request with the transport and records the scrubbed interaction.
+func (r *Recorder) record(request *http.Request, body []byte) (*http.Response, error) {
+
+	outgoing := request.Clone(request.Context())
+	if body != nil {
+		outgoing.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	response, err := r.transport.RoundTrip(outgoing)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, err := io.ReadAll(response.Body)
+	_ = response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	interaction := &Interaction{
+		Request: &Request{
+			Method:  request.Method,
+			URL:     logging.RedactURL(request.URL),
+			Headers: r.scrub(request.Header),
+			Body:    scrubBody(body),
+		},
+		Response: &Response{
+			StatusCode: response.StatusCode,
+			Headers:    r.scrub(response.Header),
+			Body:       scrubBody(responseBody),
+		},
+	}
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	r.mu.Unlock()
+
+	return response, nil
+}
+
+// scrub returns a copy of the headers with the credentials and the configured headers scrubbed.
+func (r *Recorder) scrub(header http.Header) http.Header {
+
+	scrubbed := logging.RedactHeaders(header)
+	for _, key := range r.scrubbed {
+		if _, ok := scrubbed[key]; ok {
+			scrubbed[key] = []string{Scrubbed}
+		}
+	}
+
+	return scrubbed
+}
+
+// scrubBody returns the body with its credentials scrubbed.
+// The bodies without credentials are returned unchanged, to keep their formatting.
+func scrubBody(body []byte) string {
+
+	if !logging.HasSensitiveKeys(body) {
+		return string(body)
+	}
+
+	return logging.RedactBody(body, false)
+}
+
+// readBody reads and closes the request body.
+func readBody(request *http.Request) ([]byte, error) {
+
+	if request.Body == nil || request.Body == http.NoBody {
+		return nil, nil
+	}
+
+	defer request.Body.Close()
+	return io.ReadAll(request.Body)
+}
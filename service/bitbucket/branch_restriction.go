@@ -0,0 +1,58 @@
+package bitbucket
+
+import (
+	"context"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// BranchRestrictionConnector represents the Bitbucket Cloud branch restrictions,
+// the permissions enforced on the branches matching a pattern or a branch type.
+type BranchRestrictionConnector interface {
+
+	// Gets returns a paginated list of the branch restrictions of the specified repository.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/branch-restrictions
+	Gets(ctx context.Context, workspace, repoSlug string, options *models.BranchRestrictionOptionsScheme) (*models.BranchRestrictionPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified branch restriction.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/branch-restrictions/{id}
+	Get(ctx context.Context, workspace, repoSlug string, restrictionID int) (*models.BranchRestrictionScheme, *models.ResponseScheme, error)
+
+	// Create creates a new branch restriction.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/branch-restrictions
+	Create(ctx context.Context, workspace, repoSlug string, payload *models.BranchRestrictionScheme) (*models.BranchRestrictionScheme, *models.ResponseScheme, error)
+
+	// Update updates the specified branch restriction.
+	//
+	// PUT /2.0/repositories/{workspace}/{repo_slug}/branch-restrictions/{id}
+	Update(ctx context.Context, workspace, repoSlug string, restrictionID int, payload *models.BranchRestrictionScheme) (*models.BranchRestrictionScheme, *models.ResponseScheme, error)
+
+	// Delete deletes the specified branch restriction.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/branch-restrictions/{id}
+	Delete(ctx context.Context, workspace, repoSlug string, restrictionID int) (*models.ResponseScheme, error)
+}
+
+// BranchingModelConnector represents the Bitbucket Cloud branching model of a repository.
+type BranchingModelConnector interface {
+
+	// Get returns the effective branching model of the specified repository,
+	// the settings of the repository or, when it inherits them, of its project.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/branching-model
+	Get(ctx context.Context, workspace, repoSlug string) (*models.BranchingModelScheme, *models.ResponseScheme, error)
+
+	// Settings returns the branching model settings of the specified repository.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/branching-model/settings
+	Settings(ctx context.Context, workspace, repoSlug string) (*models.BranchingModelSettingsScheme, *models.ResponseScheme, error)
+
+	// UpdateSettings updates the branching model settings of the specified repository,
+	// the attributes unset in the payload are left unchanged.
+	//
+	// PUT /2.0/repositories/{workspace}/{repo_slug}/branching-model/settings
+	UpdateSettings(ctx context.Context, workspace, repoSlug string, payload *models.BranchingModelSettingsScheme) (*models.BranchingModelSettingsScheme, *models.ResponseScheme, error)
+}
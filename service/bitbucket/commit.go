@@ -0,0 +1,134 @@
+package bitbucket
+
+import (
+	"context"
+	"io"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// CommitConnector represents the Bitbucket Cloud commits.
+type CommitConnector interface {
+
+	// Gets returns a paginated list of the commits of the specified repository, the newest first.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/commits
+	Gets(ctx context.Context, workspace, repoSlug string, options *models.CommitOptionsScheme) (*models.CommitPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified commit.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}
+	Get(ctx context.Context, workspace, repoSlug, commit string) (*models.CommitScheme, *models.ResponseScheme, error)
+
+	// Diff returns the diff between two revisions as a reader, the caller must close it.
+	//
+	// The spec is either a commit, diffed against its first parent, or two revisions, e.g. "feature..main".
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/diff/{spec}
+	Diff(ctx context.Context, workspace, repoSlug, spec string) (io.ReadCloser, error)
+
+	// Patch returns the patch between two revisions as a reader, the caller must close it.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/patch/{spec}
+	Patch(ctx context.Context, workspace, repoSlug, spec string) (io.ReadCloser, error)
+
+	// DiffStat returns a paginated list of the files modified between two revisions.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/diffstat/{spec}
+	DiffStat(ctx context.Context, workspace, repoSlug, spec string) (*models.DiffStatPageScheme, *models.ResponseScheme, error)
+}
+
+// CommitCommentConnector represents the Bitbucket Cloud commit comments.
+type CommitCommentConnector interface {
+
+	// Gets returns a paginated list of the comments on the specified commit.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/comments
+	Gets(ctx context.Context, workspace, repoSlug, commit string) (*models.CommitCommentPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified commit comment.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/comments/{comment_id}
+	Get(ctx context.Context, workspace, repoSlug, commit string, commentID int) (*models.CommitCommentScheme, *models.ResponseScheme, error)
+
+	// Create creates a new commit comment, a reply when the payload has a parent.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/comments
+	Create(ctx context.Context, workspace, repoSlug, commit string, payload *models.CommitCommentPayloadScheme) (*models.CommitCommentScheme, *models.ResponseScheme, error)
+
+	// Update updates the specified commit comment.
+	//
+	// PUT /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/comments/{comment_id}
+	Update(ctx context.Context, workspace, repoSlug, commit string, commentID int, payload *models.CommitCommentPayloadScheme) (*models.CommitCommentScheme, *models.ResponseScheme, error)
+
+	// Delete deletes the specified commit comment.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/comments/{comment_id}
+	Delete(ctx context.Context, workspace, repoSlug, commit string, commentID int) (*models.ResponseScheme, error)
+}
+
+// CommitStatusConnector represents the Bitbucket Cloud commit build statuses,
+// used by the CI systems to report the state of their builds.
+type CommitStatusConnector interface {
+
+	// Gets returns a paginated list of the statuses of the specified commit.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/statuses
+	Gets(ctx context.Context, workspace, repoSlug, commit string) (*models.CommitStatusPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified build status of a commit.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/statuses/build/{key}
+	Get(ctx context.Context, workspace, repoSlug, commit, key string) (*models.CommitStatusScheme, *models.ResponseScheme, error)
+
+	// Create creates a new build status for the specified commit.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/statuses/build
+	Create(ctx context.Context, workspace, repoSlug, commit string, payload *models.CommitStatusPayloadScheme) (*models.CommitStatusScheme, *models.ResponseScheme, error)
+
+	// Update updates the specified build status of a commit.
+	//
+	// PUT /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/statuses/build/{key}
+	Update(ctx context.Context, workspace, repoSlug, commit, key string, payload *models.CommitStatusPayloadScheme) (*models.CommitStatusScheme, *models.ResponseScheme, error)
+}
+
+// CommitReportConnector represents the Bitbucket Cloud Code Insights reports and annotations of a commit.
+type CommitReportConnector interface {
+
+	// Gets returns a paginated list of the reports of the specified commit.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/reports
+	Gets(ctx context.Context, workspace, repoSlug, commit string) (*models.CommitReportPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified report.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/reports/{reportId}
+	Get(ctx context.Context, workspace, repoSlug, commit, reportID string) (*models.CommitReportScheme, *models.ResponseScheme, error)
+
+	// Create creates the specified report, replacing the existing report with the same ID and its annotations.
+	//
+	// PUT /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/reports/{reportId}
+	Create(ctx context.Context, workspace, repoSlug, commit, reportID string, payload *models.CommitReportScheme) (*models.CommitReportScheme, *models.ResponseScheme, error)
+
+	// Delete deletes the specified report and its annotations.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/reports/{reportId}
+	Delete(ctx context.Context, workspace, repoSlug, commit, reportID string) (*models.ResponseScheme, error)
+
+	// Annotations returns a paginated list of the annotations of the specified report.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/reports/{reportId}/annotations
+	Annotations(ctx context.Context, workspace, repoSlug, commit, reportID string) (*models.CommitReportAnnotationPageScheme, *models.ResponseScheme, error)
+
+	// AddAnnotations adds the annotations to the specified report, replacing the ones with the same external IDs.
+	//
+	// A report can have up to 1000 annotations, they are sent in chunks of up to 100, the limit of a single request.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/reports/{reportId}/annotations
+	AddAnnotations(ctx context.Context, workspace, repoSlug, commit, reportID string, annotations []*models.CommitReportAnnotationScheme) ([]*models.CommitReportAnnotationScheme, *models.ResponseScheme, error)
+
+	// DeleteAnnotation deletes the specified annotation.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}/reports/{reportId}/annotations/{annotationId}
+	DeleteAnnotation(ctx context.Context, workspace, repoSlug, commit, reportID, annotationID string) (*models.ResponseScheme, error)
+}
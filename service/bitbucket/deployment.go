@@ -0,0 +1,52 @@
+package bitbucket
+
+import (
+	"context"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// DeploymentConnector represents the Bitbucket Cloud deployments.
+type DeploymentConnector interface {
+
+	// Gets returns a paginated list of the deployments of the specified repository.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/deployments
+	Gets(ctx context.Context, workspace, repoSlug string) (*models.DeploymentPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified deployment.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/deployments/{deployment_uuid}
+	Get(ctx context.Context, workspace, repoSlug, deploymentUUID string) (*models.DeploymentScheme, *models.ResponseScheme, error)
+}
+
+// EnvironmentConnector represents the Bitbucket Cloud deployment environments.
+type EnvironmentConnector interface {
+
+	// Gets returns a paginated list of the deployment environments of the specified repository.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/environments
+	Gets(ctx context.Context, workspace, repoSlug string) (*models.DeploymentEnvironmentPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified deployment environment.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/environments/{environment_uuid}
+	Get(ctx context.Context, workspace, repoSlug, environmentUUID string) (*models.DeploymentEnvironmentScheme, *models.ResponseScheme, error)
+
+	// Create creates a new deployment environment on the specified repository.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/environments
+	Create(ctx context.Context, workspace, repoSlug string, payload *models.DeploymentEnvironmentPayloadScheme) (*models.DeploymentEnvironmentScheme, *models.ResponseScheme, error)
+
+	// Update changes the name, the rank, the visibility and the restrictions of the specified deployment environment.
+	//
+	// The changes are applied asynchronously, Bitbucket accepts them with a 202 status.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/environments/{environment_uuid}/changes
+	Update(ctx context.Context, workspace, repoSlug, environmentUUID string, payload *models.DeploymentEnvironmentUpdatePayloadScheme) (*models.ResponseScheme, error)
+
+	// Delete deletes the specified deployment environment, its deployment variables included.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/environments/{environment_uuid}
+	Delete(ctx context.Context, workspace, repoSlug, environmentUUID string) (*models.ResponseScheme, error)
+}
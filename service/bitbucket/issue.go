@@ -0,0 +1,109 @@
+package bitbucket
+
+import (
+	"context"
+	"io"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// IssueConnector represents the Bitbucket Cloud repository issue tracker.
+type IssueConnector interface {
+
+	// Gets returns a paginated list of the issues of the specified repository.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/issues
+	Gets(ctx context.Context, workspace, repoSlug string, options *models.RepositoryIssueOptionsScheme) (*models.RepositoryIssuePageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified issue.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/issues/{issue_id}
+	Get(ctx context.Context, workspace, repoSlug string, issueID int) (*models.RepositoryIssueScheme, *models.ResponseScheme, error)
+
+	// Create creates a new issue, the authenticated user is the reporter.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/issues
+	Create(ctx context.Context, workspace, repoSlug string, payload *models.RepositoryIssuePayloadScheme) (*models.RepositoryIssueScheme, *models.ResponseScheme, error)
+
+	// Update updates the attributes of the specified issue.
+	//
+	// PUT /2.0/repositories/{workspace}/{repo_slug}/issues/{issue_id}
+	Update(ctx context.Context, workspace, repoSlug string, issueID int, payload *models.RepositoryIssuePayloadScheme) (*models.RepositoryIssueScheme, *models.ResponseScheme, error)
+
+	// Delete deletes the specified issue.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/issues/{issue_id}
+	Delete(ctx context.Context, workspace, repoSlug string, issueID int) (*models.ResponseScheme, error)
+}
+
+// IssueCommentConnector represents the Bitbucket Cloud repository issue comments.
+type IssueCommentConnector interface {
+
+	// Gets returns a paginated list of the comments on the specified issue.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/issues/{issue_id}/comments
+	Gets(ctx context.Context, workspace, repoSlug string, issueID int) (*models.RepositoryIssueCommentPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified issue comment.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/issues/{issue_id}/comments/{comment_id}
+	Get(ctx context.Context, workspace, repoSlug string, issueID, commentID int) (*models.RepositoryIssueCommentScheme, *models.ResponseScheme, error)
+
+	// Create creates a new comment on the specified issue.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/issues/{issue_id}/comments
+	Create(ctx context.Context, workspace, repoSlug string, issueID int, payload *models.RepositoryIssueCommentPayloadScheme) (*models.RepositoryIssueCommentScheme, *models.ResponseScheme, error)
+
+	// Update updates the content of the specified issue comment.
+	//
+	// PUT /2.0/repositories/{workspace}/{repo_slug}/issues/{issue_id}/comments/{comment_id}
+	Update(ctx context.Context, workspace, repoSlug string, issueID, commentID int, payload *models.RepositoryIssueCommentPayloadScheme) (*models.RepositoryIssueCommentScheme, *models.ResponseScheme, error)
+
+	// Delete deletes the specified issue comment.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/issues/{issue_id}/comments/{comment_id}
+	Delete(ctx context.Context, workspace, repoSlug string, issueID, commentID int) (*models.ResponseScheme, error)
+}
+
+// IssueAttachmentConnector represents the Bitbucket Cloud repository issue attachments.
+type IssueAttachmentConnector interface {
+
+	// Gets returns a paginated list of the files attached to the specified issue.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/issues/{issue_id}/attachments
+	Gets(ctx context.Context, workspace, repoSlug string, issueID int) (*models.RepositoryIssueAttachmentPageScheme, *models.ResponseScheme, error)
+
+	// Upload attaches a file to the specified issue, replacing the attachment with the same name.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/issues/{issue_id}/attachments
+	Upload(ctx context.Context, workspace, repoSlug string, issueID int, fileName string, file io.Reader) (*models.ResponseScheme, error)
+
+	// Download returns the contents of the specified attachment as a reader, the caller must close it.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/issues/{issue_id}/attachments/{path}
+	Download(ctx context.Context, workspace, repoSlug string, issueID int, fileName string) (io.ReadCloser, error)
+
+	// Delete deletes the specified attachment.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/issues/{issue_id}/attachments/{path}
+	Delete(ctx context.Context, workspace, repoSlug string, issueID int, fileName string) (*models.ResponseScheme, error)
+}
+
+// IssueChangeConnector represents the Bitbucket Cloud repository issue changes.
+type IssueChangeConnector interface {
+
+	// Gets returns a paginated list of the changes of the attributes of the specified issue, the oldest first.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/issues/{issue_id}/changes
+	Gets(ctx context.Context, workspace, repoSlug string, issueID int) (*models.RepositoryIssueChangePageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified issue change.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/issues/{issue_id}/changes/{change_id}
+	Get(ctx context.Context, workspace, repoSlug string, issueID int, changeID string) (*models.RepositoryIssueChangeScheme, *models.ResponseScheme, error)
+
+	// Create changes the attributes of the specified issue, e.g. to transition its state, and records the change.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/issues/{issue_id}/changes
+	Create(ctx context.Context, workspace, repoSlug string, issueID int, payload *models.RepositoryIssueChangePayloadScheme) (*models.RepositoryIssueChangeScheme, *models.ResponseScheme, error)
+}
@@ -0,0 +1,139 @@
+package bitbucket
+
+import (
+	"context"
+	"io"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// PipelineConnector represents the Bitbucket Cloud pipelines.
+//
+// Use it to list, trigger and stop the pipelines of a repository.
+type PipelineConnector interface {
+
+	// Gets returns a paginated list of the pipelines of the specified repository.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines
+	Gets(ctx context.Context, workspace, repoSlug string, options *models.PipelineOptionsScheme) (*models.PipelinePageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified pipeline.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines/{pipeline_uuid}
+	Get(ctx context.Context, workspace, repoSlug, pipelineUUID string) (*models.PipelineScheme, *models.ResponseScheme, error)
+
+	// Trigger triggers a pipeline on the target of the payload: a branch, a tag or a commit.
+	//
+	// Set the selector of the target to run a custom pipeline, using the variables of the payload.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/pipelines
+	Trigger(ctx context.Context, workspace, repoSlug string, payload *models.PipelinePayloadScheme) (*models.PipelineScheme, *models.ResponseScheme, error)
+
+	// Stop signals the specified pipeline to stop.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/pipelines/{pipeline_uuid}/stopPipeline
+	Stop(ctx context.Context, workspace, repoSlug, pipelineUUID string) (*models.ResponseScheme, error)
+}
+
+// PipelineStepConnector represents the Bitbucket Cloud pipeline steps.
+type PipelineStepConnector interface {
+
+	// Gets returns a paginated list of the steps of the specified pipeline.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines/{pipeline_uuid}/steps
+	Gets(ctx context.Context, workspace, repoSlug, pipelineUUID string) (*models.PipelineStepPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified pipeline step.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines/{pipeline_uuid}/steps/{step_uuid}
+	Get(ctx context.Context, workspace, repoSlug, pipelineUUID, stepUUID string) (*models.PipelineStepScheme, *models.ResponseScheme, error)
+
+	// Log returns the log of the specified pipeline step as a reader, the caller must close it.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines/{pipeline_uuid}/steps/{step_uuid}/log
+	Log(ctx context.Context, workspace, repoSlug, pipelineUUID, stepUUID string) (io.ReadCloser, error)
+}
+
+// PipelineVariableConnector represents the Bitbucket Cloud repository pipeline variables.
+type PipelineVariableConnector interface {
+
+	// Gets returns a paginated list of the pipeline variables of the specified repository.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines_config/variables
+	Gets(ctx context.Context, workspace, repoSlug string) (*models.PipelineVariablePageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified repository pipeline variable.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines_config/variables/{variable_uuid}
+	Get(ctx context.Context, workspace, repoSlug, variableUUID string) (*models.PipelineVariableScheme, *models.ResponseScheme, error)
+
+	// Create creates a repository pipeline variable.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/pipelines_config/variables
+	Create(ctx context.Context, workspace, repoSlug string, payload *models.PipelineVariableScheme) (*models.PipelineVariableScheme, *models.ResponseScheme, error)
+
+	// Update updates the specified repository pipeline variable.
+	//
+	// PUT /2.0/repositories/{workspace}/{repo_slug}/pipelines_config/variables/{variable_uuid}
+	Update(ctx context.Context, workspace, repoSlug, variableUUID string, payload *models.PipelineVariableScheme) (*models.PipelineVariableScheme, *models.ResponseScheme, error)
+
+	// Delete deletes the specified repository pipeline variable.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/pipelines_config/variables/{variable_uuid}
+	Delete(ctx context.Context, workspace, repoSlug, variableUUID string) (*models.ResponseScheme, error)
+}
+
+// PipelineWorkspaceVariableConnector represents the Bitbucket Cloud workspace pipeline variables,
+// shared by the pipelines of every repository of the workspace.
+type PipelineWorkspaceVariableConnector interface {
+
+	// Gets returns a paginated list of the pipeline variables of the specified workspace.
+	//
+	// GET /2.0/workspaces/{workspace}/pipelines-config/variables
+	Gets(ctx context.Context, workspace string) (*models.PipelineVariablePageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified workspace pipeline variable.
+	//
+	// GET /2.0/workspaces/{workspace}/pipelines-config/variables/{variable_uuid}
+	Get(ctx context.Context, workspace, variableUUID string) (*models.PipelineVariableScheme, *models.ResponseScheme, error)
+
+	// Create creates a workspace pipeline variable.
+	//
+	// POST /2.0/workspaces/{workspace}/pipelines-config/variables
+	Create(ctx context.Context, workspace string, payload *models.PipelineVariableScheme) (*models.PipelineVariableScheme, *models.ResponseScheme, error)
+
+	// Update updates the specified workspace pipeline variable.
+	//
+	// PUT /2.0/workspaces/{workspace}/pipelines-config/variables/{variable_uuid}
+	Update(ctx context.Context, workspace, variableUUID string, payload *models.PipelineVariableScheme) (*models.PipelineVariableScheme, *models.ResponseScheme, error)
+
+	// Delete deletes the specified workspace pipeline variable.
+	//
+	// DELETE /2.0/workspaces/{workspace}/pipelines-config/variables/{variable_uuid}
+	Delete(ctx context.Context, workspace, variableUUID string) (*models.ResponseScheme, error)
+}
+
+// PipelineDeploymentVariableConnector represents the Bitbucket Cloud deployment pipeline variables,
+// used by the pipeline steps deploying to an environment.
+type PipelineDeploymentVariableConnector interface {
+
+	// Gets returns a paginated list of the pipeline variables of the specified deployment environment.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/deployments_config/environments/{environment_uuid}/variables
+	Gets(ctx context.Context, workspace, repoSlug, environmentUUID string) (*models.PipelineVariablePageScheme, *models.ResponseScheme, error)
+
+	// Create creates a deployment pipeline variable.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/deployments_config/environments/{environment_uuid}/variables
+	Create(ctx context.Context, workspace, repoSlug, environmentUUID string, payload *models.PipelineVariableScheme) (*models.PipelineVariableScheme, *models.ResponseScheme, error)
+
+	// Update updates the specified deployment pipeline variable.
+	//
+	// PUT /2.0/repositories/{workspace}/{repo_slug}/deployments_config/environments/{environment_uuid}/variables/{variable_uuid}
+	Update(ctx context.Context, workspace, repoSlug, environmentUUID, variableUUID string, payload *models.PipelineVariableScheme) (*models.PipelineVariableScheme, *models.ResponseScheme, error)
+
+	// Delete deletes the specified deployment pipeline variable.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/deployments_config/environments/{environment_uuid}/variables/{variable_uuid}
+	Delete(ctx context.Context, workspace, repoSlug, environmentUUID, variableUUID string) (*models.ResponseScheme, error)
+}
@@ -0,0 +1,139 @@
+package bitbucket
+
+import (
+	"context"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// ProjectConnector represents the Bitbucket Cloud projects, the containers grouping the repositories of a workspace.
+type ProjectConnector interface {
+
+	// Gets returns a paginated list of the projects of the specified workspace.
+	//
+	// GET /2.0/workspaces/{workspace}/projects
+	Gets(ctx context.Context, workspace string) (*models.BitbucketProjectPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified project.
+	//
+	// GET /2.0/workspaces/{workspace}/projects/{project_key}
+	Get(ctx context.Context, workspace, projectKey string) (*models.BitbucketProjectScheme, *models.ResponseScheme, error)
+
+	// Create creates a new project in the specified workspace.
+	//
+	// POST /2.0/workspaces/{workspace}/projects
+	Create(ctx context.Context, workspace string, payload *models.BitbucketProjectPayloadScheme) (*models.BitbucketProjectScheme, *models.ResponseScheme, error)
+
+	// Update updates the specified project, a new key in the payload renames it.
+	//
+	// PUT /2.0/workspaces/{workspace}/projects/{project_key}
+	Update(ctx context.Context, workspace, projectKey string, payload *models.BitbucketProjectPayloadScheme) (*models.BitbucketProjectScheme, *models.ResponseScheme, error)
+
+	// Delete deletes the specified project, it must not contain any repository.
+	//
+	// DELETE /2.0/workspaces/{workspace}/projects/{project_key}
+	Delete(ctx context.Context, workspace, projectKey string) (*models.ResponseScheme, error)
+}
+
+// ProjectDefaultReviewerConnector represents the Bitbucket Cloud project default reviewers,
+// the users added as reviewers to the pull requests of every repository of the project.
+type ProjectDefaultReviewerConnector interface {
+
+	// Gets returns a paginated list of the default reviewers of the specified project.
+	//
+	// GET /2.0/workspaces/{workspace}/projects/{project_key}/default-reviewers
+	Gets(ctx context.Context, workspace, projectKey string) (*models.DefaultReviewerPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified default reviewer of the project.
+	//
+	// GET /2.0/workspaces/{workspace}/projects/{project_key}/default-reviewers/{selected_user}
+	Get(ctx context.Context, workspace, projectKey, accountID string) (*models.BitbucketAccountScheme, *models.ResponseScheme, error)
+
+	// Add adds the specified user to the default reviewers of the project.
+	//
+	// PUT /2.0/workspaces/{workspace}/projects/{project_key}/default-reviewers/{selected_user}
+	Add(ctx context.Context, workspace, projectKey, accountID string) (*models.BitbucketAccountScheme, *models.ResponseScheme, error)
+
+	// Remove removes the specified user from the default reviewers of the project.
+	//
+	// DELETE /2.0/workspaces/{workspace}/projects/{project_key}/default-reviewers/{selected_user}
+	Remove(ctx context.Context, workspace, projectKey, accountID string) (*models.ResponseScheme, error)
+}
+
+// ProjectBranchRestrictionConnector represents the Bitbucket Cloud project branch restrictions,
+// the branch restrictions inherited by every repository of the project.
+type ProjectBranchRestrictionConnector interface {
+
+	// Gets returns a paginated list of the branch restrictions of the specified project.
+	//
+	// GET /2.0/workspaces/{workspace}/projects/{project_key}/branch-restrictions
+	Gets(ctx context.Context, workspace, projectKey string, options *models.BranchRestrictionOptionsScheme) (*models.BranchRestrictionPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified branch restriction of the project.
+	//
+	// GET /2.0/workspaces/{workspace}/projects/{project_key}/branch-restrictions/{id}
+	Get(ctx context.Context, workspace, projectKey string, restrictionID int) (*models.BranchRestrictionScheme, *models.ResponseScheme, error)
+
+	// Create creates a new branch restriction on the specified project.
+	//
+	// POST /2.0/workspaces/{workspace}/projects/{project_key}/branch-restrictions
+	Create(ctx context.Context, workspace, projectKey string, payload *models.BranchRestrictionScheme) (*models.BranchRestrictionScheme, *models.ResponseScheme, error)
+
+	// Update updates the specified branch restriction of the project.
+	//
+	// PUT /2.0/workspaces/{workspace}/projects/{project_key}/branch-restrictions/{id}
+	Update(ctx context.Context, workspace, projectKey string, restrictionID int, payload *models.BranchRestrictionScheme) (*models.BranchRestrictionScheme, *models.ResponseScheme, error)
+
+	// Delete deletes the specified branch restriction of the project.
+	//
+	// DELETE /2.0/workspaces/{workspace}/projects/{project_key}/branch-restrictions/{id}
+	Delete(ctx context.Context, workspace, projectKey string, restrictionID int) (*models.ResponseScheme, error)
+}
+
+// ProjectGroupPermissionConnector represents the Bitbucket Cloud project group permissions.
+type ProjectGroupPermissionConnector interface {
+
+	// Gets returns a paginated list of the explicit group permissions of the specified project.
+	//
+	// GET /2.0/workspaces/{workspace}/projects/{project_key}/permissions-config/groups
+	Gets(ctx context.Context, workspace, projectKey string) (*models.ProjectGroupPermissionPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the explicit permission of the specified group on the project.
+	//
+	// GET /2.0/workspaces/{workspace}/projects/{project_key}/permissions-config/groups/{group_slug}
+	Get(ctx context.Context, workspace, projectKey, groupSlug string) (*models.ProjectGroupPermissionScheme, *models.ResponseScheme, error)
+
+	// Update grants, or changes, the explicit permission of the specified group on the project.
+	//
+	// PUT /2.0/workspaces/{workspace}/projects/{project_key}/permissions-config/groups/{group_slug}
+	Update(ctx context.Context, workspace, projectKey, groupSlug string, payload *models.ProjectPermissionPayloadScheme) (*models.ProjectGroupPermissionScheme, *models.ResponseScheme, error)
+
+	// Delete revokes the explicit permission of the specified group on the project.
+	//
+	// DELETE /2.0/workspaces/{workspace}/projects/{project_key}/permissions-config/groups/{group_slug}
+	Delete(ctx context.Context, workspace, projectKey, groupSlug string) (*models.ResponseScheme, error)
+}
+
+// ProjectUserPermissionConnector represents the Bitbucket Cloud project user permissions.
+type ProjectUserPermissionConnector interface {
+
+	// Gets returns a paginated list of the explicit user permissions of the specified project.
+	//
+	// GET /2.0/workspaces/{workspace}/projects/{project_key}/permissions-config/users
+	Gets(ctx context.Context, workspace, projectKey string) (*models.ProjectUserPermissionPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the explicit permission of the specified user on the project.
+	//
+	// GET /2.0/workspaces/{workspace}/projects/{project_key}/permissions-config/users/{selected_user_id}
+	Get(ctx context.Context, workspace, projectKey, accountID string) (*models.ProjectUserPermissionScheme, *models.ResponseScheme, error)
+
+	// Update grants, or changes, the explicit permission of the specified user on the project.
+	//
+	// PUT /2.0/workspaces/{workspace}/projects/{project_key}/permissions-config/users/{selected_user_id}
+	Update(ctx context.Context, workspace, projectKey, accountID string, payload *models.ProjectPermissionPayloadScheme) (*models.ProjectUserPermissionScheme, *models.ResponseScheme, error)
+
+	// Delete revokes the explicit permission of the specified user on the project.
+	//
+	// DELETE /2.0/workspaces/{workspace}/projects/{project_key}/permissions-config/users/{selected_user_id}
+	Delete(ctx context.Context, workspace, projectKey, accountID string) (*models.ResponseScheme, error)
+}
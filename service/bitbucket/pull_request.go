@@ -0,0 +1,162 @@
+package bitbucket
+
+import (
+	"context"
+	"io"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// PullRequestConnector represents the Bitbucket Cloud pull requests.
+//
+// Use it to list, create, review, merge and decline the pull requests of a repository.
+type PullRequestConnector interface {
+
+	// Gets returns a paginated list of the pull requests of the specified repository.
+	//
+	// Only the open pull requests are returned, unless other states are requested.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests
+	Gets(ctx context.Context, workspace, repoSlug string, options *models.PullRequestOptionsScheme) (*models.PullRequestPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified pull request.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}
+	Get(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*models.PullRequestScheme, *models.ResponseScheme, error)
+
+	// Create creates a new pull request where the destination repository is this repository and the author is the authenticated user.
+	//
+	// The source branch is required, the destination branch defaults to the main branch of the repository.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/pullrequests
+	Create(ctx context.Context, workspace, repoSlug string, payload *models.PullRequestPayloadScheme) (*models.PullRequestScheme, *models.ResponseScheme, error)
+
+	// Update mutates the specified pull request.
+	//
+	// PUT /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}
+	Update(ctx context.Context, workspace, repoSlug string, pullRequestID int, payload *models.PullRequestPayloadScheme) (*models.PullRequestScheme, *models.ResponseScheme, error)
+
+	// Approve approves the specified pull request as the authenticated user.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/approve
+	Approve(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*models.PullRequestParticipantScheme, *models.ResponseScheme, error)
+
+	// Unapprove redacts the authenticated user's approval of the specified pull request.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/approve
+	Unapprove(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*models.ResponseScheme, error)
+
+	// RequestChanges requests changes on the specified pull request as the authenticated user.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/request-changes
+	RequestChanges(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*models.PullRequestParticipantScheme, *models.ResponseScheme, error)
+
+	// RemoveChangeRequest removes the change request of the authenticated user on the specified pull request.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/request-changes
+	RemoveChangeRequest(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*models.ResponseScheme, error)
+
+	// Decline declines the specified pull request.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/decline
+	Decline(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*models.PullRequestScheme, *models.ResponseScheme, error)
+
+	// Merge merges the specified pull request, using the merge strategy of the payload when provided.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/merge
+	Merge(ctx context.Context, workspace, repoSlug string, pullRequestID int, payload *models.PullRequestMergePayloadScheme) (*models.PullRequestScheme, *models.ResponseScheme, error)
+
+	// Diff returns the diff of the specified pull request as a reader, the caller must close it.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/diff
+	Diff(ctx context.Context, workspace, repoSlug string, pullRequestID int) (io.ReadCloser, error)
+
+	// Patch returns the patch of the specified pull request as a reader, the caller must close it.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/patch
+	Patch(ctx context.Context, workspace, repoSlug string, pullRequestID int) (io.ReadCloser, error)
+
+	// DiffStat returns a paginated list of the files modified by the specified pull request.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/diffstat
+	DiffStat(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*models.DiffStatPageScheme, *models.ResponseScheme, error)
+
+	// Activity returns a paginated list of the activity of the specified pull request:
+	//
+	// its updates, approvals, change requests and comments.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/activity
+	Activity(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*models.PullRequestActivityPageScheme, *models.ResponseScheme, error)
+}
+
+// PullRequestCommentConnector represents the Bitbucket Cloud pull request comments.
+//
+// The comments are either general or inline, and are threaded using their parent.
+type PullRequestCommentConnector interface {
+
+	// Gets returns a paginated list of the comments on the specified pull request, the deleted comments included.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments
+	Gets(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*models.PullRequestCommentPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified pull request comment.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments/{comment_id}
+	Get(ctx context.Context, workspace, repoSlug string, pullRequestID, commentID int) (*models.PullRequestCommentScheme, *models.ResponseScheme, error)
+
+	// Create creates a new pull request comment.
+	//
+	// Set the parent of the payload to reply to a comment, and its inline location to comment a line of a file.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments
+	Create(ctx context.Context, workspace, repoSlug string, pullRequestID int, payload *models.PullRequestCommentPayloadScheme) (*models.PullRequestCommentScheme, *models.ResponseScheme, error)
+
+	// Update updates the specified pull request comment.
+	//
+	// PUT /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments/{comment_id}
+	Update(ctx context.Context, workspace, repoSlug string, pullRequestID, commentID int, payload *models.PullRequestCommentPayloadScheme) (*models.PullRequestCommentScheme, *models.ResponseScheme, error)
+
+	// Delete deletes the specified pull request comment.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments/{comment_id}
+	Delete(ctx context.Context, workspace, repoSlug string, pullRequestID, commentID int) (*models.ResponseScheme, error)
+
+	// Resolve resolves the thread of the specified pull request comment.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments/{comment_id}/resolve
+	Resolve(ctx context.Context, workspace, repoSlug string, pullRequestID, commentID int) (*models.PullRequestCommentResolutionScheme, *models.ResponseScheme, error)
+
+	// Reopen reopens the resolved thread of the specified pull request comment.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments/{comment_id}/resolve
+	Reopen(ctx context.Context, workspace, repoSlug string, pullRequestID, commentID int) (*models.ResponseScheme, error)
+}
+
+// PullRequestTaskConnector represents the Bitbucket Cloud pull request tasks.
+type PullRequestTaskConnector interface {
+
+	// Gets returns a paginated list of the tasks on the specified pull request.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/tasks
+	Gets(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*models.PullRequestTaskPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified pull request task.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/tasks/{task_id}
+	Get(ctx context.Context, workspace, repoSlug string, pullRequestID, taskID int) (*models.PullRequestTaskScheme, *models.ResponseScheme, error)
+
+	// Create creates a new pull request task, optionally attached to a comment.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/tasks
+	Create(ctx context.Context, workspace, repoSlug string, pullRequestID int, payload *models.PullRequestTaskPayloadScheme) (*models.PullRequestTaskScheme, *models.ResponseScheme, error)
+
+	// Update updates the content or the state of the specified pull request task.
+	//
+	// PUT /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/tasks/{task_id}
+	Update(ctx context.Context, workspace, repoSlug string, pullRequestID, taskID int, payload *models.PullRequestTaskPayloadScheme) (*models.PullRequestTaskScheme, *models.ResponseScheme, error)
+
+	// Delete deletes the specified pull request task.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/tasks/{task_id}
+	Delete(ctx context.Context, workspace, repoSlug string, pullRequestID, taskID int) (*models.ResponseScheme, error)
+}
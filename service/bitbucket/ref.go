@@ -0,0 +1,56 @@
+package bitbucket
+
+import (
+	"context"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// RefConnector represents the Bitbucket Cloud references: the branches and the tags of a repository.
+type RefConnector interface {
+
+	// Gets returns a paginated list of the branches and the tags of the specified repository.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/refs
+	Gets(ctx context.Context, workspace, repoSlug string, options *models.RefOptionsScheme) (*models.RefPageScheme, *models.ResponseScheme, error)
+
+	// Branches returns a paginated list of the branches of the specified repository.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/refs/branches
+	Branches(ctx context.Context, workspace, repoSlug string, options *models.RefOptionsScheme) (*models.BranchPageScheme, *models.ResponseScheme, error)
+
+	// Branch returns the specified branch.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/refs/branches/{name}
+	Branch(ctx context.Context, workspace, repoSlug, name string) (*models.BranchScheme, *models.ResponseScheme, error)
+
+	// CreateBranch creates a new branch pointing to the target of the payload.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/refs/branches
+	CreateBranch(ctx context.Context, workspace, repoSlug string, payload *models.RefPayloadScheme) (*models.BranchScheme, *models.ResponseScheme, error)
+
+	// DeleteBranch deletes the specified branch, the main branch can't be deleted.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/refs/branches/{name}
+	DeleteBranch(ctx context.Context, workspace, repoSlug, name string) (*models.ResponseScheme, error)
+
+	// Tags returns a paginated list of the tags of the specified repository.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/refs/tags
+	Tags(ctx context.Context, workspace, repoSlug string, options *models.RefOptionsScheme) (*models.TagPageScheme, *models.ResponseScheme, error)
+
+	// Tag returns the specified tag.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/refs/tags/{name}
+	Tag(ctx context.Context, workspace, repoSlug, name string) (*models.TagScheme, *models.ResponseScheme, error)
+
+	// CreateTag creates a new tag pointing to the target of the payload, an annotated tag when the payload has a message.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/refs/tags
+	CreateTag(ctx context.Context, workspace, repoSlug string, payload *models.RefPayloadScheme) (*models.TagScheme, *models.ResponseScheme, error)
+
+	// DeleteTag deletes the specified tag.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/refs/tags/{name}
+	DeleteTag(ctx context.Context, workspace, repoSlug, name string) (*models.ResponseScheme, error)
+}
@@ -2,6 +2,7 @@ package bitbucket
 
 import (
 	"context"
+	"io"
 
 	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 )
@@ -13,6 +14,13 @@ import (
 // The repo resource allows you to access public repos, or repos that belong to a specific workspace.
 type RepositoryConnector interface {
 
+	// Gets returns a paginated list of all repositories owned by the specified workspace.
+	//
+	// The result can be narrowed down based on the authenticated user's role, filtered and sorted.
+	//
+	// GET /2.0/repositories/{workspace}
+	Gets(ctx context.Context, workspace string, options *models.RepositoryOptionsScheme) (*models.RepositoryPageScheme, *models.ResponseScheme, error)
+
 	// Get returns the object describing this repository.
 	//
 	// GET /2.0/repositories/{workspace}/{repo_slug}
@@ -31,52 +39,223 @@ type RepositoryConnector interface {
 
 	// Delete deletes the repository. This is an irreversible operation and this does not affect its forks.
 	//
+	// The redirectTo parameter, when provided, is the URL the repository is moved to, the old URL redirects to it.
+	//
 	// DELETE /2.0/repositories/{workspace}/{repo_slug}
 	Delete(ctx context.Context, workspace, repoSlug, redirectTo string) (*models.ResponseScheme, error)
 
 	// Create creates a new repository.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}
 	Create(ctx context.Context, workspace, repoSlug string, payload *models.RepositoryScheme) (*models.RepositoryScheme, *models.ResponseScheme, error)
 
 	// Watchers returns a paginated list of all the watchers on the specified repository.
 	//
 	// GET /2.0/repositories/{workspace}/{repo_slug}/watchers
-	Watchers(ctx context.Context, workspace, repoSlug string)
+	Watchers(ctx context.Context, workspace, repoSlug string) (*models.BitbucketAccountPageScheme, *models.ResponseScheme, error)
 }
 
 // RepositoryForkConnector represents the Bitbucket Cloud repository forks.
 type RepositoryForkConnector interface {
-	Gets(ctx context.Context)
-	Execute(ctx context.Context)
+
+	// Gets returns a paginated list of all the forks of the specified repository.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/forks
+	Gets(ctx context.Context, workspace, repoSlug string, options *models.RepositoryOptionsScheme) (*models.RepositoryPageScheme, *models.ResponseScheme, error)
+
+	// Execute creates a new fork of the specified repository.
+	//
+	// By default, the fork is created in the same workspace as the parent, with the same name.
+	//
+	// Use the workspace of the payload to fork the repository into another workspace.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/forks
+	Execute(ctx context.Context, workspace, repoSlug string, payload *models.RepositoryForkPayloadScheme) (*models.RepositoryScheme, *models.ResponseScheme, error)
 }
 
 // RepositoryWebhookConnector represents the Bitbucket Cloud repository webhooks.
 type RepositoryWebhookConnector interface {
-	Gets(ctx context.Context)
-	Get(ctx context.Context)
-	Create(ctx context.Context)
-	Update(ctx context.Context)
-	Delete(ctx context.Context)
+
+	// Gets returns a paginated list of the webhooks installed on the specified repository.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/hooks
+	Gets(ctx context.Context, workspace, repoSlug string) (*models.WebhookSubscriptionPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified webhook installed on the repository.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/hooks/{uid}
+	Get(ctx context.Context, workspace, repoSlug, webhookID string) (*models.WebhookSubscriptionScheme, *models.ResponseScheme, error)
+
+	// Create creates a new webhook on the specified repository.
+	//
+	// Set the secret of the payload to have the deliveries signed in the X-Hub-Signature header.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/hooks
+	Create(ctx context.Context, workspace, repoSlug string, payload *models.WebhookSubscriptionPayloadScheme) (*models.WebhookSubscriptionScheme, *models.ResponseScheme, error)
+
+	// Update updates the specified webhook subscription.
+	//
+	// PUT /2.0/repositories/{workspace}/{repo_slug}/hooks/{uid}
+	Update(ctx context.Context, workspace, repoSlug, webhookID string, payload *models.WebhookSubscriptionPayloadScheme) (*models.WebhookSubscriptionScheme, *models.ResponseScheme, error)
+
+	// Delete deletes the specified webhook subscription from the repository.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/hooks/{uid}
+	Delete(ctx context.Context, workspace, repoSlug, webhookID string) (*models.ResponseScheme, error)
 }
 
 // RepositorySettingConnector represents the Bitbucket Cloud repository settings.
+//
+// The repository settings are either inherited from the project or overridden by the repository.
 type RepositorySettingConnector interface {
-	Gets(ctx context.Context)
-	Set(ctx context.Context)
+
+	// Gets returns the settings the repository overrides instead of inheriting them from its project.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/override-settings
+	Gets(ctx context.Context, workspace, repoSlug string) (*models.RepositoryInheritanceStateScheme, *models.ResponseScheme, error)
+
+	// Set sets the settings the repository overrides instead of inheriting them from its project.
+	//
+	// PUT /2.0/repositories/{workspace}/{repo_slug}/override-settings
+	Set(ctx context.Context, workspace, repoSlug string, payload *models.RepositoryInheritanceStateScheme) (*models.ResponseScheme, error)
 }
 
 // RepositoryGroupPermissionConnector represents the Bitbucket Cloud repository group permissions.
 type RepositoryGroupPermissionConnector interface {
-	Gets(ctx context.Context)
-	Get(ctx context.Context)
-	Update(ctx context.Context)
-	Delete(ctx context.Context)
+
+	// Gets returns a paginated list of the explicit group permissions of the specified repository.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/permissions-config/groups
+	Gets(ctx context.Context, workspace, repoSlug string) (*models.RepositoryGroupPermissionPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the explicit permission of the specified group on the repository.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/permissions-config/groups/{group_slug}
+	Get(ctx context.Context, workspace, repoSlug, groupSlug string) (*models.RepositoryGroupPermissionScheme, *models.ResponseScheme, error)
+
+	// Update grants, or changes, the explicit permission of the specified group on the repository.
+	//
+	// PUT /2.0/repositories/{workspace}/{repo_slug}/permissions-config/groups/{group_slug}
+	Update(ctx context.Context, workspace, repoSlug, groupSlug string, payload *models.RepositoryPermissionPayloadScheme) (*models.RepositoryGroupPermissionScheme, *models.ResponseScheme, error)
+
+	// Delete revokes the explicit permission of the specified group on the repository.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/permissions-config/groups/{group_slug}
+	Delete(ctx context.Context, workspace, repoSlug, groupSlug string) (*models.ResponseScheme, error)
 }
 
 // RepositoryUserPermissionConnector represents the Bitbucket Cloud repository user permissions.
 type RepositoryUserPermissionConnector interface {
-	Gets(ctx context.Context)
-	Get(ctx context.Context)
-	Update(ctx context.Context)
-	Delete(ctx context.Context)
-	Check(ctx context.Context)
+
+	// Gets returns a paginated list of the explicit user permissions of the specified repository.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/permissions-config/users
+	Gets(ctx context.Context, workspace, repoSlug string) (*models.RepositoryUserPermissionPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the explicit permission of the specified user on the repository.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/permissions-config/users/{selected_user_id}
+	Get(ctx context.Context, workspace, repoSlug, accountID string) (*models.RepositoryUserPermissionScheme, *models.ResponseScheme, error)
+
+	// Update grants, or changes, the explicit permission of the specified user on the repository.
+	//
+	// PUT /2.0/repositories/{workspace}/{repo_slug}/permissions-config/users/{selected_user_id}
+	Update(ctx context.Context, workspace, repoSlug, accountID string, payload *models.RepositoryPermissionPayloadScheme) (*models.RepositoryUserPermissionScheme, *models.ResponseScheme, error)
+
+	// Delete revokes the explicit permission of the specified user on the repository.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/permissions-config/users/{selected_user_id}
+	Delete(ctx context.Context, workspace, repoSlug, accountID string) (*models.ResponseScheme, error)
+
+	// Check returns the effective permission of the authenticated user on the specified repository,
+	// the highest permission granted to the user explicitly, through a group or through the workspace.
+	//
+	// GET /2.0/user/permissions/repositories?q=repository.full_name="{workspace}/{repo_slug}"
+	Check(ctx context.Context, workspace, repoSlug string) (*models.RepositoryPermissionPageScheme, *models.ResponseScheme, error)
+}
+
+// RepositoryDefaultReviewerConnector represents the Bitbucket Cloud repository default reviewers,
+// the users added as reviewers to the new pull requests of the repository.
+type RepositoryDefaultReviewerConnector interface {
+
+	// Gets returns a paginated list of the default reviewers set on the specified repository.
+	//
+	// The default reviewers inherited from the project are not returned, use Effective to include them.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/default-reviewers
+	Gets(ctx context.Context, workspace, repoSlug string) (*models.BitbucketAccountPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified default reviewer of the repository.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/default-reviewers/{target_username}
+	Get(ctx context.Context, workspace, repoSlug, accountID string) (*models.BitbucketAccountScheme, *models.ResponseScheme, error)
+
+	// Add adds the specified user to the default reviewers of the repository.
+	//
+	// PUT /2.0/repositories/{workspace}/{repo_slug}/default-reviewers/{target_username}
+	Add(ctx context.Context, workspace, repoSlug, accountID string) (*models.BitbucketAccountScheme, *models.ResponseScheme, error)
+
+	// Remove removes the specified user from the default reviewers of the repository.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/default-reviewers/{target_username}
+	Remove(ctx context.Context, workspace, repoSlug, accountID string) (*models.ResponseScheme, error)
+
+	// Effective returns a paginated list of the effective default reviewers of the specified repository,
+	// the default reviewers of the repository and the ones inherited from its project.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/effective-default-reviewers
+	Effective(ctx context.Context, workspace, repoSlug string) (*models.DefaultReviewerPageScheme, *models.ResponseScheme, error)
+}
+
+// RepositoryDeployKeyConnector represents the Bitbucket Cloud repository deploy keys.
+type RepositoryDeployKeyConnector interface {
+
+	// Gets returns a paginated list of the deploy keys of the specified repository.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/deploy-keys
+	Gets(ctx context.Context, workspace, repoSlug string) (*models.DeployKeyPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified deploy key.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/deploy-keys/{key_id}
+	Get(ctx context.Context, workspace, repoSlug string, keyID int) (*models.DeployKeyScheme, *models.ResponseScheme, error)
+
+	// Create adds a new deploy key to the specified repository.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/deploy-keys
+	Create(ctx context.Context, workspace, repoSlug string, payload *models.KeyPayloadScheme) (*models.DeployKeyScheme, *models.ResponseScheme, error)
+
+	// Update updates the label or the value of the specified deploy key.
+	//
+	// PUT /2.0/repositories/{workspace}/{repo_slug}/deploy-keys/{key_id}
+	Update(ctx context.Context, workspace, repoSlug string, keyID int, payload *models.KeyPayloadScheme) (*models.DeployKeyScheme, *models.ResponseScheme, error)
+
+	// Delete removes the specified deploy key from the repository.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/deploy-keys/{key_id}
+	Delete(ctx context.Context, workspace, repoSlug string, keyID int) (*models.ResponseScheme, error)
+}
+
+// RepositoryDownloadConnector represents the Bitbucket Cloud repository downloads.
+type RepositoryDownloadConnector interface {
+
+	// Gets returns a paginated list of the files uploaded to the downloads of the specified repository.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/downloads
+	Gets(ctx context.Context, workspace, repoSlug string) (*models.RepositoryDownloadPageScheme, *models.ResponseScheme, error)
+
+	// Upload uploads a file to the downloads of the specified repository, replacing the file with the same name.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/downloads
+	Upload(ctx context.Context, workspace, repoSlug, fileName string, file io.Reader) (*models.ResponseScheme, error)
+
+	// Download returns the contents of the specified file as a reader, the caller must close it.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/downloads/{filename}
+	Download(ctx context.Context, workspace, repoSlug, fileName string) (io.ReadCloser, error)
+
+	// Delete deletes the specified file from the downloads of the repository.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/downloads/{filename}
+	Delete(ctx context.Context, workspace, repoSlug, fileName string) (*models.ResponseScheme, error)
 }
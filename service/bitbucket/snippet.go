@@ -0,0 +1,92 @@
+package bitbucket
+
+import (
+	"context"
+	"io"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// SnippetConnector represents the Bitbucket Cloud snippets.
+type SnippetConnector interface {
+
+	// Gets returns a paginated list of the snippets of the specified workspace.
+	//
+	// GET /2.0/snippets/{workspace}
+	Gets(ctx context.Context, workspace string, options *models.SnippetOptionsScheme) (*models.SnippetPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified snippet.
+	//
+	// GET /2.0/snippets/{workspace}/{encoded_id}
+	Get(ctx context.Context, workspace, encodedID string) (*models.SnippetScheme, *models.ResponseScheme, error)
+
+	// Create creates a new snippet with the files of the payload.
+	//
+	// POST /2.0/snippets/{workspace}
+	Create(ctx context.Context, workspace string, payload *models.SnippetPayloadScheme) (*models.SnippetScheme, *models.ResponseScheme, error)
+
+	// Update updates the title and the files of the specified snippet, creating a new revision.
+	//
+	// PUT /2.0/snippets/{workspace}/{encoded_id}
+	Update(ctx context.Context, workspace, encodedID string, payload *models.SnippetPayloadScheme) (*models.SnippetScheme, *models.ResponseScheme, error)
+
+	// Delete deletes the specified snippet.
+	//
+	// DELETE /2.0/snippets/{workspace}/{encoded_id}
+	Delete(ctx context.Context, workspace, encodedID string) (*models.ResponseScheme, error)
+
+	// File returns the contents of a file of the specified snippet as a reader, the caller must close it.
+	//
+	// The file is read at the revision, at the latest one when the revision is empty.
+	//
+	// GET /2.0/snippets/{workspace}/{encoded_id}/{node_id}/files/{path}
+	File(ctx context.Context, workspace, encodedID, revision, path string) (io.ReadCloser, error)
+}
+
+// SnippetCommentConnector represents the Bitbucket Cloud snippet comments.
+type SnippetCommentConnector interface {
+
+	// Gets returns a paginated list of the comments on the specified snippet.
+	//
+	// GET /2.0/snippets/{workspace}/{encoded_id}/comments
+	Gets(ctx context.Context, workspace, encodedID string) (*models.SnippetCommentPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified snippet comment.
+	//
+	// GET /2.0/snippets/{workspace}/{encoded_id}/comments/{comment_id}
+	Get(ctx context.Context, workspace, encodedID string, commentID int) (*models.SnippetCommentScheme, *models.ResponseScheme, error)
+
+	// Create creates a new snippet comment, a reply when the payload has a parent.
+	//
+	// POST /2.0/snippets/{workspace}/{encoded_id}/comments
+	Create(ctx context.Context, workspace, encodedID string, payload *models.SnippetCommentPayloadScheme) (*models.SnippetCommentScheme, *models.ResponseScheme, error)
+
+	// Update updates the content of the specified snippet comment.
+	//
+	// PUT /2.0/snippets/{workspace}/{encoded_id}/comments/{comment_id}
+	Update(ctx context.Context, workspace, encodedID string, commentID int, payload *models.SnippetCommentPayloadScheme) (*models.SnippetCommentScheme, *models.ResponseScheme, error)
+
+	// Delete deletes the specified snippet comment.
+	//
+	// DELETE /2.0/snippets/{workspace}/{encoded_id}/comments/{comment_id}
+	Delete(ctx context.Context, workspace, encodedID string, commentID int) (*models.ResponseScheme, error)
+}
+
+// SnippetRevisionConnector represents the Bitbucket Cloud snippet revisions.
+type SnippetRevisionConnector interface {
+
+	// Gets returns a paginated list of the revisions of the specified snippet, the newest first.
+	//
+	// GET /2.0/snippets/{workspace}/{encoded_id}/commits
+	Gets(ctx context.Context, workspace, encodedID string) (*models.SnippetCommitPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified snippet revision.
+	//
+	// GET /2.0/snippets/{workspace}/{encoded_id}/commits/{revision}
+	Get(ctx context.Context, workspace, encodedID, revision string) (*models.SnippetCommitScheme, *models.ResponseScheme, error)
+
+	// Snippet returns the specified snippet as it was at the revision.
+	//
+	// GET /2.0/snippets/{workspace}/{encoded_id}/{node_id}
+	Snippet(ctx context.Context, workspace, encodedID, revision string) (*models.SnippetScheme, *models.ResponseScheme, error)
+}
@@ -0,0 +1,30 @@
+package bitbucket
+
+import (
+	"context"
+	"io"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// SourceConnector represents the Bitbucket Cloud source browsing,
+// use it to list the directories and to read the files of a repository at a revision.
+type SourceConnector interface {
+
+	// Gets returns a paginated list of the entries of the specified directory at a revision.
+	//
+	// An empty path is the root directory of the repository.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/src/{commit}/{path}
+	Gets(ctx context.Context, workspace, repoSlug, commit, path string, options *models.SourceOptionsScheme) (*models.SourcePageScheme, *models.ResponseScheme, error)
+
+	// Get returns the metadata of the specified file or directory at a revision.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/src/{commit}/{path}?format=meta
+	Get(ctx context.Context, workspace, repoSlug, commit, path string) (*models.SourceScheme, *models.ResponseScheme, error)
+
+	// Download returns the contents of the specified file at a revision as a reader, the caller must close it.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/src/{commit}/{path}
+	Download(ctx context.Context, workspace, repoSlug, commit, path string) (io.ReadCloser, error)
+}
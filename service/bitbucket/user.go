@@ -0,0 +1,50 @@
+package bitbucket
+
+import (
+	"context"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// UserConnector represents the Bitbucket Cloud users.
+type UserConnector interface {
+
+	// Current returns the currently logged-in user.
+	//
+	// GET /2.0/user
+	Current(ctx context.Context) (*models.BitbucketAccountScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified user, identified by its account ID or its UUID.
+	//
+	// GET /2.0/users/{selected_user}
+	Get(ctx context.Context, accountID string) (*models.BitbucketAccountScheme, *models.ResponseScheme, error)
+}
+
+// UserSSHKeyConnector represents the Bitbucket Cloud SSH keys of the users.
+type UserSSHKeyConnector interface {
+
+	// Gets returns a paginated list of the SSH keys of the specified user.
+	//
+	// GET /2.0/users/{selected_user}/ssh-keys
+	Gets(ctx context.Context, accountID string) (*models.SSHKeyPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified SSH key of a user.
+	//
+	// GET /2.0/users/{selected_user}/ssh-keys/{key_id}
+	Get(ctx context.Context, accountID, keyUUID string) (*models.SSHKeyScheme, *models.ResponseScheme, error)
+
+	// Create adds a new SSH key to the specified user.
+	//
+	// POST /2.0/users/{selected_user}/ssh-keys
+	Create(ctx context.Context, accountID string, payload *models.KeyPayloadScheme) (*models.SSHKeyScheme, *models.ResponseScheme, error)
+
+	// Update updates the label of the specified SSH key of a user.
+	//
+	// PUT /2.0/users/{selected_user}/ssh-keys/{key_id}
+	Update(ctx context.Context, accountID, keyUUID string, payload *models.KeyPayloadScheme) (*models.SSHKeyScheme, *models.ResponseScheme, error)
+
+	// Delete removes the specified SSH key of a user.
+	//
+	// DELETE /2.0/users/{selected_user}/ssh-keys/{key_id}
+	Delete(ctx context.Context, accountID, keyUUID string) (*models.ResponseScheme, error)
+}
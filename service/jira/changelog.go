@@ -0,0 +1,26 @@
+package jira
+
+import (
+	"context"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// IssueChangelogConnector the interface for the issue changelog methods of the Jira Service.
+type IssueChangelogConnector interface {
+
+	// Gets returns a paginated list of the change histories of an issue, the oldest first.
+	//
+	// GET /rest/api/{2-3}/issue/{issueKeyOrID}/changelog
+	Gets(ctx context.Context, issueKeyOrID string, startAt, maxResults int) (*model.IssueChangelogPageScheme, *model.ResponseScheme, error)
+
+	// GetsByIDs returns the change histories of an issue with the IDs.
+	//
+	// POST /rest/api/{2-3}/issue/{issueKeyOrID}/changelog/list
+	GetsByIDs(ctx context.Context, issueKeyOrID string, changelogIDs []int) (*model.IssueChangelogScheme, *model.ResponseScheme, error)
+
+	// BulkFetch returns a page of the change histories of several issues, optionally filtered by field.
+	//
+	// POST /rest/api/{2-3}/changelog/bulkfetch
+	BulkFetch(ctx context.Context, payload *model.IssueChangelogBulkFetchPayloadScheme) (*model.IssueChangelogBulkFetchPageScheme, *model.ResponseScheme, error)
+}
@@ -0,0 +1,24 @@
+package jira
+
+import (
+	"context"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// ExpressionConnector the interface for the Jira expression methods of the Jira Service.
+type ExpressionConnector interface {
+
+	// Evaluate evaluates a Jira expression in the context, the complexity of the evaluation is returned in the metadata.
+	//
+	// POST /rest/api/{2-3}/expression/eval
+	Evaluate(ctx context.Context, expression string, expressionContext *model.ExpressionEvalContextScheme) (*model.ExpressionEvalScheme, *model.ResponseScheme, error)
+
+	// Analyse checks the syntax, the types or the complexity of Jira expressions without evaluating them.
+	//
+	// The check is one of model.ExpressionCheckSyntax, model.ExpressionCheckType or model.ExpressionCheckComplexity,
+	// the types are checked when it's empty.
+	//
+	// POST /rest/api/{2-3}/expression/analyse
+	Analyse(ctx context.Context, check string, payload *model.ExpressionAnalysePayloadScheme) (*model.ExpressionAnalysisScheme, *model.ResponseScheme, error)
+}
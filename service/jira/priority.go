@@ -25,4 +25,103 @@ type PriorityConnector interface {
 	// Deprecated: This endpoint is deprecated in the Jira API spec.
 	// TODO Cannot change without breaking API compatibility. Consider removing in next major version.
 	Get(ctx context.Context, priorityID string) (*model.PriorityScheme, *model.ResponseScheme, error)
+
+	// Search returns a paginated list of the priorities matching the options, in their display order.
+	//
+	// GET /rest/api/{2-3}/priority/search
+	Search(ctx context.Context, options *model.PrioritySearchOptions, startAt, maxResults int) (*model.PriorityPageScheme, *model.ResponseScheme, error)
+
+	// Create creates a priority, the name and the status color are required.
+	//
+	// POST /rest/api/{2-3}/priority
+	Create(ctx context.Context, payload *model.PriorityPayloadScheme) (*model.PriorityCreatedScheme, *model.ResponseScheme, error)
+
+	// Update updates a priority, only the attributes set are changed.
+	//
+	// PUT /rest/api/{2-3}/priority/{priorityID}
+	Update(ctx context.Context, priorityID string, payload *model.PriorityPayloadScheme) (*model.ResponseScheme, error)
+
+	// Delete deletes a priority, it can't be used by any priority scheme.
+	//
+	// The deletion runs asynchronously, use the ID of the task returned to follow it with the task service.
+	//
+	// DELETE /rest/api/{2-3}/priority/{priorityID}
+	Delete(ctx context.Context, priorityID string) (*model.TaskScheme, *model.ResponseScheme, error)
+
+	// SetDefault sets the default priority, an empty ID unsets it.
+	//
+	// PUT /rest/api/{2-3}/priority/default
+	SetDefault(ctx context.Context, priorityID string) (*model.ResponseScheme, error)
+
+	// Move changes the display order of priorities.
+	//
+	// PUT /rest/api/{2-3}/priority/move
+	Move(ctx context.Context, payload *model.PriorityMovePayloadScheme) (*model.ResponseScheme, error)
+}
+
+// PrioritySchemeConnector the interface for the priority scheme methods of the Jira Service.
+type PrioritySchemeConnector interface {
+
+	// Gets returns a paginated list of the priority schemes matching the options.
+	//
+	// GET /rest/api/{2-3}/priorityscheme
+	Gets(ctx context.Context, options *model.PrioritySchemeSearchOptions, startAt, maxResults int) (*model.PrioritySchemePageScheme, *model.ResponseScheme, error)
+
+	// Create creates a priority scheme, optionally assigned to projects.
+	//
+	// The issues of the projects using priorities missing from the scheme must be remapped,
+	// the remapping runs asynchronously, use the ID of the task returned to follow it with the task service.
+	//
+	// POST /rest/api/{2-3}/priorityscheme
+	Create(ctx context.Context, payload *model.PrioritySchemePayloadScheme) (*model.PrioritySchemeCreatedScheme, *model.ResponseScheme, error)
+
+	// Update updates a priority scheme, its priorities and its projects.
+	//
+	// The issues using the priorities removed, or of the projects added, must be remapped,
+	// the remapping runs asynchronously, use the ID of the task returned to follow it with the task service.
+	//
+	// PUT /rest/api/{2-3}/priorityscheme/{schemeID}
+	Update(ctx context.Context, schemeID string, payload *model.PrioritySchemeUpdatePayloadScheme) (*model.PrioritySchemeUpdatedScheme, *model.ResponseScheme, error)
+
+	// Delete deletes a priority scheme, it can't be assigned to any project.
+	//
+	// DELETE /rest/api/{2-3}/priorityscheme/{schemeID}
+	Delete(ctx context.Context, schemeID string) (*model.ResponseScheme, error)
+
+	// Priorities returns a paginated list of the priorities of a priority scheme.
+	//
+	// GET /rest/api/{2-3}/priorityscheme/{schemeID}/priorities
+	Priorities(ctx context.Context, schemeID string, startAt, maxResults int) (*model.PriorityPageScheme, *model.ResponseScheme, error)
+
+	// Available returns a paginated list of the priorities which can be added to a priority scheme.
+	//
+	// The query filters the priorities by name, the excluded priorities are not returned.
+	//
+	// GET /rest/api/{2-3}/priorityscheme/priorities/available
+	Available(ctx context.Context, schemeID, query string, exclude []string, startAt, maxResults int) (*model.PriorityPageScheme, *model.ResponseScheme, error)
+
+	// Projects returns a paginated list of the projects a priority scheme is assigned to.
+	//
+	// GET /rest/api/{2-3}/priorityscheme/{schemeID}/projects
+	Projects(ctx context.Context, schemeID string, startAt, maxResults int) (*model.PrioritySchemeProjectPageScheme, *model.ResponseScheme, error)
+
+	// SuggestedProjects returns a paginated list of the projects a priority scheme can be assigned to.
+	//
+	// The query filters the projects by name or key, the excluded projects are not returned.
+	//
+	// GET /rest/api/{2-3}/priorityscheme/projects/available
+	SuggestedProjects(ctx context.Context, schemeID, query string, exclude []string, startAt, maxResults int) (*model.PrioritySchemeSuggestedProjectPageScheme, *model.ResponseScheme, error)
+
+	// Mappings returns a paginated list of the priorities requiring a mapping to apply changes to a priority scheme,
+	// the changes are not applied.
+	//
+	// POST /rest/api/{2-3}/priorityscheme/mappings
+	Mappings(ctx context.Context, payload *model.PrioritySchemeMappingsPayloadScheme) (*model.PriorityPageScheme, *model.ResponseScheme, error)
+
+	// Assign assigns a priority scheme to projects, remapping the priorities of their issues.
+	//
+	// The remapping runs asynchronously, use the ID of the task returned to follow it with the task service.
+	//
+	// PUT /rest/api/{2-3}/priorityscheme/{schemeID}
+	Assign(ctx context.Context, schemeID string, projectIDs []int, mappings *model.PrioritySchemeMappingsScheme) (*model.PrioritySchemeUpdatedScheme, *model.ResponseScheme, error)
 }
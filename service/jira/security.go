@@ -0,0 +1,105 @@
+package jira
+
+import (
+	"context"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// IssueSecuritySchemeConnector the interface for the issue security scheme methods of the Jira Service.
+type IssueSecuritySchemeConnector interface {
+
+	// Gets returns all the issue security schemes.
+	//
+	// GET /rest/api/{2-3}/issuesecurityschemes
+	Gets(ctx context.Context) (*model.IssueSecuritySchemesScheme, *model.ResponseScheme, error)
+
+	// Get returns an issue security scheme along with its security levels.
+	//
+	// GET /rest/api/{2-3}/issuesecurityschemes/{schemeID}
+	Get(ctx context.Context, schemeID string) (*model.IssueSecuritySchemeScheme, *model.ResponseScheme, error)
+
+	// Search returns a paginated list of the issue security schemes matching the options, ordered by name.
+	//
+	// GET /rest/api/{2-3}/issuesecurityschemes/search
+	Search(ctx context.Context, options *model.IssueSecuritySchemeSearchOptions, startAt, maxResults int) (*model.IssueSecuritySchemeSearchPageScheme, *model.ResponseScheme, error)
+
+	// Create creates an issue security scheme, optionally with its security levels and their members.
+	//
+	// POST /rest/api/{2-3}/issuesecurityschemes
+	Create(ctx context.Context, payload *model.IssueSecuritySchemePayloadScheme) (*model.IssueSecuritySchemeCreatedScheme, *model.ResponseScheme, error)
+
+	// Update updates the name or the description of an issue security scheme.
+	//
+	// PUT /rest/api/{2-3}/issuesecurityschemes/{schemeID}
+	Update(ctx context.Context, schemeID string, payload *model.IssueSecuritySchemeUpdatePayloadScheme) (*model.ResponseScheme, error)
+
+	// Delete deletes an issue security scheme, it can't be associated with any project.
+	//
+	// DELETE /rest/api/{2-3}/issuesecurityschemes/{schemeID}
+	Delete(ctx context.Context, schemeID string) (*model.ResponseScheme, error)
+
+	// Projects returns a paginated list of the associations of the issue security schemes with projects.
+	//
+	// GET /rest/api/{2-3}/issuesecurityschemes/project
+	Projects(ctx context.Context, schemeIDs, projectIDs []string, startAt, maxResults int) (*model.IssueSecuritySchemeProjectPageScheme, *model.ResponseScheme, error)
+
+	// Associate associates an issue security scheme with a project, remapping the security levels of its issues.
+	//
+	// The remapping runs asynchronously, use the ID of the task returned to follow it with the task service,
+	// e.g. with its WaitForCompletion method.
+	//
+	// PUT /rest/api/{2-3}/issuesecurityschemes/project
+	Associate(ctx context.Context, payload *model.IssueSecuritySchemeAssociatePayloadScheme) (*model.TaskScheme, *model.ResponseScheme, error)
+}
+
+// IssueSecurityLevelConnector the interface for the security level methods of the Jira Service.
+type IssueSecurityLevelConnector interface {
+
+	// Gets returns a paginated list of the security levels matching the options.
+	//
+	// GET /rest/api/{2-3}/issuesecurityschemes/level
+	Gets(ctx context.Context, options *model.IssueSecurityLevelSearchOptions, startAt, maxResults int) (*model.IssueSecurityLevelPageScheme, *model.ResponseScheme, error)
+
+	// Add adds security levels, and optionally their members, to an issue security scheme.
+	//
+	// PUT /rest/api/{2-3}/issuesecurityschemes/{schemeID}/level
+	Add(ctx context.Context, schemeID string, payload *model.IssueSecurityLevelsPayloadScheme) (*model.ResponseScheme, error)
+
+	// Update updates the name or the description of a security level.
+	//
+	// PUT /rest/api/{2-3}/issuesecurityschemes/{schemeID}/level/{levelID}
+	Update(ctx context.Context, schemeID, levelID string, payload *model.IssueSecurityLevelUpdatePayloadScheme) (*model.ResponseScheme, error)
+
+	// Delete deletes a security level, the issues with the level are moved to the replacement level when set.
+	//
+	// The deletion runs asynchronously, use the ID of the task returned to follow it with the task service,
+	// e.g. with its WaitForCompletion method.
+	//
+	// DELETE /rest/api/{2-3}/issuesecurityschemes/{schemeID}/level/{levelID}
+	Delete(ctx context.Context, schemeID, levelID, replaceWith string) (*model.TaskScheme, *model.ResponseScheme, error)
+
+	// SetDefault sets the default security levels of issue security schemes.
+	//
+	// PUT /rest/api/{2-3}/issuesecurityschemes/level/default
+	SetDefault(ctx context.Context, payload *model.IssueSecurityLevelDefaultsPayloadScheme) (*model.ResponseScheme, error)
+}
+
+// IssueSecurityLevelMemberConnector the interface for the security level member methods of the Jira Service.
+type IssueSecurityLevelMemberConnector interface {
+
+	// Gets returns a paginated list of the members of the security levels matching the options.
+	//
+	// GET /rest/api/{2-3}/issuesecurityschemes/level/member
+	Gets(ctx context.Context, options *model.IssueSecurityLevelMemberSearchOptions, startAt, maxResults int) (*model.IssueSecurityLevelMemberPageScheme, *model.ResponseScheme, error)
+
+	// Add adds members, e.g. users, groups or project roles, to a security level.
+	//
+	// PUT /rest/api/{2-3}/issuesecurityschemes/{schemeID}/level/{levelID}/member
+	Add(ctx context.Context, schemeID, levelID string, payload *model.IssueSecurityLevelMembersPayloadScheme) (*model.ResponseScheme, error)
+
+	// Remove removes a member from a security level.
+	//
+	// DELETE /rest/api/{2-3}/issuesecurityschemes/{schemeID}/level/{levelID}/member/{memberID}
+	Remove(ctx context.Context, schemeID, levelID, memberID string) (*model.ResponseScheme, error)
+}
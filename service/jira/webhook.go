@@ -0,0 +1,42 @@
+package jira
+
+import (
+	"context"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// WebhookConnector the interface for the dynamic webhook methods of the Jira Service.
+//
+// The dynamic webhooks are registered by the Connect and the OAuth 2.0 apps, they expire after 30 days unless refreshed.
+type WebhookConnector interface {
+
+	// Gets returns a paginated list of the webhooks registered by the calling app.
+	//
+	// GET /rest/api/{2-3}/webhook
+	Gets(ctx context.Context, startAt, maxResults int) (*model.WebhookPageScheme, *model.ResponseScheme, error)
+
+	// Register registers webhooks sending their deliveries to the URL.
+	//
+	// The webhooks are registered independently, the result of each one is returned in the payload order.
+	//
+	// POST /rest/api/{2-3}/webhook
+	Register(ctx context.Context, payload *model.WebhookRegistrationPayloadScheme) (*model.WebhookRegistrationResultScheme, *model.ResponseScheme, error)
+
+	// Delete removes the webhooks registered by the calling app, the IDs of the webhooks of other apps are ignored.
+	//
+	// DELETE /rest/api/{2-3}/webhook
+	Delete(ctx context.Context, webhookIDs []int) (*model.ResponseScheme, error)
+
+	// Refresh extends the life of the webhooks by 30 days, the IDs of the webhooks of other apps are ignored.
+	//
+	// PUT /rest/api/{2-3}/webhook/refresh
+	Refresh(ctx context.Context, webhookIDs []int) (*model.WebhookRefreshScheme, *model.ResponseScheme, error)
+
+	// Failed returns the deliveries of the webhooks of the calling app which failed in the last 72 hours, the oldest first.
+	//
+	// The after parameter, in milliseconds since the epoch, skips the failures that occurred before, it's ignored when zero.
+	//
+	// GET /rest/api/{2-3}/webhook/failed
+	Failed(ctx context.Context, maxResults int, after int64) (*model.FailedWebhookPageScheme, *model.ResponseScheme, error)
+}